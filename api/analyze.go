@@ -0,0 +1,431 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/cedana/cedana/utils"
+	"github.com/shirou/gopsutil/v3/process"
+	"golang.org/x/sys/cpu"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// filesystems CRIU can open inotify watches back up on by file handle, watches
+// on anything else need their paths resolved at dump time
+var handleFilesystems = map[string]bool{
+	"ext2": true, "ext3": true, "ext4": true, "xfs": true, "btrfs": true, "f2fs": true, "tmpfs": true,
+}
+
+// device files CRIU restores on its own
+var knownDevices = map[string]bool{
+	"/dev/null": true, "/dev/zero": true, "/dev/full": true, "/dev/random": true,
+	"/dev/urandom": true, "/dev/tty": true, "/dev/ptmx": true,
+}
+
+// ghost files (deleted files still open) bigger than this aren't dumped, unless
+// the ghost limit is raised
+const defaultGhostLimit = 1 << 20
+
+// analysis collects the findings over a process tree
+type analysis struct {
+	tree     map[int32]bool
+	findings []*task.Finding
+	// socket inodes held by the tree, and by which process
+	sockets map[uint32]int32
+}
+
+func (a *analysis) add(pid int32, level task.Finding_Level, resource, detail, fix string) {
+	a.findings = append(a.findings, &task.Finding{
+		PID:      pid,
+		Severity: level,
+		Resource: resource,
+		Detail:   detail,
+		Fix:      fix,
+	})
+}
+
+// Analyze walks the process tree of pid looking for what CRIU can't dump, or only
+// dumps with the right options, so it gets fixed before a dump fails on it.
+func (c *Client) Analyze(ctx context.Context, pid int32) (*task.AnalyzeResp, error) {
+	_, analyzeSpan := c.tracer.Start(ctx, "analyze")
+	defer analyzeSpan.End()
+
+	pids, err := utils.ProcessTree(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &analysis{tree: map[int32]bool{}, sockets: map[uint32]int32{}}
+	for _, p := range pids {
+		a.tree[p] = true
+	}
+
+	for _, p := range pids {
+		if err := a.fds(p); err != nil {
+			c.logger.Debug().Msgf("could not analyze fds of %d: %v", p, err)
+		}
+		a.connections(p)
+	}
+
+	if err := a.unixPeers(); err != nil {
+		c.logger.Debug().Msgf("could not find unix socket peers: %v", err)
+	}
+	a.sysvIPC(pid)
+
+	sort.SliceStable(a.findings, func(i, j int) bool {
+		return a.findings[i].Severity > a.findings[j].Severity
+	})
+
+	resp := &task.AnalyzeResp{PIDs: pids, Findings: a.findings, Checkpointable: true}
+	for _, finding := range a.findings {
+		if finding.Severity == task.Finding_BLOCKER {
+			resp.Checkpointable = false
+		}
+	}
+	return resp, nil
+}
+
+func (a *analysis) fds(pid int32) error {
+	fdDir := filepath.Join("/proc", strconv.Itoa(int(pid)), "fd")
+	entries, err := os.ReadDir(fdDir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		fdPath := filepath.Join(fdDir, entry.Name())
+		target, err := os.Readlink(fdPath)
+		if err != nil {
+			continue
+		}
+		detail := fmt.Sprintf("fd %s: %s", entry.Name(), target)
+
+		switch {
+		case target == "anon_inode:[io_uring]":
+			a.add(pid, task.Finding_BLOCKER, "io_uring", detail,
+				"CRIU can't dump io_uring instances, have the process tear the ring down before the dump (e.g. on a signal sent from a pre-dump hook)")
+
+		case target == "anon_inode:[perf_event]":
+			a.add(pid, task.Finding_BLOCKER, "perf_event", detail,
+				"CRIU can't dump perf events, detach the profiler or tracer before the dump")
+
+		case target == "anon_inode:inotify" || target == "anon_inode:[fanotify]":
+			a.watches(pid, entry.Name(), detail)
+
+		case strings.HasPrefix(target, "socket:["):
+			ino, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(target, "socket:["), "]"), 10, 32)
+			if err == nil {
+				a.sockets[uint32(ino)] = pid
+			}
+
+		case strings.HasPrefix(target, "/memfd:"):
+			// memfds are dumped along with their contents
+
+		case strings.HasSuffix(target, " (deleted)"):
+			var st unix.Stat_t
+			if err := unix.Stat(fdPath, &st); err == nil && st.Size > defaultGhostLimit {
+				a.add(pid, task.Finding_WARN, "deleted_file", fmt.Sprintf("%s, %d bytes", detail, st.Size),
+					"CRIU saves deleted files into the checkpoint up to the ghost limit of 1MiB, raise it with the GhostLimit option")
+			} else {
+				a.add(pid, task.Finding_INFO, "deleted_file", detail,
+					"saved into the checkpoint as a ghost file; if it's still linked elsewhere use the LinkRemap option instead")
+			}
+
+		case strings.HasPrefix(target, "/dev/pts/"):
+			a.add(pid, task.Finding_INFO, "terminal", detail,
+				"shell job, dumped with the ShellJob option which is set automatically; restore needs a terminal of its own")
+
+		case strings.HasPrefix(target, "/dev/nvidia"):
+			a.add(pid, task.Finding_WARN, "gpu", detail,
+				"needs the GPU controller (GPU_CONTROLLER_PATH) to checkpoint GPU state alongside CRIU")
+
+		case strings.HasPrefix(target, "/dev/") && !knownDevices[target] && !strings.HasPrefix(target, "/dev/shm/"):
+			a.add(pid, task.Finding_WARN, "device", detail,
+				"CRIU only restores the devices it knows; pass the device in on restore as an inherited fd (InheritFd), or mark it external (External)")
+		}
+	}
+
+	return nil
+}
+
+// watches flags the inotify/fanotify watches of the fd on filesystems CRIU can't
+// restore them by file handle on
+func (a *analysis) watches(pid int32, fd, detail string) {
+	info, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(int(pid)), "fdinfo", fd))
+	if err != nil {
+		return
+	}
+
+	fstypes, err := mountFilesystems(pid)
+	if err != nil {
+		return
+	}
+
+	flagged := map[string]bool{}
+	for _, line := range strings.Split(string(info), "\n") {
+		if !strings.HasPrefix(line, "inotify ") && !strings.HasPrefix(line, "fanotify ") {
+			continue
+		}
+		for _, field := range strings.Fields(line) {
+			if !strings.HasPrefix(field, "sdev:") {
+				continue
+			}
+			sdev, err := strconv.ParseUint(strings.TrimPrefix(field, "sdev:"), 16, 64)
+			if err != nil {
+				continue
+			}
+			// the kernel's internal encoding, major in the upper 12 bits
+			dev := fmt.Sprintf("%d:%d", sdev>>20, sdev&0xfffff)
+			fstype := fstypes[dev]
+			if fstype == "" || handleFilesystems[fstype] || flagged[fstype] {
+				continue
+			}
+			flagged[fstype] = true
+			a.add(pid, task.Finding_WARN, "inotify", fmt.Sprintf("%s, watching %s (%s)", detail, fstype, dev),
+				"CRIU restores watches by file handle, which "+fstype+" may not support; use the ForceIrmap option to resolve the watched paths at dump time")
+		}
+	}
+}
+
+// mountFilesystems maps the devices mounted in the mount namespace of pid to their
+// filesystem type
+func mountFilesystems(pid int32) (map[string]string, error) {
+	file, err := os.Open(filepath.Join("/proc", strconv.Itoa(int(pid)), "mountinfo"))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	fstypes := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// id parent major:minor root mountpoint options [optional...] - fstype source super
+		fields := strings.Fields(scanner.Text())
+		for i, field := range fields {
+			if field == "-" && i+1 < len(fields) && len(fields) > 2 {
+				fstypes[fields[2]] = fields[i+1]
+				break
+			}
+		}
+	}
+	return fstypes, scanner.Err()
+}
+
+func (a *analysis) connections(pid int32) {
+	p, err := process.NewProcess(pid)
+	if err != nil {
+		return
+	}
+	conns, err := p.Connections()
+	if err != nil {
+		return
+	}
+
+	for _, conn := range conns {
+		if conn.Family != syscall.AF_INET && conn.Family != syscall.AF_INET6 {
+			continue
+		}
+		if conn.Type != syscall.SOCK_STREAM {
+			continue
+		}
+
+		switch conn.Status {
+		case "LISTEN":
+			a.add(pid, task.Finding_INFO, "listening_port", fmt.Sprintf("fd %d: %s:%d", conn.Fd, conn.Laddr.IP, conn.Laddr.Port),
+				"restored as is, but the port has to be free on restore: don't leave the original running, or restore into a network namespace of its own")
+		case "ESTABLISHED":
+			a.add(pid, task.Finding_WARN, "tcp_established", fmt.Sprintf("fd %d: %s:%d -> %s:%d", conn.Fd, conn.Laddr.IP, conn.Laddr.Port, conn.Raddr.IP, conn.Raddr.Port),
+				"dumped with the TcpEstablished option which is set automatically; the peer has to keep the connection open until the restore, set network.lock_backend to lock the network meanwhile")
+		}
+	}
+}
+
+// unixPeers flags unix sockets of the tree connected to processes outside of it
+func (a *analysis) unixPeers() error {
+	peers, err := unixSocketPeers()
+	if err != nil {
+		return err
+	}
+
+	for ino, pid := range a.sockets {
+		peer, ok := peers[ino]
+		if !ok || peer == 0 {
+			continue
+		}
+		if _, inTree := a.sockets[peer]; inTree {
+			continue
+		}
+		a.add(pid, task.Finding_WARN, "external_unix_socket", fmt.Sprintf("socket:[%d] connected to socket:[%d] outside the process tree", ino, peer),
+			"dumped with the ExtUnixSk option which is set automatically, the peer has to be listening again on restore; or checkpoint the peer along with the tree")
+	}
+	return nil
+}
+
+const (
+	sockDiagByFamily = 20
+	udiagShowPeer    = 0x4
+	unixDiagPeer     = 2
+	unixDiagMsgLen   = 16
+	unixDiagReqLen   = 24
+)
+
+// unixSocketPeers asks the kernel (sock_diag) for the peer of every unix socket
+// in our network namespace, by inode
+func unixSocketPeers() (map[uint32]uint32, error) {
+	var order binary.ByteOrder = binary.LittleEndian
+	if cpu.IsBigEndian {
+		order = binary.BigEndian
+	}
+
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_SOCK_DIAG)
+	if err != nil {
+		return nil, err
+	}
+	defer unix.Close(fd)
+
+	req := make([]byte, unix.NLMSG_HDRLEN+unixDiagReqLen)
+	order.PutUint32(req[0:], uint32(len(req)))
+	order.PutUint16(req[4:], sockDiagByFamily)
+	order.PutUint16(req[6:], unix.NLM_F_REQUEST|unix.NLM_F_DUMP)
+	order.PutUint32(req[8:], 1)
+	// unix_diag_req: family, protocol, pad, states, ino, show, cookie
+	body := req[unix.NLMSG_HDRLEN:]
+	body[0] = unix.AF_UNIX
+	order.PutUint32(body[4:], 0xffffffff)
+	order.PutUint32(body[12:], udiagShowPeer)
+
+	if err := unix.Sendto(fd, req, 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return nil, err
+	}
+
+	peers := map[uint32]uint32{}
+	buf := make([]byte, 8*os.Getpagesize())
+	for {
+		n, _, err := unix.Recvfrom(fd, buf, 0)
+		if err != nil {
+			return nil, err
+		}
+		msgs, err := syscall.ParseNetlinkMessage(buf[:n])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, msg := range msgs {
+			switch msg.Header.Type {
+			case unix.NLMSG_DONE:
+				return peers, nil
+			case unix.NLMSG_ERROR:
+				return nil, fmt.Errorf("sock_diag request failed")
+			}
+			if len(msg.Data) < unixDiagMsgLen {
+				continue
+			}
+
+			ino := order.Uint32(msg.Data[4:])
+			attrs := msg.Data[unixDiagMsgLen:]
+			for len(attrs) >= unix.SizeofRtAttr {
+				length := int(order.Uint16(attrs[0:]))
+				if length < unix.SizeofRtAttr || length > len(attrs) {
+					break
+				}
+				if order.Uint16(attrs[2:]) == unixDiagPeer && length >= unix.SizeofRtAttr+4 {
+					peers[ino] = order.Uint32(attrs[unix.SizeofRtAttr:])
+				}
+				aligned := (length + unix.RTA_ALIGNTO - 1) &^ (unix.RTA_ALIGNTO - 1)
+				if aligned > len(attrs) {
+					break
+				}
+				attrs = attrs[aligned:]
+			}
+		}
+	}
+}
+
+// sysvIPC flags SysV shared memory and message queues used by the tree, which CRIU
+// only dumps along with an IPC namespace of the job's own
+func (a *analysis) sysvIPC(pid int32) {
+	own, err := os.Readlink(filepath.Join("/proc", strconv.Itoa(int(pid)), "ns", "ipc"))
+	if err != nil {
+		return
+	}
+	host, err := os.Readlink("/proc/self/ns/ipc")
+	if err == nil && own != host {
+		// in a namespace of its own, which gets dumped with the job
+		return
+	}
+
+	const fix = "CRIU only dumps SysV IPC with its IPC namespace, start the job in an IPC namespace of its own (CLONE_NEWIPC)"
+	for _, table := range []struct {
+		resource string
+		path     string
+		id       string
+		pids     []string
+	}{
+		{"sysv_shm", "/proc/sysvipc/shm", "shmid", []string{"cpid", "lpid"}},
+		{"sysv_msg", "/proc/sysvipc/msg", "msqid", []string{"lspid", "lrpid"}},
+	} {
+		for _, row := range readSysvTable(table.path) {
+			for _, column := range table.pids {
+				user, err := strconv.Atoi(row[column])
+				if err != nil || !a.tree[int32(user)] {
+					continue
+				}
+				a.add(int32(user), task.Finding_WARN, table.resource, fmt.Sprintf("key %s, id %s", row["key"], row[table.id]), fix)
+				break
+			}
+		}
+	}
+}
+
+// readSysvTable reads a /proc/sysvipc table into rows keyed by column name
+func readSysvTable(path string) []map[string]string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) < 2 {
+		return nil
+	}
+
+	header := strings.Fields(lines[0])
+	var rows []map[string]string
+	for _, line := range lines[1:] {
+		row := map[string]string{}
+		for i, value := range strings.Fields(line) {
+			if i < len(header) {
+				row[header[i]] = value
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func (s *service) Analyze(ctx context.Context, args *task.AnalyzeArgs) (*task.AnalyzeResp, error) {
+	if args.PID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "pid must be set")
+	}
+
+	resp, err := s.client.Analyze(ctx, args.PID)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -0,0 +1,285 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/cedana/cedana/utils"
+	"github.com/checkpoint-restore/go-criu/v6/rpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Process jobs run in a cgroup v2 of their own, see utils.JobCgroup. CRIU manages
+// the cgroups on dump and restore: the job's cgroup gets dumped along with it,
+// and the restored job lands in a new cgroup of its own, under the limits of the
+// checkpoint and whatever the restore asks for on top. A job's cgroup goes once
+// no process is left in it, see reapCgroup.
+//
+// Jobs are started under cedana cgroup-wait, which holds off running the job
+// until the daemon has moved it into its cgroup, so nothing it forks can be
+// left outside.
+
+const CgroupWaitCommand = "cgroup-wait"
+
+// how often the cgroups being reaped are checked for processes left
+const cgroupReapInterval = time.Second
+
+func limitsOf(limits *task.ResourceLimits) utils.CgroupLimits {
+	if limits == nil {
+		return utils.CgroupLimits{}
+	}
+	return utils.CgroupLimits{MemoryMax: limits.MemoryMax, CPUs: limits.CPUs, PidsMax: limits.PidsMax}
+}
+
+func validateLimits(limits *task.ResourceLimits) error {
+	return limitsOf(limits).Validate()
+}
+
+// mergeLimits returns the limits of update set over base
+func mergeLimits(base, update *task.ResourceLimits) *task.ResourceLimits {
+	if base == nil {
+		return update
+	}
+	merged := proto.Clone(base).(*task.ResourceLimits)
+	proto.Merge(merged, update)
+	return merged
+}
+
+// jobCgroup creates the cgroup of a job under the limits given, or reuses the
+// one it had
+func (c *Client) jobCgroup(name string, limits *task.ResourceLimits) (string, error) {
+	c.cgroupsMu.Lock()
+	defer c.cgroupsMu.Unlock()
+
+	cgroup, err := utils.JobCgroup(c.config.Cgroup.ParentPath(), name)
+	if err != nil {
+		return "", err
+	}
+	// it's about to get a job again
+	if stop, ok := c.reaping[cgroup]; ok {
+		close(stop)
+		delete(c.reaping, cgroup)
+	}
+	if err := utils.SetCgroupLimits(cgroup, limitsOf(limits)); err != nil {
+		return "", err
+	}
+	return cgroup, nil
+}
+
+// reapCgroup removes the cgroup of a job once no process is left in it, unless
+// it's taken by another job first
+func (c *Client) reapCgroup(cgroup string) {
+	if cgroup == "" {
+		return
+	}
+
+	c.cgroupsMu.Lock()
+	if _, ok := c.reaping[cgroup]; ok {
+		c.cgroupsMu.Unlock()
+		return
+	}
+	if c.reaping == nil {
+		c.reaping = map[string]chan struct{}{}
+	}
+	stop := make(chan struct{})
+	c.reaping[cgroup] = stop
+	c.cgroupsMu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(cgroupReapInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+
+			populated, err := utils.CgroupPopulated(cgroup)
+			if err == nil && populated {
+				continue
+			}
+
+			c.cgroupsMu.Lock()
+			select {
+			case <-stop:
+				c.cgroupsMu.Unlock()
+				return
+			default:
+			}
+			delete(c.reaping, cgroup)
+			if err == nil {
+				err = utils.RemoveCgroup(cgroup)
+			}
+			c.cgroupsMu.Unlock()
+
+			if err != nil && !os.IsNotExist(err) {
+				c.logger.Warn().Msgf("could not remove cgroup %s: %v", cgroup, err)
+			}
+			return
+		}
+	}()
+}
+
+// reapCgroups reaps the job cgroups left from before the daemon started
+func (c *Client) reapCgroups() {
+	parent := c.config.Cgroup.ParentPath()
+	entries, err := os.ReadDir(filepath.Join(utils.CgroupMount, parent))
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			c.reapCgroup("/" + parent + "/" + entry.Name())
+		}
+	}
+}
+
+// inCgroup makes cmd wait under cedana cgroup-wait until it's moved into its
+// cgroup. Once cmd is started and moved, a byte written to the returned pipe
+// lets it run, closing the pipe without one makes it exit instead.
+func inCgroup(cmd *exec.Cmd) (*os.File, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("could not find the cedana binary to run the job through: %w", err)
+	}
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	cmd.ExtraFiles = append(cmd.ExtraFiles, r)
+	fd := 2 + len(cmd.ExtraFiles)
+	cmd.Args = append([]string{exe, CgroupWaitCommand, strconv.Itoa(fd), "--", cmd.Path}, cmd.Args[1:]...)
+	cmd.Path = exe
+	return w, nil
+}
+
+// CgroupWait waits for the go ahead of the daemon on fd, then runs argv in place
+// of the current process
+func CgroupWait(fd int, argv []string) error {
+	if len(argv) == 0 {
+		return fmt.Errorf("no command to run")
+	}
+	sync := os.NewFile(uintptr(fd), "cgroup-wait")
+	if sync == nil {
+		return fmt.Errorf("invalid fd %d", fd)
+	}
+	buf := make([]byte, 1)
+	if _, err := io.ReadFull(sync, buf); err != nil {
+		return fmt.Errorf("daemon gave up on the job: %w", err)
+	}
+	sync.Close()
+
+	path, err := exec.LookPath(argv[0])
+	if err != nil {
+		return err
+	}
+	return syscall.Exec(path, argv, os.Environ())
+}
+
+// cgroupOf returns the job cgroup pid is in, empty if it isn't in one
+func (c *Client) cgroupOf(pid int32) string {
+	cgroup, err := utils.ProcessCgroup(pid)
+	if err != nil || !strings.HasPrefix(cgroup, "/"+c.config.Cgroup.ParentPath()+"/") {
+		return ""
+	}
+	return cgroup
+}
+
+// cgroupLimits reads the limits of a job cgroup, nil if it has none
+func cgroupLimits(cgroup string) *task.ResourceLimits {
+	if cgroup == "" {
+		return nil
+	}
+	limits, err := utils.ReadCgroupLimits(cgroup)
+	if err != nil || limits == (utils.CgroupLimits{}) {
+		return nil
+	}
+	return &task.ResourceLimits{MemoryMax: limits.MemoryMax, CPUs: limits.CPUs, PidsMax: limits.PidsMax}
+}
+
+// restoreCgroup has CRIU restore the job into a new cgroup named name, if it was
+// checkpointed in one or the restore asks for limits. Returns the cgroup, for
+// the caller to reap once the restore is through.
+func (c *Client) restoreCgroup(opts *rpc.CriuOpts, state *task.ProcessState, name string, limits *task.ResourceLimits) (string, error) {
+	if state.Cgroup == "" && limits == nil {
+		return "", nil
+	}
+	cgroup, err := c.jobCgroup(name, mergeLimits(state.Limits, limits))
+	if err != nil {
+		return "", err
+	}
+	// the new cgroup takes the place of the one the job was dumped from
+	opts.ManageCgroups = proto.Bool(true)
+	opts.ManageCgroupsMode = rpc.CriuCgMode_SOFT.Enum()
+	opts.CgRoot = append(opts.CgRoot, &rpc.CgroupRoot{Path: proto.String(cgroup)})
+	return cgroup, nil
+}
+
+// UpdateJob changes the limits of a running job. Jobs without a cgroup, started
+// before they had one, get one with all of their processes moved in.
+func (s *service) UpdateJob(ctx context.Context, args *task.UpdateJobArgs) (*task.UpdateJobResp, error) {
+	if args.JobID == "" {
+		return nil, status.Error(codes.InvalidArgument, "job id cannot be empty")
+	}
+	if args.Limits == nil {
+		return nil, status.Error(codes.InvalidArgument, "no limits to update")
+	}
+	if err := validateLimits(args.Limits); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	state, err := s.client.db.GetStateFromID(args.JobID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("job %s not found: %v", args.JobID, err))
+	}
+	if state.Flag != task.FlagEnum_JOB_RUNNING || state.PID == 0 || syscall.Kill(int(state.PID), 0) != nil {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("job %s is not running", args.JobID))
+	}
+
+	cgroup := s.client.cgroupOf(state.PID)
+	if cgroup == "" {
+		if cgroup, err = s.client.jobCgroup(args.JobID, nil); err != nil {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("could not create the cgroup of job %s: %v", args.JobID, err))
+		}
+		_, members, err := jobTrees(state.PID, state.Scope, state.Trees)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for _, member := range members {
+			if err := utils.AddToCgroup(cgroup, member.PID); err != nil && !isGone(member.PID) {
+				return nil, status.Error(codes.Internal, fmt.Sprintf("could not move process %d into cgroup %s: %v", member.PID, cgroup, err))
+			}
+		}
+		s.client.reapCgroup(cgroup)
+	}
+
+	if err := utils.SetCgroupLimits(cgroup, limitsOf(args.Limits)); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.logger.Info().Msgf("updated limits of job %s in cgroup %s", args.JobID, cgroup)
+
+	state.Cgroup = cgroup
+	state.Limits = cgroupLimits(cgroup)
+	if err := s.client.db.ReplaceProcessState(args.JobID, state); err != nil {
+		s.logger.Warn().Msgf("could not record limits of job %s: %v", args.JobID, err)
+	}
+
+	return &task.UpdateJobResp{Limits: state.Limits}, nil
+}
+
+// isGone tells whether pid exited
+func isGone(pid int32) bool {
+	return syscall.Kill(int(pid), 0) == syscall.ESRCH
+}
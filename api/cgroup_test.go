@@ -0,0 +1,32 @@
+package api
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+)
+
+func TestInCgroup(t *testing.T) {
+	cmd := exec.Command("/bin/sh", "-c", "exit 3")
+	sync, err := inCgroup(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sync.Close()
+
+	exe, _ := os.Executable()
+	want := []string{exe, CgroupWaitCommand, "3", "--", "/bin/sh", "-c", "exit 3"}
+	if cmd.Path != exe || len(cmd.Args) != len(want) {
+		t.Fatalf("expected %v, got %s %v", want, cmd.Path, cmd.Args)
+	}
+	for i := range want {
+		if cmd.Args[i] != want[i] {
+			t.Errorf("expected %v, got %v", want, cmd.Args)
+			break
+		}
+	}
+	if len(cmd.ExtraFiles) != 1 {
+		t.Fatalf("expected the waiting end of the pipe passed on, got %v", cmd.ExtraFiles)
+	}
+	cmd.ExtraFiles[0].Close()
+}
@@ -0,0 +1,244 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/cedana/cedana/utils"
+	"github.com/checkpoint-restore/go-criu/v6/rpc"
+	"golang.org/x/sys/unix"
+	"google.golang.org/protobuf/proto"
+)
+
+// oldest CRIU we can checkpoint with, the container code needs the same
+const minCriuVersion = 30000
+
+// CheckHost probes what checkpoint/restore needs from the host, so missing
+// privileges, CRIU or kernel features show up before a dump fails halfway.
+func (c *Client) CheckHost(ctx context.Context) *task.CheckHostResp {
+	_, checkSpan := c.tracer.Start(ctx, "check-host")
+	defer checkSpan.End()
+
+	var checks []*task.HostCheck
+	checks = append(checks, checkPrivileges())
+	checks = append(checks, c.checkCriu()...)
+	checks = append(checks, checkCgroups())
+	checks = append(checks, checkGPUController())
+	checks = append(checks, c.checkConfig())
+
+	resp := &task.CheckHostResp{Checks: checks}
+	for _, check := range checks {
+		if check.Result > resp.Result {
+			resp.Result = check.Result
+		}
+	}
+	return resp
+}
+
+func pass(name, format string, a ...interface{}) *task.HostCheck {
+	return &task.HostCheck{Name: name, Result: task.HostCheck_PASS, Message: fmt.Sprintf(format, a...)}
+}
+
+func warn(name, format string, a ...interface{}) *task.HostCheck {
+	return &task.HostCheck{Name: name, Result: task.HostCheck_WARN, Message: fmt.Sprintf(format, a...)}
+}
+
+func fail(name, format string, a ...interface{}) *task.HostCheck {
+	return &task.HostCheck{Name: name, Result: task.HostCheck_FAIL, Message: fmt.Sprintf(format, a...)}
+}
+
+func checkPrivileges() *task.HostCheck {
+	const name = "privileges"
+
+	caps, err := effectiveCaps()
+	if err != nil {
+		if os.Geteuid() == 0 {
+			return warn(name, "running as root, but could not read capabilities: %v", err)
+		}
+		return fail(name, "not running as root, and could not read capabilities: %v", err)
+	}
+
+	hasCap := func(capability int) bool { return caps&(1<<uint(capability)) != 0 }
+	switch {
+	case hasCap(unix.CAP_SYS_ADMIN):
+		if os.Geteuid() == 0 {
+			return pass(name, "running as root with CAP_SYS_ADMIN")
+		}
+		return pass(name, "running with CAP_SYS_ADMIN")
+	case hasCap(unix.CAP_CHECKPOINT_RESTORE):
+		return warn(name, "running with CAP_CHECKPOINT_RESTORE but not CAP_SYS_ADMIN, only unprivileged processes can be checkpointed")
+	default:
+		return fail(name, "cedana is not running as root, nor with CAP_SYS_ADMIN or CAP_CHECKPOINT_RESTORE")
+	}
+}
+
+// effectiveCaps reads the effective capability set of the daemon
+func effectiveCaps() (uint64, error) {
+	file, err := os.Open("/proc/self/status")
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "CapEff:") {
+			return strconv.ParseUint(strings.TrimSpace(strings.TrimPrefix(line, "CapEff:")), 16, 64)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("no CapEff in /proc/self/status")
+}
+
+func (c *Client) checkCriu() []*task.HostCheck {
+	path, err := exec.LookPath("criu")
+	if err != nil {
+		return []*task.HostCheck{fail("criu", "criu binary not found in PATH")}
+	}
+
+	version, err := c.CRIU.GetCriuVersion()
+	if err != nil {
+		return []*task.HostCheck{fail("criu", "could not get the version of %s: %v", path, err)}
+	}
+	formatted := fmt.Sprintf("%d.%d.%d", version/10000, version/100%100, version%100)
+	if version < minCriuVersion {
+		return []*task.HostCheck{fail("criu", "%s is version %s, %d.%d or newer is needed", path, formatted, minCriuVersion/10000, minCriuVersion/100%100)}
+	}
+
+	checks := []*task.HostCheck{pass("criu", "%s, version %s", path, formatted)}
+
+	features, err := c.CRIU.FeatureCheck(&rpc.CriuFeatures{
+		MemTrack:   proto.Bool(true),
+		LazyPages:  proto.Bool(true),
+		PidfdStore: proto.Bool(true),
+	})
+	if err != nil {
+		return append(checks, warn("criu features", "could not check CRIU features: %v", err))
+	}
+
+	feature := func(name string, supported bool, without string) *task.HostCheck {
+		name = "criu feature " + name
+		if supported {
+			return pass(name, "supported")
+		}
+		return warn(name, "not supported, %s", without)
+	}
+
+	return append(checks,
+		feature("mem_track", features.GetMemTrack(), "pre-dumps are unavailable"),
+		feature("lazy_pages", features.GetLazyPages(), "lazy migrations are unavailable"),
+		feature("pidfd_store", features.GetPidfdStore(), "pid reuse between pre-dumps goes undetected"),
+	)
+}
+
+func checkCgroups() *task.HostCheck {
+	const name = "cgroups"
+
+	var st unix.Statfs_t
+	if err := unix.Statfs("/sys/fs/cgroup", &st); err != nil {
+		return fail(name, "could not stat /sys/fs/cgroup: %v", err)
+	}
+
+	if st.Type == unix.CGROUP2_SUPER_MAGIC {
+		return pass(name, "cgroup v2 (unified)")
+	}
+
+	if err := unix.Statfs("/sys/fs/cgroup/unified", &st); err == nil && st.Type == unix.CGROUP2_SUPER_MAGIC {
+		return pass(name, "cgroup v1 (hybrid, with cgroup v2 at /sys/fs/cgroup/unified)")
+	}
+	return pass(name, "cgroup v1")
+}
+
+func checkGPUController() *task.HostCheck {
+	const name = "gpu controller"
+
+	path := os.Getenv("GPU_CONTROLLER_PATH")
+	if path == "" {
+		return warn(name, "GPU_CONTROLLER_PATH is not set, GPU checkpoints are unavailable")
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		return fail(name, "GPU_CONTROLLER_PATH is set to %s, which can't be found: %v", path, err)
+	}
+	if fi.Mode().Perm()&0o111 == 0 {
+		return fail(name, "%s is not executable", path)
+	}
+	return pass(name, "%s", path)
+}
+
+func (c *Client) checkConfig() *task.HostCheck {
+	const name = "config"
+
+	var problems []string
+	add := func(err error) {
+		if err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+
+	cfg := c.config
+	add(utils.ValidateCompression(cfg.Client.Compression))
+	add(utils.ValidateSignaturePolicy(cfg.Signing.Policy))
+	add(cfg.Retention.Validate())
+	add(cfg.Restore.Validate())
+	add(cfg.Cgroup.Validate())
+	add(cfg.Metrics.Validate())
+	add(utils.ValidateNetworkLock(cfg.Network.LockBackend))
+	if bin := map[string]string{utils.NetworkLockNftables: "nft", utils.NetworkLockIptables: "iptables"}[cfg.Network.LockBackend]; bin != "" {
+		if _, err := exec.LookPath(bin); err != nil {
+			problems = append(problems, fmt.Sprintf("network lock backend is %s, but %s is not installed", cfg.Network.LockBackend, bin))
+		}
+	}
+
+	// a missing criu gets reported by its own check, profiles are then only
+	// checked for themselves
+	version, _ := c.CRIU.GetCriuVersion()
+	var profiles []string
+	for name := range cfg.Criu.Profiles {
+		profiles = append(profiles, name)
+	}
+	sort.Strings(profiles)
+	for _, name := range profiles {
+		profile := cfg.Criu.Profiles[name]
+		if err := profile.Validate(version); err != nil {
+			problems = append(problems, fmt.Sprintf("criu profile %s: %v", name, err))
+		}
+	}
+
+	_, err := utils.LoadKeyring(cfg.Encryption)
+	add(err)
+	_, err = utils.LoadSigner(cfg.Signing)
+	add(err)
+	if cfg.Signing.TrustStore != "" {
+		_, err = utils.LoadTrustStore(cfg.Signing.TrustStore)
+		add(err)
+	} else if cfg.Signing.Policy == utils.SignaturePolicyEnforce {
+		problems = append(problems, "signature policy is enforce, but no trust store is set")
+	}
+
+	if dir := cfg.SharedStorage.DumpStorageDir; dir != "" {
+		if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+			problems = append(problems, fmt.Sprintf("dump storage dir %s is not a directory", dir))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fail(name, "%s", strings.Join(problems, "; "))
+	}
+	return pass(name, "valid")
+}
+
+func (s *service) CheckHost(ctx context.Context, args *task.CheckHostArgs) (*task.CheckHostResp, error) {
+	return s.client.CheckHost(ctx), nil
+}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 
 	"github.com/cedana/cedana/api/services/task"
@@ -32,10 +33,15 @@ type Client struct {
 	// db meta/state store
 	db *DB
 
-	// a separate client is created for each connection to the gRPC client,
-	// and the jobID is set at instantiation time. We rely on whatever is callig
-	// InstantiateClient() to set the jobID correctly.
-	jobID string
+	// IDs of the jobs being dumped
+	dumping sync.Map
+	// IDs of the restores running, whose working dirs can't be swept
+	restoring sync.Map
+	// masters of the ptys of restored jobs, by pid
+	ptys sync.Map
+	// job cgroups waiting to be empty to be removed, see reapCgroup
+	cgroupsMu sync.Mutex
+	reaping   map[string]chan struct{}
 
 	// used for perf, CEDANA_OTEL_ENABLED needs to be set
 	tracer trace.Tracer
@@ -180,6 +186,7 @@ func (c *Client) generateState(pid int32) (*task.ProcessState, error) {
 		IsRunning:       isRunning,
 		OpenConnections: openConnections,
 		Status:          strings.Join(status, ""),
+		Usage:           processUsage(pid),
 	}
 
 	return &state, nil
@@ -11,7 +11,9 @@ import (
 	"github.com/cedana/cedana/utils"
 	bolt "go.etcd.io/bbolt"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 )
 
@@ -84,6 +86,25 @@ func TestClient_RunTask(t *testing.T) {
 		}
 
 	})
+
+	t.Run("PolicyRefusedBeforeStart", func(t *testing.T) {
+		client, err := setup(t)
+		if err != nil {
+			t.Error("error setting up grpc client")
+		}
+
+		ctx := context.Background()
+
+		for _, args := range []*task.StartTaskArgs{
+			{Task: "sleep 10", CheckpointPolicy: &task.CheckpointPolicy{IntervalSeconds: 60}},
+			{Task: "sleep 10", Id: "policy-test", CheckpointPolicy: &task.CheckpointPolicy{Cron: "not a schedule"}},
+		} {
+			_, err = client.StartTask(ctx, args)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("expected the policy %v to be refused, got %v", args.CheckpointPolicy, err)
+			}
+		}
+	})
 }
 
 func TestClient_TryStartJob(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"runtime"
 	"strconv"
+	"sync"
 	"syscall"
 
 	"github.com/checkpoint-restore/go-criu/v6/rpc"
@@ -19,6 +20,9 @@ import (
 // Code for interfacing with CRIU. We could use go-criu, but there are certain limitations in the abstractions
 // presented. Most of the code found here is lifted from https://github.com/checkpoint-restore/go-criu/blob/master/main.go.
 type Criu struct {
+	// cached by GetCriuVersion
+	versionMu sync.Mutex
+	version   int
 }
 
 func (c *Criu) sendAndRecv(reqB []byte, sk *os.File) ([]byte, int, error) {
@@ -84,6 +88,12 @@ func SendFile(socket *os.File, file *os.File) error {
 }
 
 func (c *Criu) doSwrkWithResp(reqType rpc.CriuReqType, opts *rpc.CriuOpts, nfy *Notify, extraFiles []*os.File, features *rpc.CriuFeatures) (*rpc.CriuResp, error) {
+	return c.doSwrkWrapped(reqType, opts, nfy, extraFiles, features, nil)
+}
+
+// doSwrkWrapped runs criu swrk through wrap, which rewrites the command to start
+// criu from some other process. That process is left running.
+func (c *Criu) doSwrkWrapped(reqType rpc.CriuReqType, opts *rpc.CriuOpts, nfy *Notify, extraFiles []*os.File, features *rpc.CriuFeatures, wrap func(*exec.Cmd) error) (*rpc.CriuResp, error) {
 	var resp *rpc.CriuResp
 
 	req := rpc.CriuReq{
@@ -106,6 +116,7 @@ func (c *Criu) doSwrkWithResp(reqType rpc.CriuReqType, opts *rpc.CriuOpts, nfy *
 
 	cln := os.NewFile(uintptr(fds[0]), "criu-xprt-cln")
 	syscall.CloseOnExec(fds[0])
+	defer cln.Close()
 
 	srv := os.NewFile(uintptr(fds[1]), "criu-xprt-srv")
 	defer srv.Close()
@@ -115,11 +126,26 @@ func (c *Criu) doSwrkWithResp(reqType rpc.CriuReqType, opts *rpc.CriuOpts, nfy *
 	if extraFiles != nil {
 		cmd.ExtraFiles = append(cmd.ExtraFiles, extraFiles...)
 	}
+	if wrap != nil {
+		if err := wrap(cmd); err != nil {
+			return nil, err
+		}
+	}
 
 	err = cmd.Start()
 	if err != nil {
 		return nil, err
 	}
+	if wrap == nil {
+		// closing our end of the socket lets criu exit on every return, to be
+		// reaped
+		defer func() {
+			if cmd.ProcessState == nil {
+				cln.Close()
+				cmd.Wait()
+			}
+		}()
+	}
 
 	for {
 		reqB, err := proto.Marshal(&req)
@@ -187,15 +213,34 @@ func (c *Criu) doSwrkWithResp(reqType rpc.CriuReqType, opts *rpc.CriuOpts, nfy *
 		}
 	}
 
-	// cleanup
+	// cleanup. criu swrk keeps waiting for a follow-up request after a
+	// pre-dump or page server, so close our end of the socket to let it exit.
+	cln.Close()
+	if wrap != nil {
+		return resp, nil
+	}
 	err = cmd.Wait()
-	if err != nil {
+	if err != nil && !swrkClosed(reqType, err) {
 		return nil, err
 	}
 
 	return resp, nil
 }
 
+// criu swrk exits with this status when it finds the socket closed while waiting
+// for a follow-up request, from its main returning -1
+const swrkClosedStatus = 255
+
+// swrkClosed tells whether criu exited with err only because the socket was
+// closed after a pre-dump or page server, which wait for a follow-up request
+func swrkClosed(reqType rpc.CriuReqType, err error) bool {
+	if reqType != rpc.CriuReqType_PRE_DUMP && reqType != rpc.CriuReqType_PAGE_SERVER {
+		return false
+	}
+	var exitErr *exec.ExitError
+	return errors.As(err, &exitErr) && exitErr.ExitCode() == swrkClosedStatus
+}
+
 // Dump dumps a process
 func (c *Criu) Dump(opts *rpc.CriuOpts, nfy *Notify) (*rpc.CriuResp, error) {
 	return c.doSwrk(rpc.CriuReqType_DUMP, opts, nfy, nil)
@@ -206,7 +251,52 @@ func (c *Criu) Restore(opts *rpc.CriuOpts, nfy *Notify, extraFiles []*os.File) (
 	return c.doSwrk(rpc.CriuReqType_RESTORE, opts, nfy, extraFiles)
 }
 
+// RestoreWrapped restores a process with criu started through wrap, see doSwrkWrapped
+func (c *Criu) RestoreWrapped(opts *rpc.CriuOpts, nfy *Notify, extraFiles []*os.File, wrap func(*exec.Cmd) error) (*rpc.CriuResp, error) {
+	resp, err := c.doSwrkWrapped(rpc.CriuReqType_RESTORE, opts, nfy, extraFiles, nil, wrap)
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetType() != rpc.CriuReqType_RESTORE {
+		return nil, errors.New("unexpected CRIU RPC response")
+	}
+	return resp, nil
+}
+
+// PreDump does a pre-dump
+func (c *Criu) PreDump(opts *rpc.CriuOpts, nfy *Notify) (*rpc.CriuResp, error) {
+	return c.doSwrk(rpc.CriuReqType_PRE_DUMP, opts, nfy, nil)
+}
+
+// StartPageServer starts a daemonized page server, receiving pages into opts.ImagesDirFd
+func (c *Criu) StartPageServer(opts *rpc.CriuOpts) (*rpc.CriuResp, error) {
+	return c.doSwrk(rpc.CriuReqType_PAGE_SERVER, opts, nil, nil)
+}
+
+// FeatureCheck asks CRIU which of the requested features are supported
+// by the running kernel and CRIU binary.
+func (c *Criu) FeatureCheck(features *rpc.CriuFeatures) (*rpc.CriuFeatures, error) {
+	resp, err := c.doSwrkWithResp(rpc.CriuReqType_FEATURE_CHECK, nil, nil, nil, features)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetType() != rpc.CriuReqType_FEATURE_CHECK {
+		return nil, fmt.Errorf("unexpected CRIU RPC response")
+	}
+
+	return resp.GetFeatures(), nil
+}
+
+// GetCriuVersion gets the version of CRIU, e.g. 31700 for 3.17. CRIU is only asked
+// until it answers, the version is cached after that.
 func (c *Criu) GetCriuVersion() (int, error) {
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+	if c.version != 0 {
+		return c.version, nil
+	}
+
 	resp, err := c.doSwrkWithResp(rpc.CriuReqType_VERSION, nil, nil, nil, nil)
 	if err != nil {
 		return 0, err
@@ -228,6 +318,7 @@ func (c *Criu) GetCriuVersion() (int, error) {
 		version += 100
 	}
 
+	c.version = version
 	return version, nil
 }
 
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/cedana/cedana/utils"
+)
+
+// criuProfile resolves the CRIU options of a request: the profile it names from
+// the config, then its own options over that, checked against the CRIU that will
+// run them. Nil when the request doesn't change any.
+func (c *Client) criuProfile(req *task.CriuProcessOpts) (*utils.CriuProfile, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	var p utils.CriuProfile
+	if req.Profile != "" {
+		profile, ok := c.config.Criu.Profiles[strings.ToLower(req.Profile)]
+		if !ok {
+			return nil, fmt.Errorf("unknown criu profile %q", req.Profile)
+		}
+		p = profile
+	}
+
+	if req.GhostLimit != 0 {
+		p.GhostLimit = req.GhostLimit
+	}
+	p.External = append(append([]string{}, p.External...), req.External...)
+	switch req.Tcp {
+	case task.CriuProcessOpts_TCP_ESTABLISHED:
+		p.Tcp = utils.CriuTcpEstablished
+	case task.CriuProcessOpts_TCP_CLOSE:
+		p.Tcp = utils.CriuTcpClose
+	}
+	if req.FileLocks != nil {
+		p.FileLocks = req.FileLocks
+	}
+	if req.LinkRemap != nil {
+		p.LinkRemap = req.LinkRemap
+	}
+	if req.SkipInFlight != nil {
+		p.SkipInFlight = req.SkipInFlight
+	}
+	if req.ManageCgroupsMode != "" {
+		p.ManageCgroupsMode = req.ManageCgroupsMode
+	}
+	if req.LogLevel != nil {
+		p.LogLevel = req.LogLevel
+	}
+	if req.LeaveRunning != nil {
+		p.LeaveRunning = req.LeaveRunning
+	}
+
+	version, err := c.CRIU.GetCriuVersion()
+	if err != nil {
+		return nil, fmt.Errorf("could not get criu version: %w", err)
+	}
+	if err := p.Validate(version); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -16,9 +17,12 @@ func NewDB() *DB {
 	return &DB{}
 }
 
+// dbPath is where the embedded db lives, tests point it elsewhere
+var dbPath = "/tmp/cedana.db"
+
 func NewBoltConn() (*bolt.DB, error) {
 	// set up embedded key-value db
-	conn, err := bolt.Open("/tmp/cedana.db", 0o777, nil)
+	conn, err := bolt.Open(dbPath, 0o777, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +70,41 @@ func (db *DB) CreateOrUpdateCedanaProcess(id string, state *task.ProcessState) e
 	})
 }
 
+// ReplaceProcessState makes state, under its new pid, the only entry of the job,
+// for when a restore brings the job back as another process
+func (db *DB) ReplaceProcessState(id string, state *task.ProcessState) error {
+	conn, err := NewBoltConn()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	return conn.Update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucketIfNotExists([]byte("default"))
+		if err != nil {
+			return err
+		}
+
+		if root.Bucket([]byte(id)) != nil {
+			if err := root.DeleteBucket([]byte(id)); err != nil {
+				return err
+			}
+		}
+		job, err := root.CreateBucket([]byte(id))
+		if err != nil {
+			return err
+		}
+
+		marshaledState, err := json.Marshal(state)
+		if err != nil {
+			return err
+		}
+
+		return job.Put([]byte(strconv.Itoa(int(state.PID))), marshaledState)
+	})
+}
+
 // This automatically gets the latest entry in the job bucket
 func (db *DB) GetStateFromID(id string) (*task.ProcessState, error) {
 	var state task.ProcessState
@@ -277,3 +316,543 @@ func (db *DB) GetLatestLocalCheckpoints(id string) ([]*string, error) {
 
 	return checkpoints, err
 }
+
+// PreDumpChain tracks the iterative pre-dumps taken of a runc container, so that
+// a later final dump can reference them as its parent images.
+// structure is pre-dump -> containerId: chain
+type PreDumpChain struct {
+	ImagesDirectory string
+	// pre-dump image directories, relative to ImagesDirectory, oldest first
+	PreDumps []string
+}
+
+func (db *DB) GetPreDumpChain(containerId string) (*PreDumpChain, error) {
+	var chain *PreDumpChain
+
+	conn, err := NewBoltConn()
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+
+	err = conn.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte("pre-dump"))
+		if root == nil {
+			return nil
+		}
+
+		marshaledChain := root.Get([]byte(containerId))
+		if marshaledChain == nil {
+			return nil
+		}
+
+		chain = &PreDumpChain{}
+		return json.Unmarshal(marshaledChain, chain)
+	})
+
+	return chain, err
+}
+
+func (db *DB) UpdatePreDumpChain(containerId string, chain *PreDumpChain) error {
+	conn, err := NewBoltConn()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	return conn.Update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucketIfNotExists([]byte("pre-dump"))
+		if err != nil {
+			return err
+		}
+
+		marshaledChain, err := json.Marshal(chain)
+		if err != nil {
+			return err
+		}
+
+		return root.Put([]byte(containerId), marshaledChain)
+	})
+}
+
+func (db *DB) DeletePreDumpChain(containerId string) error {
+	conn, err := NewBoltConn()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	return conn.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte("pre-dump"))
+		if root == nil {
+			return nil
+		}
+
+		return root.Delete([]byte(containerId))
+	})
+}
+
+// Checkpoint policies of jobs, picked back up by the scheduler when the daemon starts
+// structure is policies -> jobId: policy
+func (db *DB) GetCheckpointPolicies() (map[string]*task.CheckpointPolicy, error) {
+	policies := map[string]*task.CheckpointPolicy{}
+
+	conn, err := NewBoltConn()
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+
+	err = conn.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte("policies"))
+		if root == nil {
+			return nil
+		}
+
+		return root.ForEach(func(k, v []byte) error {
+			policy := &task.CheckpointPolicy{}
+			if err := json.Unmarshal(v, policy); err != nil {
+				return err
+			}
+			policies[string(k)] = policy
+			return nil
+		})
+	})
+
+	return policies, err
+}
+
+// SetCheckpointPolicy stores the policy of a job, or deletes it if policy is nil
+func (db *DB) SetCheckpointPolicy(id string, policy *task.CheckpointPolicy) error {
+	conn, err := NewBoltConn()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	return conn.Update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucketIfNotExists([]byte("policies"))
+		if err != nil {
+			return err
+		}
+
+		if policy == nil {
+			return root.Delete([]byte(id))
+		}
+
+		marshaledPolicy, err := json.Marshal(policy)
+		if err != nil {
+			return err
+		}
+
+		return root.Put([]byte(id), marshaledPolicy)
+	})
+}
+
+// CheckpointRecord is a checkpoint taken of a job, kept around so the retention
+// policies can delete older ones
+// structure is checkpoints -> jobId -> timestamp: record
+type CheckpointRecord struct {
+	// unix nanoseconds, also the key of the record
+	Timestamp int64
+	// directory the checkpoint was dumped into, removed along with the record
+	Dir            string
+	CheckpointPath string
+	// bytes on disk, of Dir and CheckpointPath
+	Size int64
+	// set for remote checkpoints, along with the sha256 of what was uploaded
+	CheckpointID string
+	Checksum     string
+	Reason       *task.CheckpointReason
+}
+
+func recordKey(timestamp int64) []byte {
+	// zero padded so keys sort in time order
+	return []byte(fmt.Sprintf("%020d", timestamp))
+}
+
+func (db *DB) AddCheckpointRecord(id string, record *CheckpointRecord) error {
+	conn, err := NewBoltConn()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	return conn.Update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucketIfNotExists([]byte("checkpoints"))
+		if err != nil {
+			return err
+		}
+
+		job, err := root.CreateBucketIfNotExists([]byte(id))
+		if err != nil {
+			return err
+		}
+
+		marshaledRecord, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+
+		return job.Put(recordKey(record.Timestamp), marshaledRecord)
+	})
+}
+
+// ListCheckpointRecords returns the checkpoints taken of a job, oldest first
+func (db *DB) ListCheckpointRecords(id string) ([]*CheckpointRecord, error) {
+	var records []*CheckpointRecord
+
+	conn, err := NewBoltConn()
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+
+	err = conn.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte("checkpoints"))
+		if root == nil {
+			return nil
+		}
+
+		job := root.Bucket([]byte(id))
+		if job == nil {
+			return nil
+		}
+
+		return job.ForEach(func(k, v []byte) error {
+			record := &CheckpointRecord{}
+			if err := json.Unmarshal(v, record); err != nil {
+				return err
+			}
+			records = append(records, record)
+			return nil
+		})
+	})
+
+	return records, err
+}
+
+// ListAllCheckpointRecords returns the checkpoints taken of every job, by job id
+func (db *DB) ListAllCheckpointRecords() (map[string][]*CheckpointRecord, error) {
+	records := map[string][]*CheckpointRecord{}
+
+	conn, err := NewBoltConn()
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+
+	err = conn.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte("checkpoints"))
+		if root == nil {
+			return nil
+		}
+
+		return root.ForEachBucket(func(k []byte) error {
+			id := string(k)
+			return root.Bucket(k).ForEach(func(k, v []byte) error {
+				record := &CheckpointRecord{}
+				if err := json.Unmarshal(v, record); err != nil {
+					return err
+				}
+				records[id] = append(records[id], record)
+				return nil
+			})
+		})
+	})
+
+	return records, err
+}
+
+// CheckpointChecksum finds the checksum a remote checkpoint was uploaded with,
+// empty if it wasn't uploaded from here
+func (db *DB) CheckpointChecksum(checkpointID string) (string, error) {
+	all, err := db.ListAllCheckpointRecords()
+	if err != nil {
+		return "", err
+	}
+	for _, records := range all {
+		for _, record := range records {
+			if record.CheckpointID == checkpointID {
+				return record.Checksum, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// SetCheckpointRecordID sets the remote id and checksum of the checkpoint of a job
+// at checkpointPath, once it's uploaded
+func (db *DB) SetCheckpointRecordID(id, checkpointPath, checkpointID, checksum string) error {
+	conn, err := NewBoltConn()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	return conn.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte("checkpoints"))
+		if root == nil {
+			return fmt.Errorf("could not find bucket")
+		}
+
+		job := root.Bucket([]byte(id))
+		if job == nil {
+			return fmt.Errorf("could not find job")
+		}
+
+		// the checkpoint just uploaded is most likely the last one
+		c := job.Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			record := &CheckpointRecord{}
+			if err := json.Unmarshal(v, record); err != nil {
+				return err
+			}
+			if record.CheckpointPath != checkpointPath {
+				continue
+			}
+
+			record.CheckpointID = checkpointID
+			record.Checksum = checksum
+			marshaledRecord, err := json.Marshal(record)
+			if err != nil {
+				return err
+			}
+			return job.Put(k, marshaledRecord)
+		}
+
+		return fmt.Errorf("could not find checkpoint %s", checkpointPath)
+	})
+}
+
+func (db *DB) DeleteCheckpointRecord(id string, timestamp int64) error {
+	conn, err := NewBoltConn()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	return conn.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte("checkpoints"))
+		if root == nil {
+			return nil
+		}
+
+		job := root.Bucket([]byte(id))
+		if job == nil {
+			return nil
+		}
+
+		return job.Delete(recordKey(timestamp))
+	})
+}
+
+// SetCheckpointGroup records the members of a group checkpoint, to restore it by
+func (db *DB) SetCheckpointGroup(group *task.GroupDumpResp) error {
+	conn, err := NewBoltConn()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	return conn.Update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucketIfNotExists([]byte("groups"))
+		if err != nil {
+			return err
+		}
+
+		marshaledGroup, err := json.Marshal(group)
+		if err != nil {
+			return err
+		}
+
+		return root.Put([]byte(group.GroupID), marshaledGroup)
+	})
+}
+
+func (db *DB) GetCheckpointGroup(id string) (*task.GroupDumpResp, error) {
+	var group task.GroupDumpResp
+
+	conn, err := NewBoltConn()
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+
+	err = conn.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte("groups"))
+		if root == nil {
+			return fmt.Errorf("could not find bucket")
+		}
+
+		marshaledGroup := root.Get([]byte(id))
+		if marshaledGroup == nil {
+			return fmt.Errorf("could not find group %s", id)
+		}
+
+		return json.Unmarshal(marshaledGroup, &group)
+	})
+
+	return &group, err
+}
+
+// ListJobStates returns the state of every job, by job id
+func (db *DB) ListJobStates() (map[string]*task.ProcessState, error) {
+	states := map[string]*task.ProcessState{}
+
+	conn, err := NewBoltConn()
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+
+	err = conn.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte("default"))
+		if root == nil {
+			return nil
+		}
+
+		return root.ForEachBucket(func(k []byte) error {
+			jobID := string(k)
+			return root.Bucket(k).ForEach(func(k, v []byte) error {
+				state := &task.ProcessState{}
+				if err := json.Unmarshal(v, state); err != nil {
+					return err
+				}
+				states[jobID] = state
+				return nil
+			})
+		})
+	})
+
+	return states, err
+}
+
+// AddJobMetrics records a usage sample of a job
+// structure is metrics -> jobId -> timestamp: sample
+func (db *DB) AddJobMetrics(id string, sample *task.JobMetricsSample) error {
+	conn, err := NewBoltConn()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	return conn.Update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucketIfNotExists([]byte("metrics"))
+		if err != nil {
+			return err
+		}
+
+		job, err := root.CreateBucketIfNotExists([]byte(id))
+		if err != nil {
+			return err
+		}
+
+		marshaledSample, err := json.Marshal(sample)
+		if err != nil {
+			return err
+		}
+
+		return job.Put(recordKey(sample.Timestamp), marshaledSample)
+	})
+}
+
+// ListJobMetrics returns the usage samples of a job taken since the unix
+// nanoseconds given, oldest first
+func (db *DB) ListJobMetrics(id string, since int64) ([]*task.JobMetricsSample, error) {
+	var samples []*task.JobMetricsSample
+
+	conn, err := NewBoltConn()
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+
+	err = conn.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte("metrics"))
+		if root == nil {
+			return nil
+		}
+
+		job := root.Bucket([]byte(id))
+		if job == nil {
+			return nil
+		}
+
+		c := job.Cursor()
+		for k, v := c.Seek(recordKey(since)); k != nil; k, v = c.Next() {
+			sample := &task.JobMetricsSample{}
+			if err := json.Unmarshal(v, sample); err != nil {
+				return err
+			}
+			samples = append(samples, sample)
+		}
+		return nil
+	})
+
+	return samples, err
+}
+
+// PruneJobMetrics deletes the usage samples of every job taken before the unix
+// nanoseconds given, and the jobs left without any. Returns the samples deleted.
+func (db *DB) PruneJobMetrics(before int64) (int, error) {
+	pruned := 0
+
+	conn, err := NewBoltConn()
+	if err != nil {
+		return 0, err
+	}
+
+	defer conn.Close()
+
+	err = conn.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte("metrics"))
+		if root == nil {
+			return nil
+		}
+
+		var empty [][]byte
+		err := root.ForEachBucket(func(id []byte) error {
+			job := root.Bucket(id)
+			c := job.Cursor()
+			// keys sort in time order, so the old ones come first
+			for k, _ := c.First(); k != nil && bytes.Compare(k, recordKey(before)) < 0; k, _ = c.First() {
+				if err := c.Delete(); err != nil {
+					return err
+				}
+				pruned++
+			}
+			if k, _ := c.First(); k == nil {
+				empty = append(empty, append([]byte(nil), id...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, id := range empty {
+			if err := root.DeleteBucket(id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return pruned, err
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/cedana/cedana/api/services/task"
+	bolt "go.etcd.io/bbolt"
+)
+
+// useTestDB points the db at a fresh file for the length of the test
+func useTestDB(t *testing.T) *DB {
+	t.Helper()
+	path := dbPath
+	dbPath = filepath.Join(t.TempDir(), "cedana.db")
+	t.Cleanup(func() {
+		dbPath = path
+	})
+	return NewDB()
+}
+
+// Records samples of two jobs out of order, lists them back in time order from a
+// point in time, and prunes the old ones, along with the job left without any.
+func TestJobMetrics(t *testing.T) {
+	db := useTestDB(t)
+
+	for _, sample := range []struct {
+		job       string
+		timestamp int64
+	}{
+		{"a", 300},
+		{"a", 100},
+		{"a", 200},
+		{"b", 50},
+	} {
+		if err := db.AddJobMetrics(sample.job, &task.JobMetricsSample{Timestamp: sample.timestamp}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	samples, err := db.ListJobMetrics("a", 150)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(samples) != 2 || samples[0].Timestamp != 200 || samples[1].Timestamp != 300 {
+		t.Fatalf("expected the samples at 200 and 300, got %v", samples)
+	}
+
+	pruned, err := db.PruneJobMetrics(250)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pruned != 3 {
+		t.Errorf("expected 3 samples pruned, got %d", pruned)
+	}
+
+	if samples, err = db.ListJobMetrics("a", 0); err != nil {
+		t.Fatal(err)
+	}
+	if len(samples) != 1 || samples[0].Timestamp != 300 {
+		t.Errorf("expected only the sample at 300 left, got %v", samples)
+	}
+
+	conn, err := NewBoltConn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	err = conn.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte("metrics"))
+		if root.Bucket([]byte("b")) != nil {
+			t.Error("expected the bucket of job b to go with its last sample")
+		}
+		if root.Bucket([]byte("a")) == nil {
+			t.Error("expected the bucket of job a to stay")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"github.com/cedana/cedana/types"
 	"github.com/cedana/cedana/utils"
 	"github.com/checkpoint-restore/go-criu/v6/rpc"
+	criustats "github.com/checkpoint-restore/go-criu/v6/stats"
 	"github.com/docker/docker/pkg/namesgenerator"
 	bolt "go.etcd.io/bbolt"
 	"go.opentelemetry.io/otel/attribute"
@@ -25,6 +27,15 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+const (
+	preDumpDirPrefix     = "pre-dump-"
+	maxPreDumpIterations = 10
+	// dir of the checkpoint the captured files are copied into
+	capturedFilesDir = "files"
+	// dir of the restore the files replaced by captured ones are backed up into
+	capturedFilesBackupDir = "files.orig"
+)
+
 // The bundle includes path to bundle and the runc/podman container id of the bundle. The bundle is a folder that includes the oci spec config.json
 // as well as the rootfs used for setting up the container. Sometimes rootfs can be defined elsewhere. Podman adds extra directories and files in their
 // bundle including a file called attach which is a unix socket for attaching stdin, stdout to the terminal
@@ -84,13 +95,10 @@ func (c *Client) prepareDump(ctx context.Context, pid int32, dir string, opts *r
 	// strip out non posix-compliant characters from the processname
 	formattedProcessName := regexp.MustCompile("[^a-zA-Z0-9_.-]").ReplaceAllString(*pname, "_")
 	formattedProcessName = strings.ReplaceAll(formattedProcessName, ".", "_")
-	processCheckpointDir := strings.Join([]string{formattedProcessName, time.Now().Format("02_01_2006_1504")}, "_")
-	checkpointFolderPath := filepath.Join(dir, processCheckpointDir)
-	_, err = os.Stat(filepath.Join(checkpointFolderPath))
+	processCheckpointDir := strings.Join([]string{formattedProcessName, time.Now().Format("02_01_2006_150405")}, "_")
+	checkpointFolderPath, err := newCheckpointFolder(dir, processCheckpointDir)
 	if err != nil {
-		if err := os.MkdirAll(checkpointFolderPath, 0o777); err != nil {
-			return "", err
-		}
+		return "", err
 	}
 
 	err = chmodRecursive(checkpointFolderPath, 0o777)
@@ -104,46 +112,316 @@ func (c *Client) prepareDump(ctx context.Context, pid int32, dir string, opts *r
 		return "", err
 	}
 
-	// c.copyOpenFiles(checkpointFolderPath, state)
-
 	return checkpointFolderPath, nil
 }
 
-func (c *Client) postDump(ctx context.Context, dumpdir string, state *task.ProcessState) {
+// newCheckpointFolder creates the folder name in dir for a dump to write to, with
+// a suffix if another dump already took it in the same second
+func newCheckpointFolder(dir, name string) (string, error) {
+	if err := os.MkdirAll(dir, 0o777); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, name)
+	for i := 1; ; i++ {
+		err := os.Mkdir(path, 0o777)
+		if err == nil {
+			return path, nil
+		}
+		if !os.IsExist(err) {
+			return "", err
+		}
+		path = filepath.Join(dir, fmt.Sprintf("%s_%d", name, i))
+	}
+}
+
+// filesToCapture lists the files of pids to copy into the checkpoint, once each
+func filesToCapture(pids []int32, capture task.FileCapture) []utils.OpenFile {
+	var files []utils.OpenFile
+	index := map[string]int{}
+	for _, pid := range pids {
+		open, err := utils.ProcessOpenFiles(pid)
+		if err != nil {
+			continue
+		}
+		for _, f := range open {
+			if capture == task.FileCapture_WRITTEN_FILES && !f.Writable() {
+				continue
+			}
+			if i, ok := index[f.Path]; ok {
+				if f.Pos > files[i].Pos {
+					files[i].Pos = f.Pos
+				}
+				continue
+			}
+			index[f.Path] = len(files)
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+// captureFiles copies files into the files dir of dumpdir. It runs after CRIU is
+// done with the processes but before they're resumed, so the copies have the
+// size CRIU recorded, which it checks on restore.
+func captureFiles(dumpdir string, files []utils.OpenFile) ([]*task.CapturedFile, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+	if err := os.MkdirAll(filepath.Join(dumpdir, capturedFilesDir), 0o777); err != nil {
+		return nil, err
+	}
+
+	var captured []*task.CapturedFile
+	for i, f := range files {
+		fi, err := os.Stat(f.Path)
+		if err != nil {
+			return nil, fmt.Errorf("could not capture %s: %w", f.Path, err)
+		}
+
+		name := strconv.Itoa(i)
+		if err := utils.CopyFileTo(f.Path, filepath.Join(dumpdir, capturedFilesDir, name)); err != nil {
+			return nil, fmt.Errorf("could not capture %s: %w", f.Path, err)
+		}
+
+		file := &task.CapturedFile{
+			Path:   f.Path,
+			Name:   name,
+			Size:   fi.Size(),
+			Mode:   uint32(fi.Mode().Perm()),
+			Offset: f.Pos,
+		}
+		if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+			file.UID = st.Uid
+			file.GID = st.Gid
+		}
+		captured = append(captured, file)
+	}
+	return captured, nil
+}
+
+// postDump records the checkpoint in the state, and compresses it next to dumpdir
+// with compression if archive is set, encrypting it if a key is configured. Remote
+// checkpoints skip the archive, they get streamed from dumpdir straight into the
+// upload instead.
+func (c *Client) postDump(ctx context.Context, jobID string, dumpdir string, state *task.ProcessState, archive bool, compression utils.Compression) error {
 	_, postDumpSpan := c.tracer.Start(ctx, "post-dump")
 	defer postDumpSpan.End()
-	compressedCheckpointPath := strings.Join([]string{dumpdir, ".tar"}, "")
 
-	state.CheckpointPath = compressedCheckpointPath
+	keys, err := utils.LoadKeyring(c.config.Encryption)
+	if err != nil {
+		postDumpSpan.RecordError(err)
+		return err
+	}
+
+	checkpointPath := dumpdir
+	if archive && c.config.SharedStorage.Dedup {
+		checkpointPath = strings.Join([]string{dumpdir, ".chunks"}, "")
+	} else if archive {
+		checkpointPath = strings.Join([]string{dumpdir, compression.Extension()}, "")
+		if keys != nil {
+			checkpointPath += ".enc"
+		}
+		postDumpSpan.SetAttributes(attribute.String("compression", compression.Algorithm))
+	}
+
+	state.CheckpointPath = checkpointPath
 	state.CheckpointState = task.CheckpointState_CHECKPOINTED
 	// sneak in a serialized state obj
-	err := c.SerializeStateToDir(dumpdir, state)
+	err = c.SerializeStateToDir(dumpdir, state)
 	if err != nil {
 		postDumpSpan.RecordError(err)
-		c.logger.Fatal().Err(err)
+		return err
 	}
 
-	c.logger.Info().Msgf("compressing checkpoint to %s", compressedCheckpointPath)
-
-	err = utils.TarFolder(dumpdir, compressedCheckpointPath)
+	// checksum everything, state included, so restores can verify the checkpoint
+	manifest, err := utils.WriteManifest(dumpdir, keys.KeyID())
 	if err != nil {
 		postDumpSpan.RecordError(err)
-		c.logger.Fatal().Err(err)
+		return err
 	}
+	postDumpSpan.SetAttributes(attribute.String("digest", manifest.Digest))
 
-	err = c.db.UpdateProcessStateWithID(c.jobID, state)
+	signer, err := utils.LoadSigner(c.config.Signing)
 	if err != nil {
 		postDumpSpan.RecordError(err)
-		c.logger.Fatal().Err(err)
+		return err
 	}
-	// get size of compressed checkpoint
-	info, err := os.Stat(compressedCheckpointPath)
+	if signer != nil {
+		if err := signer.SignManifest(dumpdir); err != nil {
+			postDumpSpan.RecordError(err)
+			return err
+		}
+		postDumpSpan.SetAttributes(attribute.String("signed-by", signer.KeyID()))
+	}
+
+	if archive && c.config.SharedStorage.Dedup {
+		c.logger.Info().Msgf("deduplicating checkpoint into chunk index %s", checkpointPath)
+
+		err = c.chunkFolder(dumpdir, checkpointPath, keys)
+		if err != nil {
+			postDumpSpan.RecordError(err)
+			return err
+		}
+	} else if archive {
+		c.logger.Info().Msgf("compressing checkpoint to %s", checkpointPath)
+
+		err = archiveFolder(dumpdir, checkpointPath, compression, keys)
+		if err != nil {
+			postDumpSpan.RecordError(err)
+			return err
+		}
+	}
+
+	// encrypted checkpoints are only kept sealed, the images in the clear go
+	// once they're in the archive or chunk store
+	sealed := archive && keys != nil
+	if sealed {
+		if err := os.RemoveAll(dumpdir); err != nil {
+			postDumpSpan.RecordError(err)
+			return fmt.Errorf("could not remove unencrypted checkpoint %s: %w", dumpdir, err)
+		}
+	}
+
+	err = c.db.UpdateProcessStateWithID(jobID, state)
 	if err != nil {
 		postDumpSpan.RecordError(err)
-		c.logger.Fatal().Err(err)
+		return err
+	}
+	// get size of checkpoint
+	var size int64
+	var dirSize int64
+	if !sealed {
+		dirSize, err = utils.FolderSize(dumpdir)
+		if err != nil {
+			postDumpSpan.RecordError(err)
+		}
+	}
+	if archive {
+		info, err := os.Stat(checkpointPath)
+		if err != nil {
+			postDumpSpan.RecordError(err)
+		} else {
+			size = info.Size()
+		}
+	} else {
+		size = dirSize
+	}
+
+	postDumpSpan.SetAttributes(attribute.Int("ckpt-size", int(size)))
+
+	// record the checkpoint for the retention policies, both the dir and the
+	// archive next to it count against them
+	if jobID != "" {
+		record := &CheckpointRecord{
+			Timestamp:      time.Now().UnixNano(),
+			Dir:            dumpdir,
+			CheckpointPath: checkpointPath,
+			Size:           dirSize,
+			Reason:         state.CheckpointReason,
+		}
+		if checkpointPath != dumpdir {
+			record.Size += size
+		}
+		if err := c.db.AddCheckpointRecord(jobID, record); err != nil {
+			c.logger.Warn().Msgf("could not record checkpoint %s: %v", checkpointPath, err)
+		}
+	}
+
+	return nil
+}
+
+// archiveFolder writes the checkpoint archive of dumpdir to path. Encrypted
+// archives are only readable by their owner.
+func archiveFolder(dumpdir, path string, compression utils.Compression, keys *utils.Keyring) error {
+	perm := os.FileMode(0o644)
+	if keys != nil {
+		perm = 0o600
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := utils.ArchiveFolderToWriter(dumpdir, file, compression, keys); err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
+// chunkFolder splits dumpdir into the chunk store, only keeping the chunks not
+// already in there from earlier checkpoints, and writes the index to path.
+func (c *Client) chunkFolder(dumpdir, path string, keys *utils.Keyring) error {
+	chunks, err := c.chunkStore(keys)
+	if err != nil {
+		return err
+	}
+
+	index, err := chunks.ChunkFolder(dumpdir)
+	if err != nil {
+		return err
 	}
 
-	postDumpSpan.SetAttributes(attribute.Int("ckpt-size", int(info.Size())))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := utils.WriteChunkIndex(file, index); err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
+// chunkStore opens the chunk store of deduplicated checkpoints, under the shared
+// storage dir.
+func (c *Client) chunkStore(keys *utils.Keyring) (*utils.ChunkStore, error) {
+	dir := c.config.SharedStorage.DumpStorageDir
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	return utils.NewChunkStore(filepath.Join(dir, utils.ChunkStoreDir), keys)
+}
+
+// uploadCheckpoint streams the checkpoint in dir to store, as chunks the remote
+// doesn't have yet if deduplication is on, or else as a tar compressed with
+// compression. Either way encrypted if a key is configured. The checkpoint comes
+// back with the checksum restores check its download against.
+func (c *Client) uploadCheckpoint(ctx context.Context, store *utils.CedanaStore, dir string, compression utils.Compression) (*utils.UploadResponse, *utils.CheckpointMeta, error) {
+	keys, err := utils.LoadKeyring(c.config.Encryption)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if c.config.SharedStorage.Dedup {
+		chunks, err := c.chunkStore(keys)
+		if err != nil {
+			return nil, nil, err
+		}
+		return store.UploadChunked(ctx, dir, chunks)
+	}
+
+	return store.UploadFolder(ctx, dir, compression, keys)
+}
+
+// compression picks how a checkpoint gets compressed, falling back to the client
+// config for whatever the request leaves unset.
+func (c *Client) compression(algorithm string, level int32) utils.Compression {
+	compression := utils.Compression{Algorithm: algorithm, Level: int(level)}
+	if compression.Algorithm == "" {
+		compression.Algorithm = c.config.Client.Compression
+		if level == 0 {
+			compression.Level = c.config.Client.CompressionLevel
+		}
+	}
+	if compression.Algorithm == "" {
+		compression.Algorithm = utils.CompressionNone
+	}
+	return compression
 }
 
 func (c *Client) prepareCheckpointOpts() *rpc.CriuOpts {
@@ -245,43 +523,48 @@ func patchPodmanDump(containerId, imgPath string) error {
 
 }
 
-func (c *Client) RuncDump(ctx context.Context, root, containerId string, opts *container.CriuOpts) error {
+// RuncDump checkpoints a runc container, recording the checkpoint under jobID
+func (c *Client) RuncDump(ctx context.Context, root, containerId, jobID string, opts *container.CriuOpts, archive bool) error {
 	_, dumpSpan := c.tracer.Start(ctx, "dump")
 	dumpSpan.SetAttributes(attribute.Bool("container", true))
 
-	links := []linkPairs{
-		{"/host/var/run/netns", "/var/run/netns"},
-		{"/host/run/containerd", "/run/containerd"},
-		{"/host/var/run/secrets", "/var/run/secrets"},
-		{"/host/var/lib/rancher", "/var/lib/rancher"},
-		{"/host/run/k3s", "/run/k3s"},
-		{"/host/var/lib/kubelet", "/var/lib/kubelet"},
+	linkHostPaths()
+
+	// finish off an incremental checkpoint, on top of the pre-dumps taken so far
+	chain, err := c.db.GetPreDumpChain(containerId)
+	if err != nil {
+		c.logger.Warn().Msgf("could not get pre-dump chain for %s: %v", containerId, err)
 	}
-	// Create sym links so that runc c/r can resolve config.json paths to the mounted ones in /host
-	for _, link := range links {
-		// Check if the target file exists
-		if _, err := os.Stat(link.Value); os.IsNotExist(err) {
-			// Target file does not exist, attempt to create a symbolic link
-			if err := os.Symlink(link.Key, link.Value); err != nil {
-				// Handle the error if creating symlink fails
-				fmt.Println("Error creating symlink:", err)
-				// Handle the error or log it as needed
-			}
-		} else if err != nil {
-			// Handle other errors from os.Stat if any
-			fmt.Println("Error checking file info:", err)
-			// Handle the error or log it as needed
+	if chain != nil && opts.ParentImage == "" {
+		parent, err := chain.parentOf(opts.ImagesDirectory)
+		if err != nil {
+			c.logger.Warn().Msgf("ignoring pre-dump chain for %s: %v", containerId, err)
+		} else {
+			opts.ParentImage = parent
 		}
 	}
+
 	bundle := Bundle{ContainerId: containerId}
 	runcContainer := container.GetContainerFromRunc(containerId, root)
-	err := runcContainer.RuncCheckpoint(opts, runcContainer.Pid, root, runcContainer.Config)
+	if opts.LazyPages {
+		err = c.runcLazyCheckpoint(runcContainer, root, opts)
+	} else {
+		err = runcContainer.RuncCheckpoint(opts, runcContainer.Pid, root, runcContainer.Config)
+	}
 	if err != nil {
 		dumpSpan.RecordError(err)
-		c.logger.Fatal().Err(err)
+		dumpSpan.End()
+		return err
 	}
 	dumpSpan.End()
 
+	// the chain is consumed by this dump, the next pre-dump starts a new one
+	if chain != nil {
+		if err := c.db.DeletePreDumpChain(containerId); err != nil {
+			c.logger.Warn().Msgf("could not clear pre-dump chain for %s: %v", containerId, err)
+		}
+	}
+
 	if checkIfPodman(bundle) {
 		if err := patchPodmanDump(containerId, opts.ImagesDirectory); err != nil {
 			return err
@@ -302,12 +585,179 @@ func (c *Client) RuncDump(ctx context.Context, root, containerId string, opts *c
 
 	// CRIU ntfy hooks get run before this,
 	// so have to ensure that image files aren't tampered with
-	c.postDump(ctx, opts.ImagesDirectory, state)
+	err = c.postDump(ctx, jobID, opts.ImagesDirectory, state, archive, c.compression("", 0))
 	c.cleanupClient()
 
+	return err
+}
+
+// RuncPreDump takes an iterative pre-dump of a runc container into a subdirectory of
+// opts.ImagesDirectory, chained to the previous pre-dump of the same container. The
+// container is left running. A subsequent RuncDump to the same images directory
+// references the chain as its parent, so only pages dirtied since the last pre-dump
+// end up in the final dump. Returns the directory the pre-dump was written to.
+func (c *Client) RuncPreDump(ctx context.Context, root, containerId string, opts *container.CriuOpts) (string, error) {
+	_, preDumpSpan := c.tracer.Start(ctx, "pre-dump")
+	preDumpSpan.SetAttributes(attribute.Bool("container", true))
+	defer preDumpSpan.End()
+
+	if opts.ImagesDirectory == "" {
+		return "", fmt.Errorf("invalid directory to save pre-dump")
+	}
+
+	linkHostPaths()
+
+	chain, err := c.db.GetPreDumpChain(containerId)
+	if err != nil {
+		return "", err
+	}
+	if chain == nil || chain.ImagesDirectory != opts.ImagesDirectory {
+		chain = &PreDumpChain{ImagesDirectory: opts.ImagesDirectory}
+	}
+
+	if err := os.MkdirAll(opts.ImagesDirectory, 0o777); err != nil {
+		return "", err
+	}
+
+	name := fmt.Sprintf("%s%d", preDumpDirPrefix, len(chain.PreDumps))
+
+	preDumpOpts := *opts
+	preDumpOpts.ImagesDirectory = filepath.Join(opts.ImagesDirectory, name)
+	preDumpOpts.PreDump = true
+	preDumpOpts.LeaveRunning = true
+	if len(chain.PreDumps) > 0 {
+		// relative to the images directory of this pre-dump
+		preDumpOpts.ParentImage = filepath.Join("..", chain.PreDumps[len(chain.PreDumps)-1])
+	}
+
+	runcContainer := container.GetContainerFromRunc(containerId, root)
+	err = runcContainer.RuncCheckpoint(&preDumpOpts, runcContainer.Pid, root, runcContainer.Config)
+	if err != nil {
+		preDumpSpan.RecordError(err)
+		return "", err
+	}
+
+	chain.PreDumps = append(chain.PreDumps, name)
+	if err := c.db.UpdatePreDumpChain(containerId, chain); err != nil {
+		return "", err
+	}
+
+	c.logger.Info().Msgf("pre-dump %d of container %s written to %s", len(chain.PreDumps), containerId, preDumpOpts.ImagesDirectory)
+	preDumpSpan.SetAttributes(attribute.Int("pre-dumps", len(chain.PreDumps)))
+
+	return preDumpOpts.ImagesDirectory, nil
+}
+
+// runcLazyCheckpoint dumps everything but the memory pages of a container, which are
+// left behind CRIU's page server at opts.PageServer instead. It returns as soon as the
+// page server is ready, and the images directory can be shipped off for a lazy restore.
+// The dump itself only completes once the restored side has faulted all pages in.
+func (c *Client) runcLazyCheckpoint(runcContainer *container.RuncContainer, root string, opts *container.CriuOpts) error {
+	if opts.PageServer.Port == 0 {
+		return fmt.Errorf("lazy dump requires a page server port")
+	}
+	if opts.PageServer.Address == "" {
+		opts.PageServer.Address = "0.0.0.0"
+	}
+
+	// criu signals the page server coming up by writing to (and closing) the status fd
+	fds := make([]int, 2)
+	if err := syscall.Pipe2(fds, syscall.O_CLOEXEC); err != nil {
+		return err
+	}
+	status := os.NewFile(uintptr(fds[0]), "lazy-status")
+	defer status.Close()
+	opts.StatusFd = fds[1]
+
+	done := make(chan error, 1)
+	go func() {
+		err := runcContainer.RuncCheckpoint(opts, runcContainer.Pid, root, runcContainer.Config)
+		// the checkpoint closes the status fd once it's handed to criu, or if the
+		// page server comes up, otherwise it's still ours to close
+		if opts.StatusFd != -1 {
+			syscall.Close(opts.StatusFd)
+			opts.StatusFd = -1
+		}
+		done <- err
+	}()
+
+	ready := make(chan error, 1)
+	go func() {
+		buf := make([]byte, 1)
+		_, err := status.Read(buf)
+		ready <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("lazy dump exited before the page server was ready")
+	case err := <-ready:
+		if err != nil {
+			return fmt.Errorf("page server did not come up: %w", err)
+		}
+	}
+
+	c.logger.Info().Msgf("serving pages of container %s on %s:%d", runcContainer.Id, opts.PageServer.Address, opts.PageServer.Port)
+
+	go func() {
+		if err := <-done; err != nil {
+			c.logger.Error().Msgf("lazy dump of container %s failed: %v", runcContainer.Id, err)
+			return
+		}
+		c.logger.Info().Msgf("all pages of container %s transferred", runcContainer.Id)
+	}()
+
 	return nil
 }
 
+// parentOf returns the parent image path, relative to imagesDirectory, that a final
+// dump into imagesDirectory should reference to build on top of this chain.
+func (chain *PreDumpChain) parentOf(imagesDirectory string) (string, error) {
+	if len(chain.PreDumps) == 0 {
+		return "", fmt.Errorf("chain is empty")
+	}
+	if chain.ImagesDirectory != imagesDirectory {
+		return "", fmt.Errorf("chain was taken in %s, not %s", chain.ImagesDirectory, imagesDirectory)
+	}
+
+	parent := chain.PreDumps[len(chain.PreDumps)-1]
+	if _, err := os.Stat(filepath.Join(imagesDirectory, parent)); err != nil {
+		return "", err
+	}
+
+	return parent, nil
+}
+
+// Create sym links so that runc c/r can resolve config.json paths to the mounted ones in /host
+func linkHostPaths() {
+	links := []linkPairs{
+		{"/host/var/run/netns", "/var/run/netns"},
+		{"/host/run/containerd", "/run/containerd"},
+		{"/host/var/run/secrets", "/var/run/secrets"},
+		{"/host/var/lib/rancher", "/var/lib/rancher"},
+		{"/host/run/k3s", "/run/k3s"},
+		{"/host/var/lib/kubelet", "/var/lib/kubelet"},
+	}
+	for _, link := range links {
+		// Check if the target file exists
+		if _, err := os.Stat(link.Value); os.IsNotExist(err) {
+			// Target file does not exist, attempt to create a symbolic link
+			if err := os.Symlink(link.Key, link.Value); err != nil {
+				// Handle the error if creating symlink fails
+				fmt.Println("Error creating symlink:", err)
+				// Handle the error or log it as needed
+			}
+		} else if err != nil {
+			// Handle other errors from os.Stat if any
+			fmt.Println("Error checking file info:", err)
+			// Handle the error or log it as needed
+		}
+	}
+}
+
 func (c *Client) ContainerDump(imagePath, containerId string) error {
 	root := "/run/containerd/runc/k8s.io"
 
@@ -331,13 +781,42 @@ func (c *Client) ContainerDump(imagePath, containerId string) error {
 
 	// CRIU ntfy hooks get run before this,
 	// so have to ensure that image files aren't tampered with
-	c.postDump(context.Background(), imagePath, state)
+	err = c.postDump(context.Background(), "", imagePath, state, true, c.compression("", 0))
 	c.cleanupClient()
 
-	return nil
+	return err
+}
+
+func (c *Client) Dump(ctx context.Context, args *task.DumpArgs) error {
+	release, err := c.reserveDump(args.JobID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return c.dump(ctx, args, nil, false)
+}
+
+// reserveDump marks a job as being dumped until release is called. Different
+// jobs can be dumped at the same time, the same one can't.
+func (c *Client) reserveDump(jobID string) (release func(), err error) {
+	if jobID == "" {
+		return func() {}, nil
+	}
+	if _, busy := c.dumping.LoadOrStore(jobID, struct{}{}); busy {
+		return nil, fmt.Errorf("job %s is already being dumped", jobID)
+	}
+	return func() { c.dumping.Delete(jobID) }, nil
 }
 
-func (c *Client) Dump(ctx context.Context, dir string, pid int32) error {
+// dump checkpoints args.PID, sending memory pages to the page server ps instead
+// of the images directory if one is given. A frozen job was stopped by the
+// caller, who resumes or kills it afterwards, so it's left as it is. The caller
+// reserves the job, see reserveDump.
+func (c *Client) dump(ctx context.Context, args *task.DumpArgs, ps *rpc.CriuPageServerInfo, frozen bool) error {
+	dir := args.Dir
+	pid := args.PID
+
 	opts := c.prepareCheckpointOpts()
 	dumpdir, err := c.prepareDump(ctx, pid, dir, opts)
 	if err != nil {
@@ -379,6 +858,40 @@ func (c *Client) Dump(ctx context.Context, dir string, pid int32) error {
 	opts.ImagesDirFd = proto.Int32(int32(img.Fd()))
 	opts.Pid = proto.Int32(pid)
 
+	// the job's cgroup is dumped along with it, for the restore to recreate
+	cgroup := c.cgroupOf(pid)
+	if cgroup != "" {
+		opts.ManageCgroups = proto.Bool(true)
+		opts.ManageCgroupsMode = rpc.CriuCgMode_SOFT.Enum()
+	}
+
+	profile, err := c.criuProfile(args.CriuOpts)
+	if err != nil {
+		return err
+	}
+	if profile != nil {
+		profile.Apply(opts)
+	}
+
+	if args.PreDumpIterations > 0 || args.PreDumpThreshold > 0 {
+		parent, err := c.preDump(ctx, dumpdir, pid, opts, args.PreDumpIterations, args.PreDumpThreshold)
+		if err != nil {
+			return err
+		}
+		opts.ParentImg = proto.String(parent)
+		opts.TrackMem = proto.Bool(true)
+	}
+
+	if frozen {
+		opts.LeaveRunning = proto.Bool(true)
+	}
+
+	// the process moves over to wherever its pages end up, see below
+	if ps != nil {
+		opts.Ps = ps
+		opts.LeaveRunning = proto.Bool(true)
+	}
+
 	nfy := Notify{
 		Logger: c.logger,
 	}
@@ -390,28 +903,211 @@ func (c *Client) Dump(ctx context.Context, dir string, pid int32) error {
 		return err
 	}
 
-	_, dumpSpan := c.tracer.Start(ctx, "dump")
-	dumpSpan.SetAttributes(attribute.Bool("container", false))
-	_, err = c.CRIU.Dump(opts, &nfy)
+	var trees []int32
+	if args.JobID != "" {
+		if prev, err := c.db.GetStateFromID(args.JobID); err == nil && prev != nil {
+			trees = prev.Trees
+		}
+	}
+	roots, members, err := jobTrees(pid, args.Scope, trees)
 	if err != nil {
-		// check for sudo error
-		if strings.Contains(err.Error(), "errno 0") {
-			c.logger.Warn().Msgf("error dumping, cedana is not running as root: %v", err)
+		return err
+	}
+	state.Scope = args.Scope
+	state.Members = members
+	state.Trees = trees
+	// shell jobs can be restored onto a pty of the daemon's
+	state.TtyKey = ttyKey(pid)
+	// CRIU brings the namespace back along with the job on restore
+	state.PidNamespace = pidNamespace(pid)
+	state.Cgroup = cgroup
+	state.Limits = cgroupLimits(cgroup)
+
+	if args.Files != task.FileCapture_NO_FILES {
+		var pids []int32
+		for _, member := range members {
+			pids = append(pids, member.PID)
+		}
+		files := filesToCapture(pids, args.Files)
+		nfy.PostDumpFunc = NotifyFunc{
+			Avail: true,
+			Callback: func() error {
+				var err error
+				state.CapturedFiles, err = captureFiles(dumpdir, files)
+				return err
+			},
+		}
+	}
+
+	if len(roots) > 1 && ps != nil {
+		return fmt.Errorf("session of pid %d spans %d process trees, which can't be migrated through a page server", pid, len(roots))
+	}
+
+	// peers can't reach the job from the moment it's frozen until it's restored,
+	// or whatever they send would be lost with its sockets. CRIU locks a network
+	// namespace of its own once the tasks are frozen, host endpoints are locked
+	// by us, so the job gets stopped first. So does a session of several trees,
+	// for every tree to be checkpointed at the same point. Either way the job is
+	// continued once restored. A job dumped to a page server stays stopped after
+	// the dump, for the caller to kill once it's restored on the other end or to
+	// continue if it can't be.
+	lock := c.networkLock(state, pid)
+	var dumped bool
+	if !frozen && (ps != nil || len(roots) > 1 || (lock != nil && lock.Netns == "")) {
+		if err := signalMembers(members, syscall.SIGSTOP); err != nil {
 			return err
 		}
+		defer func() {
+			if ps != nil && dumped {
+				return
+			}
+			if err := signalMembers(members, syscall.SIGCONT); err != nil {
+				c.logger.Warn().Msgf("could not continue job of pid %d: %v", pid, err)
+			}
+		}()
+		state.Stopped = true
+	}
 
-		dumpSpan.RecordError(err)
-		c.logger.Warn().Msgf("error dumping process: %v", err)
+	var keepLock bool
+	if lock != nil {
+		if lock.Netns != "" {
+			nfy.NetworkLockFunc = NotifyFunc{Avail: true, Callback: lock.Lock}
+		} else if err := lock.Lock(); err != nil {
+			return fmt.Errorf("could not lock network of pid %d: %w", pid, err)
+		}
+		// the lock stays until restore only when the job is gone from here
+		defer func() {
+			if keepLock {
+				return
+			}
+			if err := lock.Unlock(); err != nil {
+				c.logger.Warn().Msgf("could not unlock network of pid %d: %v", pid, err)
+			}
+		}()
+	}
+
+	// processes that left the job's tree get dumped on their own
+	if len(roots) > 1 {
+		if err := c.dumpTrees(ctx, dumpdir, roots[1:], opts); err != nil {
+			return err
+		}
+	}
+
+	// the span only covers CRIU's part, whichever way it ends
+	err = func() error {
+		_, dumpSpan := c.tracer.Start(ctx, "dump")
+		dumpSpan.SetAttributes(attribute.Bool("container", false))
+		dumpSpan.SetAttributes(attribute.Int("trees", len(roots)))
+		defer dumpSpan.End()
+
+		_, err := c.CRIU.Dump(opts, &nfy)
+		if err != nil {
+			// check for sudo error
+			if strings.Contains(err.Error(), "errno 0") {
+				c.logger.Warn().Msgf("error dumping, cedana is not running as root (see cedana check): %v", err)
+				return err
+			}
+
+			dumpSpan.RecordError(err)
+			c.logger.Warn().Msgf("error dumping process: %v", err)
+		}
+		return err
+	}()
+	if err != nil {
 		return err
 	}
 
-	dumpSpan.End()
+	dumped = true
+	keepLock = !opts.GetLeaveRunning() && ps == nil
+
+	// CRIU only killed the leader's tree, the others go now that all are dumped
+	if !opts.GetLeaveRunning() {
+		for _, root := range roots[1:] {
+			if err := killTree(root); err != nil {
+				c.logger.Warn().Msgf("could not kill tree of pid %d: %v", root, err)
+			}
+		}
+	}
 
 	state.GPUCheckpointed = GPUCheckpointed
-	c.postDump(ctx, dumpdir, state)
+	state.CheckpointReason = args.Reason
+	err = c.postDump(ctx, args.JobID, dumpdir, state, args.Type == task.DumpArgs_LOCAL, c.compression(args.Compression, args.CompressionLevel))
 	c.cleanupClient()
 
-	return nil
+	return err
+}
+
+// preDump runs iterative CRIU pre-dump passes into subdirectories of dumpdir, each
+// one chained to the previous through ParentImg, so that only pages dirtied since the
+// last pass get written. It stops after the requested number of iterations, or once
+// a pass writes fewer pages than threshold. The returned path is the last pre-dump
+// relative to dumpdir, to be used as the parent of the final dump.
+func (c *Client) preDump(ctx context.Context, dumpdir string, pid int32, dumpOpts *rpc.CriuOpts, iterations int32, threshold uint64) (string, error) {
+	_, preDumpSpan := c.tracer.Start(ctx, "pre-dump")
+	defer preDumpSpan.End()
+
+	features, err := c.CRIU.FeatureCheck(&rpc.CriuFeatures{MemTrack: proto.Bool(true)})
+	if err != nil {
+		return "", err
+	}
+	if !features.GetMemTrack() {
+		return "", fmt.Errorf("pre-dump requested but memory tracking is not supported on this host")
+	}
+
+	// only a threshold was given, bound the number of passes anyway
+	if iterations <= 0 {
+		iterations = maxPreDumpIterations
+	}
+
+	var parent string
+	for i := int32(0); i < iterations; i++ {
+		name := fmt.Sprintf("%s%d", preDumpDirPrefix, i)
+		preDumpDir := filepath.Join(dumpdir, name)
+		if err := os.MkdirAll(preDumpDir, 0o777); err != nil {
+			return "", err
+		}
+
+		img, err := os.Open(preDumpDir)
+		if err != nil {
+			return "", err
+		}
+
+		opts := proto.Clone(dumpOpts).(*rpc.CriuOpts)
+		opts.ImagesDirFd = proto.Int32(int32(img.Fd()))
+		opts.Pid = proto.Int32(pid)
+		opts.LogFile = proto.String("pre-dump.log")
+		opts.TrackMem = proto.Bool(true)
+		if parent != "" {
+			// relative to the images directory of this pass
+			opts.ParentImg = proto.String(filepath.Join("..", parent))
+		}
+
+		c.logger.Info().Msgf("pre-dump %d of pid %d into %s", i, pid, preDumpDir)
+		_, err = c.CRIU.PreDump(opts, nil)
+		if err != nil {
+			img.Close()
+			preDumpSpan.RecordError(err)
+			return "", fmt.Errorf("pre-dump %d failed: %w", i, err)
+		}
+
+		stats, err := criustats.CriuGetDumpStats(img)
+		img.Close()
+		parent = name
+		if err != nil {
+			c.logger.Warn().Msgf("could not read pre-dump stats: %v", err)
+			continue
+		}
+
+		written := stats.GetPagesWritten()
+		c.logger.Info().Msgf("pre-dump %d wrote %d pages", i, written)
+		if threshold > 0 && written < threshold {
+			break
+		}
+	}
+
+	preDumpSpan.SetAttributes(attribute.String("parent", parent))
+
+	return parent, nil
 }
 
 func (c *Client) gpuCheckpoint(ctx context.Context, dumpdir string) error {
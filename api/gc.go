@@ -0,0 +1,288 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/cedana/cedana/utils"
+	"github.com/robfig/cron/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// chunks younger than this may belong to a checkpoint whose index isn't written
+// yet, so the sweep leaves them be
+const chunkGracePeriod = time.Hour
+
+// collect deletes the checkpoints past retention, of job or of every job if it's
+// empty, along with their remote copies. Only records that keep returns true for
+// are considered. With sweep set, the chunks no checkpoint references anymore go
+// too. With dryRun set nothing gets deleted, the response lists what would be.
+func (s *service) collect(ctx context.Context, job string, retention utils.Retention, keep func(*CheckpointRecord) bool, sweep, dryRun bool) (*task.GarbageCollectResp, error) {
+	s.gcMu.Lock()
+	defer s.gcMu.Unlock()
+
+	all, err := s.client.db.ListAllCheckpointRecords()
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []string
+	var records []*CheckpointRecord
+	var checkpoints []utils.RetainedCheckpoint
+	for id, jobRecords := range all {
+		if job != "" && id != job {
+			continue
+		}
+		for _, record := range jobRecords {
+			if keep != nil && !keep(record) {
+				continue
+			}
+			jobs = append(jobs, id)
+			records = append(records, record)
+			checkpoints = append(checkpoints, utils.RetainedCheckpoint{
+				JobID: id,
+				Time:  time.Unix(0, record.Timestamp),
+				Size:  record.Size,
+			})
+		}
+	}
+
+	expired, err := retention.Expired(checkpoints, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &task.GarbageCollectResp{}
+	deleted := map[string]bool{}
+	var store *utils.CedanaStore
+
+	for _, i := range expired {
+		id, record := jobs[i], records[i]
+
+		if !dryRun {
+			if record.CheckpointID != "" {
+				if store == nil {
+					store = utils.NewCedanaStore(s.client.config, s.client.tracer)
+				}
+				// on failure the record stays, to retry on the next collection
+				if err := s.deleteRemoteCheckpoint(ctx, store, id, record.CheckpointID); err != nil {
+					s.logger.Warn().Msgf("could not delete remote checkpoint %s of job %s: %v", record.CheckpointID, id, err)
+					continue
+				}
+			}
+			if err := removeCheckpoint(record); err != nil {
+				s.logger.Warn().Msgf("could not delete checkpoint %s of job %s: %v", record.CheckpointPath, id, err)
+				continue
+			}
+			if err := s.client.db.DeleteCheckpointRecord(id, record.Timestamp); err != nil {
+				s.logger.Warn().Msgf("could not delete record of checkpoint %s: %v", record.CheckpointPath, err)
+			}
+			s.logger.Info().Msgf("deleted checkpoint %s of job %s", record.CheckpointPath, id)
+		}
+
+		deleted[record.CheckpointPath] = true
+		resp.FreedBytes += record.Size
+		resp.Checkpoints = append(resp.Checkpoints, &task.CollectedCheckpoint{
+			JobID:          id,
+			CheckpointPath: record.CheckpointPath,
+			CheckpointID:   record.CheckpointID,
+			Size:           record.Size,
+			Timestamp:      time.Unix(0, record.Timestamp).Unix(),
+		})
+	}
+
+	if sweep {
+		count, size, err := s.sweepChunks(all, deleted, dryRun)
+		if err != nil {
+			s.logger.Warn().Msgf("could not sweep chunk store: %v", err)
+		}
+		resp.FreedChunks = int64(count)
+		resp.FreedBytes += size
+	}
+
+	return resp, nil
+}
+
+// sweep deletes the chunks no checkpoint references anymore, returning how many
+// went and the bytes they freed
+func (s *service) sweep() (int, int64, error) {
+	s.gcMu.Lock()
+	defer s.gcMu.Unlock()
+
+	all, err := s.client.db.ListAllCheckpointRecords()
+	if err != nil {
+		return 0, 0, err
+	}
+	return s.sweepChunks(all, nil, false)
+}
+
+// removeCheckpoint deletes the dump dir of a checkpoint, and the archive or chunk
+// index next to it
+func removeCheckpoint(record *CheckpointRecord) error {
+	for _, path := range []string{record.CheckpointPath, record.Dir} {
+		if path == "" {
+			continue
+		}
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *service) deleteRemoteCheckpoint(ctx context.Context, store *utils.CedanaStore, id, checkpointID string) error {
+	if err := store.DeleteCheckpoint(ctx, checkpointID); err != nil {
+		return err
+	}
+
+	state, err := s.client.db.GetStateFromID(id)
+	if err != nil {
+		// job is gone, along with its remote state
+		return nil
+	}
+
+	var remoteState []*task.RemoteState
+	for _, remote := range state.RemoteState {
+		if remote.CheckpointID != checkpointID {
+			remoteState = append(remoteState, remote)
+		}
+	}
+	state.RemoteState = remoteState
+
+	return s.client.db.UpdateProcessStateWithID(id, state)
+}
+
+// sweepChunks deletes the chunks no chunk index references anymore. Indexes are
+// found from the checkpoint records, and in the shared storage dir for the ones
+// without a record.
+func (s *service) sweepChunks(records map[string][]*CheckpointRecord, deleted map[string]bool, dryRun bool) (int, int64, error) {
+	storageDir := s.client.config.SharedStorage.DumpStorageDir
+	if storageDir == "" {
+		storageDir = os.TempDir()
+	}
+	if _, err := os.Stat(filepath.Join(storageDir, utils.ChunkStoreDir)); err != nil {
+		return 0, 0, nil
+	}
+
+	indexes, err := filepath.Glob(filepath.Join(storageDir, "*.chunks"))
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, jobRecords := range records {
+		for _, record := range jobRecords {
+			if strings.HasSuffix(record.CheckpointPath, ".chunks") {
+				indexes = append(indexes, record.CheckpointPath)
+			}
+		}
+	}
+
+	referenced := map[string]bool{}
+	for _, path := range indexes {
+		if deleted[path] {
+			continue
+		}
+		hashes, err := indexHashes(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			// a chunk index we can't read could reference anything
+			return 0, 0, fmt.Errorf("could not read chunk index %s: %v", path, err)
+		}
+		for _, hash := range hashes {
+			referenced[hash] = true
+		}
+	}
+
+	keys, err := utils.LoadKeyring(s.client.config.Encryption)
+	if err != nil {
+		return 0, 0, err
+	}
+	chunks, err := s.client.chunkStore(keys)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return chunks.Sweep(referenced, chunkGracePeriod, dryRun)
+}
+
+func indexHashes(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	index, _, err := utils.ReadChunkIndex(file)
+	if err != nil {
+		return nil, err
+	}
+	if index == nil {
+		return nil, fmt.Errorf("not a chunk index")
+	}
+	return index.Hashes(), nil
+}
+
+// scheduleGC collects checkpoints past the retention of the client config on its
+// interval, if it sets any limit. With deduplication on, the chunk store is swept
+// on the same interval, on its own so that nothing else collecting checkpoints,
+// like the pruning of scheduled ones, has to go through every chunk.
+func (sc *scheduler) scheduleGC(retention utils.Retention, dedup bool) error {
+	if !retention.Enabled() && !dedup {
+		return nil
+	}
+	if err := retention.Validate(); err != nil {
+		return err
+	}
+	interval, _ := retention.Interval()
+
+	if retention.Enabled() {
+		job := cron.NewChain(cron.SkipIfStillRunning(cron.DiscardLogger)).Then(cron.FuncJob(func() {
+			resp, err := sc.s.collect(context.Background(), "", retention, nil, false, false)
+			if err != nil {
+				sc.logger.Warn().Msgf("garbage collection failed: %v", err)
+				return
+			}
+			if len(resp.Checkpoints) > 0 {
+				sc.logger.Info().Msgf("garbage collection deleted %d checkpoints, freeing %d bytes", len(resp.Checkpoints), resp.FreedBytes)
+			}
+		}))
+		sc.cron.Schedule(cron.Every(interval), job)
+		sc.logger.Info().Msgf("collecting checkpoints past retention every %s", interval)
+	}
+
+	if dedup {
+		job := cron.NewChain(cron.SkipIfStillRunning(cron.DiscardLogger)).Then(cron.FuncJob(func() {
+			count, size, err := sc.s.sweep()
+			if err != nil {
+				sc.logger.Warn().Msgf("could not sweep chunk store: %v", err)
+				return
+			}
+			if count > 0 {
+				sc.logger.Info().Msgf("chunk sweep deleted %d chunks, freeing %d bytes", count, size)
+			}
+		}))
+		sc.cron.Schedule(cron.Every(interval), job)
+		sc.logger.Info().Msgf("sweeping unreferenced chunks every %s", interval)
+	}
+	return nil
+}
+
+func (s *service) GarbageCollect(ctx context.Context, args *task.GarbageCollectArgs) (*task.GarbageCollectResp, error) {
+	retention := s.client.config.Retention
+	if err := retention.Validate(); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	resp, err := s.collect(ctx, args.JobID, retention, nil, true, args.DryRun)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
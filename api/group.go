@@ -0,0 +1,399 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cedana/cedana/api/runc"
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/cedana/cedana/container"
+	"github.com/cedana/cedana/utils"
+	"github.com/cedana/runc/libcontainer/configs"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Members of a group talk to each other, so they're all frozen before the first
+// dump and stay frozen until the last one is done, for every checkpoint to see
+// the same moment. Jobs are frozen with SIGSTOP, containers with their cgroup
+// freezer, which CRIU leaves as it found it. Whatever was stopped or frozen
+// before the group stays that way when it resumes, after the dump or restore.
+
+// groupMember is a member of a group being checkpointed or restored
+type groupMember struct {
+	*task.GroupMember
+	// processes of a job
+	members []*task.ProcessMember
+	scope   task.JobScope
+	// roots of the other trees of a restored session job
+	trees []int32
+	rc    *container.RuncContainer
+}
+
+func (m *groupMember) String() string {
+	if m.JobID != "" {
+		return "job " + m.JobID
+	}
+	return "container " + m.ContainerID
+}
+
+// freeze stops every process of the member, noting the ones that were stopped
+// already
+func (m *groupMember) freeze() error {
+	if m.rc != nil {
+		state, err := m.rc.CgroupManager.GetFreezerState()
+		if err != nil {
+			return err
+		}
+		if state == configs.Frozen {
+			m.Frozen = true
+			return nil
+		}
+		return m.rc.CgroupManager.Freeze(configs.Frozen)
+	}
+
+	m.Stopped = nil
+	for _, member := range m.members {
+		if stat, err := utils.ReadProcStat(member.PID); err == nil && stat.State == 'T' {
+			m.Stopped = append(m.Stopped, member.PID)
+		}
+	}
+	return signalMembers(m.members, syscall.SIGSTOP)
+}
+
+// thaw resumes every process of the member but the ones that were stopped before
+// it was frozen
+func (m *groupMember) thaw() error {
+	if m.rc != nil {
+		if m.Frozen {
+			return nil
+		}
+		return m.rc.CgroupManager.Freeze(configs.Thawed)
+	}
+
+	stopped := make(map[int32]bool, len(m.Stopped))
+	for _, pid := range m.Stopped {
+		stopped[pid] = true
+	}
+	var running []*task.ProcessMember
+	for _, member := range m.members {
+		if !stopped[member.PID] {
+			running = append(running, member)
+		}
+	}
+	return signalMembers(running, syscall.SIGCONT)
+}
+
+// kill kills every process of the member, deleting what's left of a container
+// so it can be restored under the same ID
+func (m *groupMember) kill() error {
+	if m.rc == nil {
+		return signalMembers(m.members, syscall.SIGKILL)
+	}
+
+	pids, err := m.rc.CgroupManager.GetAllPids()
+	if err != nil {
+		return err
+	}
+	for _, pid := range pids {
+		if err := syscall.Kill(pid, syscall.SIGKILL); err != nil && err != syscall.ESRCH {
+			return err
+		}
+	}
+	if err := m.rc.CgroupManager.Freeze(configs.Thawed); err != nil {
+		return err
+	}
+
+	// the cgroup can only go once its processes are reaped
+	for i := 0; i < 50; i++ {
+		if err = m.rc.CgroupManager.Destroy(); err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(m.rc.Root)
+}
+
+// containerBundle finds the bundle a runc container was created from
+func containerBundle(rc *container.RuncContainer) string {
+	for _, label := range rc.Config.Labels {
+		if strings.HasPrefix(label, "bundle=") {
+			return strings.TrimPrefix(label, "bundle=")
+		}
+	}
+	return ""
+}
+
+// groupMembers resolves the jobs and containers of a group dump
+func (s *service) groupMembers(args *task.GroupDumpArgs) ([]*groupMember, error) {
+	var members []*groupMember
+	seen := map[string]bool{}
+
+	for _, id := range args.JobIDs {
+		if seen["job "+id] {
+			continue
+		}
+		seen["job "+id] = true
+
+		state, err := s.client.db.GetStateFromID(id)
+		if err != nil || state == nil || state.PID == 0 {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("job %s not found", id))
+		}
+		if err := syscall.Kill(int(state.PID), 0); err != nil {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("job %s is not running", id))
+		}
+		_, processes, err := jobTrees(state.PID, state.Scope, state.Trees)
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("job %s: %v", id, err))
+		}
+
+		members = append(members, &groupMember{
+			GroupMember: &task.GroupMember{JobID: id, PID: state.PID},
+			members:     processes,
+			scope:       state.Scope,
+		})
+	}
+
+	for _, ctr := range args.Containers {
+		if ctr.ContainerID == "" || ctr.Root == "" {
+			return nil, status.Error(codes.InvalidArgument, "containers need both an id and a runc root")
+		}
+		if seen["container "+ctr.ContainerID] {
+			continue
+		}
+		seen["container "+ctr.ContainerID] = true
+
+		pid, err := runc.GetPidByContainerId(ctr.ContainerID, ctr.Root)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("container %s not found: %v", ctr.ContainerID, err))
+		}
+		rc := container.GetContainerFromRunc(ctr.ContainerID, ctr.Root)
+
+		member := &groupMember{
+			GroupMember: &task.GroupMember{
+				ContainerID: ctr.ContainerID,
+				Root:        ctr.Root,
+				Bundle:      ctr.Bundle,
+				PID:         int32(pid),
+			},
+			rc: rc,
+		}
+		if member.Bundle == "" {
+			member.Bundle = containerBundle(rc)
+		}
+		members = append(members, member)
+	}
+
+	if len(members) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no jobs or containers to checkpoint")
+	}
+	return members, nil
+}
+
+// dumpMember checkpoints a frozen member into its own directory under dir
+func (s *service) dumpMember(ctx context.Context, dir string, m *groupMember) error {
+	if m.rc == nil {
+		release, err := s.client.reserveDump(m.JobID)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		err = s.client.dump(ctx, &task.DumpArgs{
+			PID:   m.PID,
+			Dir:   dir,
+			JobID: m.JobID,
+			Type:  task.DumpArgs_LOCAL,
+			Scope: m.scope,
+		}, nil, true)
+		if err != nil {
+			return err
+		}
+
+		state, err := s.client.db.GetStateFromID(m.JobID)
+		if err != nil {
+			return err
+		}
+		m.CheckpointPath = state.CheckpointPath
+		return nil
+	}
+
+	// like RuncDump, the checkpoint is recorded under a job of its own
+	jobID := uuid.New().String()
+	if err := s.client.db.CreateOrUpdateCedanaProcess(jobID, &task.ProcessState{
+		Flag: task.FlagEnum_JOB_RUNNING,
+		PID:  m.PID,
+	}); err != nil {
+		return err
+	}
+	// runc restores straight from the images directory, it's left unarchived
+	m.CheckpointPath = filepath.Join(dir, m.ContainerID)
+	return s.client.RuncDump(ctx, m.Root, m.ContainerID, jobID, &container.CriuOpts{
+		ImagesDirectory: m.CheckpointPath,
+		LeaveRunning:    true,
+	}, false)
+}
+
+func (s *service) GroupDump(ctx context.Context, args *task.GroupDumpArgs) (*task.GroupDumpResp, error) {
+	ctx, groupSpan := s.client.tracer.Start(ctx, "group-dump")
+	defer groupSpan.End()
+
+	members, err := s.groupMembers(args)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := args.Dir
+	if dir == "" {
+		dir = s.client.config.SharedStorage.DumpStorageDir
+	}
+	if dir == "" {
+		return nil, status.Error(codes.InvalidArgument, "no dump directory specified")
+	}
+
+	resp := &task.GroupDumpResp{GroupID: uuid.New().String()}
+	groupDir := filepath.Join(dir, "group-"+resp.GroupID)
+	if err := os.MkdirAll(groupDir, 0o777); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	groupSpan.SetAttributes(attribute.String("groupID", resp.GroupID), attribute.Int("members", len(members)))
+
+	// whatever happens, members are left either running or killed, never frozen
+	var frozen []*groupMember
+	thaw := func() {
+		for _, m := range frozen {
+			if err := m.thaw(); err != nil {
+				s.logger.Warn().Msgf("could not resume %s: %v", m, err)
+			}
+		}
+	}
+
+	for _, m := range members {
+		if err := m.freeze(); err != nil {
+			thaw()
+			os.RemoveAll(groupDir)
+			return nil, status.Error(codes.Internal, fmt.Sprintf("could not freeze %s: %v", m, err))
+		}
+		frozen = append(frozen, m)
+	}
+
+	for _, m := range members {
+		s.logger.Info().Msgf("dumping %s of group %s", m, resp.GroupID)
+		if err := s.dumpMember(ctx, groupDir, m); err != nil {
+			groupSpan.RecordError(err)
+			thaw()
+			os.RemoveAll(groupDir)
+			return nil, status.Error(codes.Internal, fmt.Sprintf("could not dump %s, group checkpoint abandoned: %v", m, err))
+		}
+		resp.Members = append(resp.Members, m.GroupMember)
+	}
+
+	if err := s.client.db.SetCheckpointGroup(resp); err != nil {
+		thaw()
+		os.RemoveAll(groupDir)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if args.LeaveRunning {
+		thaw()
+	} else {
+		for _, m := range members {
+			if err := m.kill(); err != nil {
+				s.logger.Warn().Msgf("could not kill %s after dumping it: %v", m, err)
+			}
+		}
+	}
+
+	s.logger.Info().Msgf("dumped group %s of %d members to %s", resp.GroupID, len(members), groupDir)
+	return resp, nil
+}
+
+// restoreMember restores a member of a group, left frozen like it was dumped
+func (s *service) restoreMember(ctx context.Context, m *groupMember) error {
+	if m.ContainerID == "" {
+		pid, trees, err := s.client.Restore(ctx, &task.RestoreArgs{
+			Type:           task.RestoreArgs_LOCAL,
+			CheckpointPath: m.CheckpointPath,
+		}, nil)
+		if err != nil {
+			return err
+		}
+		m.PID = *pid
+		m.trees = trees
+		m.members = s.restoredJob(m.JobID, *pid, trees)
+		return nil
+	}
+
+	err := s.client.RuncRestore(ctx, m.CheckpointPath, m.ContainerID, false, []string{}, &container.RuncOpts{
+		Root:    m.Root,
+		Bundle:  m.Bundle,
+		Detatch: true,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	pid, err := runc.GetPidByContainerId(m.ContainerID, m.Root)
+	if err != nil {
+		return err
+	}
+	m.PID = int32(pid)
+	m.rc = container.GetContainerFromRunc(m.ContainerID, m.Root)
+	return nil
+}
+
+func (s *service) GroupRestore(ctx context.Context, args *task.GroupRestoreArgs) (*task.GroupRestoreResp, error) {
+	ctx, groupSpan := s.client.tracer.Start(ctx, "group-restore")
+	groupSpan.SetAttributes(attribute.String("groupID", args.GroupID))
+	defer groupSpan.End()
+
+	group, err := s.client.db.GetCheckpointGroup(args.GroupID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("group %s not found", args.GroupID))
+	}
+
+	var restored []*groupMember
+	for _, member := range group.Members {
+		m := &groupMember{GroupMember: member}
+		s.logger.Info().Msgf("restoring %s of group %s", m, args.GroupID)
+		if err := s.restoreMember(ctx, m); err != nil {
+			groupSpan.RecordError(err)
+			// a group is restored whole or not at all
+			for _, r := range restored {
+				if r.rc == nil {
+					for _, root := range append([]int32{r.PID}, r.trees...) {
+						if err := killTree(root); err != nil {
+							s.logger.Warn().Msgf("could not kill restored %s: %v", r, err)
+						}
+					}
+					continue
+				}
+				if err := r.kill(); err != nil {
+					s.logger.Warn().Msgf("could not kill restored %s: %v", r, err)
+				}
+			}
+			return nil, status.Error(restoreErrorCode(err), fmt.Sprintf("could not restore %s, group restore rolled back: %v", m, err))
+		}
+		restored = append(restored, m)
+	}
+
+	resp := &task.GroupRestoreResp{}
+	for _, m := range restored {
+		if err := m.thaw(); err != nil {
+			s.logger.Warn().Msgf("could not resume restored %s: %v", m, err)
+		}
+		resp.Members = append(resp.Members, m.GroupMember)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"os/exec"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/cedana/cedana/utils"
+)
+
+// waitState waits for pid to be in state, '\x00' for anything but stopped
+func waitState(t *testing.T, pid int32, state byte) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		stat, err := utils.ReadProcStat(pid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stat.State == state || (state == 0 && stat.State != 'T') {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("process %d never got to state %q", pid, state)
+}
+
+// Freezes and thaws a job with a process stopped beforehand, which should be
+// the only one left stopped.
+func TestThawLeavesStoppedProcesses(t *testing.T) {
+	m := &groupMember{GroupMember: &task.GroupMember{}}
+	for i := 0; i < 2; i++ {
+		cmd := exec.Command("sleep", "10")
+		cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+		if err := cmd.Start(); err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+			cmd.Wait()
+		})
+		m.members = append(m.members, &task.ProcessMember{PID: int32(cmd.Process.Pid)})
+	}
+	stopped, running := m.members[0].PID, m.members[1].PID
+
+	if err := syscall.Kill(int(stopped), syscall.SIGSTOP); err != nil {
+		t.Fatal(err)
+	}
+	waitState(t, stopped, 'T')
+
+	if err := m.freeze(); err != nil {
+		t.Fatal(err)
+	}
+	waitState(t, running, 'T')
+	if len(m.Stopped) != 1 || m.Stopped[0] != stopped {
+		t.Fatalf("expected only %d to be noted as stopped, got %v", stopped, m.Stopped)
+	}
+
+	if err := m.thaw(); err != nil {
+		t.Fatal(err)
+	}
+	waitState(t, running, 0)
+	time.Sleep(50 * time.Millisecond)
+	waitState(t, stopped, 'T')
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+	"time"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/cedana/cedana/utils"
+	"github.com/robfig/cron/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The daemon samples the usage of every running job on the interval of the
+// metrics config, summed over the processes of the job, into a time series per
+// job in the db. Samples past the retention of the config get pruned as new ones
+// come in.
+
+func usageOf(u *utils.ProcessUsage) *task.ResourceUsage {
+	return &task.ResourceUsage{
+		RSS:          u.RSS,
+		PSS:          u.PSS,
+		AnonBytes:    u.AnonBytes,
+		FileBytes:    u.FileBytes,
+		Threads:      u.Threads,
+		CPUUser:      u.CPUUser,
+		CPUSystem:    u.CPUSystem,
+		IOReadBytes:  u.IOReadBytes,
+		IOWriteBytes: u.IOWriteBytes,
+		Mappings:     u.Mappings,
+	}
+}
+
+// processUsage reads the usage of pid alone, nil if it's gone
+func processUsage(pid int32) *task.ResourceUsage {
+	u, err := utils.ReadProcessUsage(pid)
+	if err != nil {
+		return nil
+	}
+	return usageOf(u)
+}
+
+// jobUsage sums the usage of the processes of a running job
+func jobUsage(state *task.ProcessState) (*task.ResourceUsage, error) {
+	if state.PID == 0 || syscall.Kill(int(state.PID), 0) != nil {
+		return nil, fmt.Errorf("process %d is not running", state.PID)
+	}
+	_, members, err := jobTrees(state.PID, state.Scope, state.Trees)
+	if err != nil {
+		return nil, err
+	}
+
+	total := &utils.ProcessUsage{}
+	for _, member := range members {
+		u, err := utils.ReadProcessUsage(member.PID)
+		if err != nil {
+			// exited since we listed the job
+			continue
+		}
+		total.Add(u)
+	}
+	return usageOf(total), nil
+}
+
+// sampleJobs records a usage sample of every running job, and prunes the ones
+// older than retention. A job that can't be sampled doesn't keep the others from
+// being sampled, nor old samples from being pruned.
+func (s *service) sampleJobs(retention time.Duration) error {
+	now := time.Now()
+
+	states, err := s.client.db.ListJobStates()
+	if err != nil {
+		s.logger.Warn().Msgf("could not list jobs to sample: %v", err)
+	}
+	for jobID, state := range states {
+		if state.Flag != task.FlagEnum_JOB_RUNNING {
+			continue
+		}
+		usage, err := jobUsage(state)
+		if err != nil {
+			continue
+		}
+		if err := s.client.db.AddJobMetrics(jobID, &task.JobMetricsSample{Timestamp: now.UnixNano(), Usage: usage}); err != nil {
+			s.logger.Warn().Msgf("could not record metrics of job %s: %v", jobID, err)
+		}
+	}
+
+	_, err = s.client.db.PruneJobMetrics(now.Add(-retention).UnixNano())
+	return err
+}
+
+// scheduleMetrics samples the usage of the jobs on the interval of the metrics
+// config
+func (sc *scheduler) scheduleMetrics(metrics utils.Metrics) error {
+	if err := metrics.Validate(); err != nil {
+		return err
+	}
+
+	interval, _ := metrics.SampleInterval()
+	retention, _ := metrics.RetentionPeriod()
+	job := cron.NewChain(cron.SkipIfStillRunning(cron.DiscardLogger)).Then(cron.FuncJob(func() {
+		if err := sc.s.sampleJobs(retention); err != nil {
+			sc.logger.Warn().Msgf("could not sample job metrics: %v", err)
+		}
+	}))
+	sc.cron.Schedule(cron.Every(interval), job)
+
+	sc.logger.Info().Msgf("sampling job metrics every %s, kept for %s", interval, retention)
+	return nil
+}
+
+func (s *service) GetJobMetrics(ctx context.Context, args *task.GetJobMetricsArgs) (*task.GetJobMetricsResp, error) {
+	if args.JobID == "" {
+		return nil, status.Error(codes.InvalidArgument, "job id cannot be empty")
+	}
+
+	state, err := s.client.db.GetStateFromID(args.JobID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("job %s not found: %v", args.JobID, err))
+	}
+
+	samples, err := s.client.db.ListJobMetrics(args.JobID, args.Since)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &task.GetJobMetricsResp{Samples: samples}
+	if state.Flag == task.FlagEnum_JOB_RUNNING {
+		resp.Current, _ = jobUsage(state)
+	}
+	return resp, nil
+}
@@ -0,0 +1,447 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	task "github.com/cedana/cedana/api/services/task"
+	"github.com/cedana/cedana/utils"
+	"github.com/checkpoint-restore/go-criu/v6/rpc"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// where migrations are dumped to on the source, and received into on the target
+	migrationDir = "/tmp/cedana_migrate"
+	// size of the checkpoint chunks streamed to the target daemon
+	migrationChunkSize = 1 << 20
+	// how long the target waits for its page server to finish writing pages
+	pageServerExitTimeout = 30 * time.Second
+	// how long a prepared migration waits for the source to complete it
+	migrationTTL = 10 * time.Minute
+)
+
+// A migration is prepared on the target daemon: a page server receiving the pages
+// of the job into dir, waiting for the rest of the checkpoint to be streamed over.
+// It expires after migrationTTL if it's never completed.
+type migration struct {
+	jobID          string
+	dir            string
+	pageServerPid  int32
+	pageServerPort int32
+	expiry         *time.Timer
+}
+
+// cleanup stops the page server of the migration, if it's still up, and removes
+// what it received
+func (m *migration) cleanup() {
+	if !pageServerExited(m.pageServerPid) {
+		syscall.Kill(int(m.pageServerPid), syscall.SIGKILL)
+	}
+	os.RemoveAll(m.dir)
+}
+
+// takeMigration removes migration id from the ones prepared, for the caller to
+// complete or clean up
+func (s *service) takeMigration(id string) (*migration, bool) {
+	s.migrationsMu.Lock()
+	defer s.migrationsMu.Unlock()
+	m, ok := s.migrations[id]
+	if !ok {
+		return nil, false
+	}
+	delete(s.migrations, id)
+	m.expiry.Stop()
+	return m, true
+}
+
+// Live migration of a job to another cedana daemon. The target starts a CRIU page
+// server, the source dumps straight to it and streams the remaining (non-memory)
+// images over, after which the target restores. The job stays stopped on the
+// source until the target has restored it, and is continued if it can't.
+// Migration -> PrepareMigration (target) -> dump to page server -> CompleteMigration (target)
+func (s *service) Migrate(ctx context.Context, args *task.MigrateArgs) (*task.MigrateResp, error) {
+	ctx, migrateSpan := s.client.tracer.Start(ctx, "migrate")
+	migrateSpan.SetAttributes(attribute.String("jobID", args.JobID))
+	defer migrateSpan.End()
+
+	if args.JobID == "" {
+		return nil, status.Error(codes.InvalidArgument, "job id cannot be empty")
+	}
+	if args.Target == "" {
+		return nil, status.Error(codes.InvalidArgument, "target cannot be empty")
+	}
+
+	pid, err := s.client.db.GetPID(args.JobID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("job %s not found: %v", args.JobID, err))
+	}
+
+	release, err := s.client.reserveDump(args.JobID)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	defer release()
+
+	host, _, err := net.SplitHostPort(args.Target)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid target %s: %v", args.Target, err))
+	}
+	pageServerAddress := args.PageServerAddress
+	if pageServerAddress == "" {
+		pageServerAddress = host
+	}
+	if pageServerAddress == "" {
+		pageServerAddress = "127.0.0.1"
+	}
+
+	var opts []grpc.DialOption
+	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+
+	targetConn, err := grpc.Dial(args.Target, opts...)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, fmt.Sprintf("could not connect to target %s: %v", args.Target, err))
+	}
+	defer targetConn.Close()
+
+	target := task.NewTaskServiceClient(targetConn)
+
+	prep, err := target.PrepareMigration(ctx, &task.PrepareMigrationArgs{JobID: args.JobID})
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, fmt.Sprintf("target could not prepare migration: %v", err))
+	}
+	migrateSpan.SetAttributes(attribute.String("migrationID", prep.MigrationID))
+
+	dir := args.Dir
+	if dir == "" {
+		dir = migrationDir
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.logger.Info().Msgf("migrating job %s (pid %d) to %s, page server on %s:%d", args.JobID, pid, args.Target, pageServerAddress, prep.PageServerPort)
+
+	err = s.client.dump(ctx, &task.DumpArgs{
+		PID:   pid,
+		Dir:   dir,
+		JobID: args.JobID,
+		// not archived locally, the checkpoint dir is streamed to the target instead
+		Type: task.DumpArgs_REMOTE,
+	}, &rpc.CriuPageServerInfo{
+		Address: proto.String(pageServerAddress),
+		Port:    proto.Int32(prep.PageServerPort),
+	}, false)
+	if err != nil {
+		s.continueMigrated(pid)
+		migrateSpan.RecordError(err)
+		return nil, status.Error(codes.Internal, fmt.Sprintf("dump to page server failed: %v", err))
+	}
+
+	state, err := s.client.db.GetStateFromID(args.JobID)
+	if err != nil {
+		s.continueMigrated(pid)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp, err := sendMigration(ctx, target, prep.MigrationID, state.CheckpointPath)
+	if err != nil {
+		s.continueMigrated(pid)
+		migrateSpan.RecordError(err)
+		code := codes.Internal
+		if status.Code(err) == codes.DataLoss {
+			code = codes.DataLoss
+		}
+		return nil, status.Error(code, fmt.Sprintf("restore on target failed, job %s continues here: %v", args.JobID, err))
+	}
+
+	// the job runs on the target now
+	if err := killTree(pid); err != nil {
+		s.logger.Warn().Msgf("could not kill migrated job %s (pid %d): %v", args.JobID, pid, err)
+	}
+	os.RemoveAll(state.CheckpointPath)
+	state.Flag = task.FlagEnum_JOB_KILLED
+	if err := s.client.db.UpdateProcessStateWithID(args.JobID, state); err != nil {
+		s.logger.Warn().Msgf("could not record migrated job %s: %v", args.JobID, err)
+	}
+
+	return &task.MigrateResp{
+		Message:     fmt.Sprintf("Migrated job %s to %s, new pid %d", args.JobID, args.Target, resp.NewPID),
+		MigrationID: prep.MigrationID,
+		NewPID:      resp.NewPID,
+	}, nil
+}
+
+// continueMigrated continues the job of pid, left stopped by its dump to the page
+// server, after its migration failed
+func (s *service) continueMigrated(pid int32) {
+	members, err := treeMembers([]int32{pid})
+	if err == nil {
+		err = signalMembers(members, syscall.SIGCONT)
+	}
+	if err != nil {
+		s.logger.Warn().Msgf("could not continue pid %d after its failed migration: %v", pid, err)
+	}
+}
+
+// sendMigration streams the checkpoint (minus the pages, which went to the page server)
+// to the target daemon and waits for it to restore.
+func sendMigration(ctx context.Context, target task.TaskServiceClient, migrationID, checkpointDir string) (*task.RestoreResp, error) {
+	stream, err := target.CompleteMigration(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tarStream, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(utils.TarFolderToWriter(checkpointDir, pw))
+	}()
+	defer tarStream.Close()
+
+	buf := make([]byte, migrationChunkSize)
+	chunk := &task.MigrationChunk{MigrationID: migrationID}
+	for {
+		n, err := tarStream.Read(buf)
+		if n > 0 {
+			chunk.Data = buf[:n]
+			if err := stream.Send(chunk); err != nil {
+				return nil, err
+			}
+			chunk = &task.MigrationChunk{}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
+
+func (s *service) PrepareMigration(ctx context.Context, args *task.PrepareMigrationArgs) (*task.PrepareMigrationResp, error) {
+	ctx, prepSpan := s.client.tracer.Start(ctx, "prepare-migration")
+	prepSpan.SetAttributes(attribute.String("jobID", args.JobID))
+	defer prepSpan.End()
+
+	if args.JobID == "" {
+		return nil, status.Error(codes.InvalidArgument, "job id cannot be empty")
+	}
+
+	id := uuid.New().String()
+	dir := filepath.Join(migrationDir, id)
+	if err := os.MkdirAll(dir, 0o777); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pid, port, err := s.client.startPageServer(ctx, dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		prepSpan.RecordError(err)
+		return nil, status.Error(codes.Internal, fmt.Sprintf("could not start page server: %v", err))
+	}
+
+	s.migrationsMu.Lock()
+	s.migrations[id] = &migration{
+		jobID:          args.JobID,
+		dir:            dir,
+		pageServerPid:  pid,
+		pageServerPort: port,
+		expiry: time.AfterFunc(migrationTTL, func() {
+			if m, ok := s.takeMigration(id); ok {
+				s.logger.Warn().Msgf("migration %s of job %s was never completed, dropping it", id, m.jobID)
+				m.cleanup()
+			}
+		}),
+	}
+	s.migrationsMu.Unlock()
+
+	s.logger.Info().Msgf("prepared migration %s of job %s, page server on port %d", id, args.JobID, port)
+
+	return &task.PrepareMigrationResp{MigrationID: id, PageServerPort: port}, nil
+}
+
+func (s *service) CompleteMigration(stream task.TaskService_CompleteMigrationServer) error {
+	ctx, completeSpan := s.client.tracer.Start(stream.Context(), "complete-migration")
+	defer completeSpan.End()
+
+	chunk, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	m, ok := s.takeMigration(chunk.MigrationID)
+	if !ok {
+		return status.Error(codes.NotFound, fmt.Sprintf("migration %s not found", chunk.MigrationID))
+	}
+	defer m.cleanup()
+	completeSpan.SetAttributes(attribute.String("jobID", m.jobID))
+
+	// extract the checkpoint as it streams in, next to the pages from the page server
+	pr, pw := io.Pipe()
+	extracted := make(chan error, 1)
+	go func() {
+		err := utils.UntarFromReader(pr, m.dir)
+		pr.CloseWithError(err)
+		extracted <- err
+	}()
+
+	for {
+		if _, err := pw.Write(chunk.Data); err != nil {
+			break
+		}
+		chunk, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			pw.CloseWithError(err)
+			<-extracted
+			return err
+		}
+	}
+	pw.Close()
+
+	if err := <-extracted; err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("could not decompress checkpoint: %v", err))
+	}
+
+	if err := waitForPageServer(m.pageServerPid); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	state, pid, err := s.client.restoreMigration(ctx, m.dir, m.jobID)
+	if err != nil {
+		completeSpan.RecordError(err)
+		return status.Error(restoreErrorCode(err), fmt.Sprintf("failed to restore process: %v", err))
+	}
+
+	state.PID = *pid
+	state.PidNamespace = pidNamespace(*pid)
+	state.Cgroup = s.client.cgroupOf(*pid)
+	state.Limits = cgroupLimits(state.Cgroup)
+	state.Flag = task.FlagEnum_JOB_RUNNING
+	state.CheckpointState = task.CheckpointState_RESTORED
+	if err := s.client.db.CreateOrUpdateCedanaProcess(m.jobID, state); err != nil {
+		s.logger.Warn().Msgf("could not record migrated job %s: %v", m.jobID, err)
+	}
+
+	return stream.SendAndClose(&task.RestoreResp{
+		Message: fmt.Sprintf("Successfully restored migrated process: %v", *pid),
+		NewPID:  *pid,
+	})
+}
+
+// startPageServer starts a daemonized CRIU page server receiving pages into dir,
+// listening on a port picked by CRIU. Returns the pid and port of the page server.
+func (c *Client) startPageServer(ctx context.Context, dir string) (int32, int32, error) {
+	_, psSpan := c.tracer.Start(ctx, "page-server")
+	defer psSpan.End()
+
+	img, err := os.Open(dir)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer img.Close()
+
+	opts := &rpc.CriuOpts{
+		ImagesDirFd: proto.Int32(int32(img.Fd())),
+		LogLevel:    proto.Int32(4),
+		LogFile:     proto.String("page-server.log"),
+		Ps: &rpc.CriuPageServerInfo{
+			Address: proto.String("0.0.0.0"),
+			Port:    proto.Int32(0),
+		},
+	}
+
+	resp, err := c.CRIU.StartPageServer(opts)
+	if err != nil {
+		psSpan.RecordError(err)
+		return 0, 0, err
+	}
+
+	ps := resp.GetPs()
+	if ps.GetPort() == 0 {
+		return 0, 0, fmt.Errorf("page server did not report its port")
+	}
+
+	return ps.GetPid(), ps.GetPort(), nil
+}
+
+// verifyMigration verifies the checkpoint of a migrated process in dir, where
+// the page server received its pages. The source never had those, so they're
+// the only files its manifest may leave out.
+func (c *Client) verifyMigration(ctx context.Context, dir string) error {
+	return c.verifyCheckpoint(ctx, dir, utils.PageServerFiles...)
+}
+
+// restoreMigration restores a migrated process from dir, where its pages were
+// received by the page server and the rest of the checkpoint decompressed into.
+func (c *Client) restoreMigration(ctx context.Context, dir, jobID string) (*task.ProcessState, *int32, error) {
+	if err := c.verifyMigration(ctx, dir); err != nil {
+		return nil, nil, err
+	}
+
+	opts := c.prepareRestoreOpts()
+
+	state, extraFiles, err := c.prepareRestoreDir(ctx, opts, dir, &stdio{})
+	if err != nil {
+		return nil, nil, err
+	}
+	cgroup, err := c.restoreCgroup(opts, state, jobID, nil)
+	if err != nil {
+		closeExtraFiles(extraFiles)
+		return nil, nil, err
+	}
+	defer c.reapCgroup(cgroup)
+	backup, err := c.restoreFiles(state, dir, false)
+	if err != nil {
+		closeExtraFiles(extraFiles)
+		return nil, nil, err
+	}
+
+	pid, _, err := c.restore(ctx, opts, dir, state, extraFiles, false)
+	if err != nil {
+		backup.rollback(c.logger)
+		return nil, nil, err
+	}
+
+	return state, pid, nil
+}
+
+// waitForPageServer waits for the page server to exit, which it does once the dump
+// closes its connection and all pages have been written out.
+func waitForPageServer(pid int32) error {
+	deadline := time.Now().Add(pageServerExitTimeout)
+	for time.Now().Before(deadline) {
+		if pageServerExited(pid) {
+			return nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return fmt.Errorf("page server %d still running after %v", pid, pageServerExitTimeout)
+}
+
+// pageServerExited tells whether the page server is gone, or a zombie nobody
+// reaped yet
+func pageServerExited(pid int32) bool {
+	if pid == 0 {
+		return true
+	}
+	stat, err := utils.ReadProcStat(pid)
+	return err != nil || stat.State == 'Z' || stat.State == 'X'
+}
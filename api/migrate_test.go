@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/cedana/cedana/utils"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Migrates a signed checkpoint to a target enforcing signatures, which should
+// take the pages its page server received, but nothing else the source didn't
+// sign. There's no CRIU to restore with, so the migration fails past that.
+func TestMigrationEnforced(t *testing.T) {
+	keys := t.TempDir()
+	trusted := t.TempDir()
+	keyPath := filepath.Join(keys, "node.key")
+	if _, err := utils.GenerateSigningKey(keyPath); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(keyPath+".pub", filepath.Join(trusted, "node.pub")); err != nil {
+		t.Fatal(err)
+	}
+	signing := utils.Signing{KeyFile: keyPath, TrustStore: trusted, Policy: utils.SignaturePolicyEnforce}
+
+	logger := utils.GetLogger()
+	c := &Client{
+		CRIU:   new(Criu),
+		logger: &logger,
+		config: &utils.Config{Signing: signing},
+		tracer: noop.NewTracerProvider().Tracer(""),
+	}
+	s := &service{client: c, logger: &logger, migrations: map[string]*migration{}}
+
+	// the source's dump, minus the pages it sent to the page server
+	src := t.TempDir()
+	if err := os.WriteFile(filepath.Join(src, "core-1.img"), []byte("core"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SerializeStateToDir(src, &task.ProcessState{ProcessInfo: &task.ProcessInfo{}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := utils.WriteManifest(src, ""); err != nil {
+		t.Fatal(err)
+	}
+	signer, err := utils.LoadSigner(signing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := signer.SignManifest(src); err != nil {
+		t.Fatal(err)
+	}
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	task.RegisterTaskServiceServer(srv, s)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	conn, err := grpc.DialContext(context.Background(), "", grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	target := task.NewTaskServiceClient(conn)
+
+	// prepare puts a migration where the page server has received its pages
+	prepare := func(id string, received ...string) {
+		dir := filepath.Join(t.TempDir(), id)
+		if err := os.Mkdir(dir, 0o777); err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range received {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte("received"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		s.migrations[id] = &migration{jobID: id, dir: dir, expiry: time.AfterFunc(time.Hour, func() {})}
+		// the restore of the migration logs to the default output
+		t.Cleanup(func() {
+			logs, _ := filepath.Glob("/var/log/cedana-output-*-" + id + ".log")
+			for _, log := range logs {
+				os.Remove(log)
+			}
+		})
+	}
+
+	prepare("pages", "pages-1.img", "pagemap-1.img", "page-server.log")
+	_, err = sendMigration(context.Background(), target, "pages", src)
+	if code := status.Code(err); code == codes.OK || code == codes.DataLoss || code == codes.PermissionDenied {
+		t.Errorf("expected a migration with its pages to get past verification, got %v", err)
+	}
+
+	prepare("smuggled", "pages-1.img", "pagemap-1.img", "page-server.log", "extra.img")
+	_, err = sendMigration(context.Background(), target, "smuggled", src)
+	if status.Code(err) != codes.DataLoss {
+		t.Errorf("expected a migration with an unsigned file to be refused, got %v", err)
+	}
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/cedana/cedana/utils"
+)
+
+// networkLock is the lock of a job's network, or nil if locking is off or the
+// job has nothing to lock. Its name comes from the dumped pid, so the lock taken
+// on dump is found again on restore, where pid is the restored one.
+func (c *Client) networkLock(state *task.ProcessState, pid int32) *utils.NetworkLock {
+	if c.config.Network.LockBackend == "" || state.ProcessInfo == nil {
+		return nil
+	}
+
+	lock := &utils.NetworkLock{
+		Backend: c.config.Network.LockBackend,
+		Name:    fmt.Sprintf("cedana-%d", state.PID),
+	}
+
+	// a job with a netns of its own is locked whole, from inside it
+	netns := fmt.Sprintf("/proc/%d/ns/net", pid)
+	own, err1 := os.Readlink(netns)
+	host, err2 := os.Readlink("/proc/self/ns/net")
+	if err1 == nil && err2 == nil && own != host {
+		lock.Netns = netns
+		return lock
+	}
+
+	for _, conn := range state.ProcessInfo.OpenConnections {
+		if conn.Laddr == nil || (conn.Type != syscall.SOCK_STREAM && conn.Type != syscall.SOCK_DGRAM) {
+			continue
+		}
+		lock.Endpoints = append(lock.Endpoints, utils.Endpoint{
+			IP:   conn.Laddr.IP,
+			Port: conn.Laddr.Port,
+			Type: conn.Type,
+		})
+	}
+	if len(lock.Endpoints) == 0 {
+		return nil
+	}
+	return lock
+}
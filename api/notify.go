@@ -10,6 +10,10 @@ type Notify struct {
 	PostDumpFunc   NotifyFunc
 	PreRestoreFunc NotifyFunc
 	PreResumeFunc  NotifyFunc
+	// CRIU only asks for the network to be locked and unlocked when the tasks
+	// have a network namespace of their own
+	NetworkLockFunc   NotifyFunc
+	NetworkUnlockFunc NotifyFunc
 }
 
 type NotifyFunc struct {
@@ -70,13 +74,27 @@ func (n Notify) PostRestore(pid int32) error {
 	return nil
 }
 
-// NetworkLock NoNotify
 func (n Notify) NetworkLock() error {
+	if n.NetworkLockFunc.Avail {
+		n.Logger.Debug().Msgf("executing network lock anonymous function")
+		err := n.NetworkLockFunc.Callback()
+		if err != nil {
+			n.Logger.Error().Err(err).Msgf("failed to execute network lock anonymous function")
+			return err
+		}
+	}
 	return nil
 }
 
-// NetworkUnlock NoNotify
 func (n Notify) NetworkUnlock() error {
+	if n.NetworkUnlockFunc.Avail {
+		n.Logger.Debug().Msgf("executing network unlock anonymous function")
+		err := n.NetworkUnlockFunc.Callback()
+		if err != nil {
+			n.Logger.Error().Err(err).Msgf("failed to execute network unlock anonymous function")
+			return err
+		}
+	}
 	return nil
 }
 
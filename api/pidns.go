@@ -0,0 +1,138 @@
+package api
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/cedana/cedana/utils"
+)
+
+// Jobs can run in a pid namespace of their own, so they never clash with pids
+// taken on the host they are restored on. cedana pidns-init is pid 1 in there:
+// it runs the job, or criu restoring it, forwards signals and reaps orphans, and
+// exits along with the last process of the namespace. Being the init, it is the
+// root of the job's tree on the host and what gets dumped, after which CRIU
+// recreates the namespace on every restore.
+
+const PidnsInitCommand = "pidns-init"
+
+// how long a restored process takes to be reparented to the init, once criu exits
+const pidnsReparentTimeout = 5 * time.Second
+
+// signals the init passes on to the rest of the namespace
+var pidnsSignals = []os.Signal{syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP, syscall.SIGQUIT, syscall.SIGUSR1, syscall.SIGUSR2}
+
+// inPidns makes cmd run in a new pid namespace, under a cedana init
+func inPidns(cmd *exec.Cmd) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("could not find the cedana binary to run the pid namespace init: %w", err)
+	}
+	cmd.Args = append([]string{exe, PidnsInitCommand, "--", cmd.Path}, cmd.Args[1:]...)
+	cmd.Path = exe
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Cloneflags |= syscall.CLONE_NEWPID
+	return nil
+}
+
+// pidNamespace maps the processes of the tree of pid to their pids in its pid
+// namespace, nil unless pid is the init of one
+func pidNamespace(pid int32) *task.PidNamespace {
+	nspids, err := utils.NsPids(pid)
+	if err != nil || len(nspids) < 2 || nspids[len(nspids)-1] != 1 {
+		return nil
+	}
+	level := len(nspids) - 1
+
+	tree, err := utils.ProcessTree(pid)
+	if err != nil {
+		return nil
+	}
+	ns := &task.PidNamespace{InitPID: pid}
+	for _, p := range tree {
+		nspids, err := utils.NsPids(p)
+		if err != nil || len(nspids) <= level {
+			// exited while we walked the tree
+			continue
+		}
+		ns.Mappings = append(ns.Mappings, &task.PidMapping{HostPID: p, NsPID: nspids[level]})
+	}
+	return ns
+}
+
+// nsHostPid finds the host pid of the process nspid in the pid namespace of
+// init, waiting for it to be reparented to the init
+func nsHostPid(init, nspid int32) (int32, error) {
+	deadline := time.Now().Add(pidnsReparentTimeout)
+	for {
+		if ns := pidNamespace(init); ns != nil {
+			for _, m := range ns.Mappings {
+				if m.NsPID == nspid && m.HostPID != init {
+					return m.HostPID, nil
+				}
+			}
+		}
+		if time.Now().After(deadline) {
+			return 0, fmt.Errorf("process %d not found in the pid namespace of %d", nspid, init)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// PidnsInit runs argv as the init of a pid namespace, until no process is left
+// in it. Returns the exit code of argv.
+func PidnsInit(argv []string) (int, error) {
+	if os.Getpid() != 1 {
+		return 0, fmt.Errorf("%s has to be pid 1 of its pid namespace", PidnsInitCommand)
+	}
+	if len(argv) == 0 {
+		return 0, fmt.Errorf("no command to run")
+	}
+
+	sigs := make(chan os.Signal, 8)
+	signal.Notify(sigs, pidnsSignals...)
+	go func() {
+		for sig := range sigs {
+			// everyone in the namespace but us
+			syscall.Kill(-1, sig.(syscall.Signal))
+		}
+	}()
+
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return 0, err
+	}
+
+	code := 0
+	for {
+		var ws syscall.WaitStatus
+		pid, err := syscall.Wait4(-1, &ws, 0, nil)
+		if err == syscall.EINTR {
+			continue
+		}
+		if err == syscall.ECHILD {
+			return code, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		if pid != cmd.Process.Pid {
+			continue
+		}
+		if ws.Signaled() {
+			code = 128 + int(ws.Signal())
+		} else {
+			code = ws.ExitStatus()
+		}
+	}
+}
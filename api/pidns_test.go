@@ -0,0 +1,39 @@
+package api
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+	"testing"
+)
+
+func TestInPidns(t *testing.T) {
+	cmd := exec.Command("/bin/sh", "-c", "exit 3")
+	if err := inPidns(cmd); err != nil {
+		t.Fatal(err)
+	}
+
+	exe, _ := os.Executable()
+	want := []string{exe, PidnsInitCommand, "--", "/bin/sh", "-c", "exit 3"}
+	if cmd.Path != exe || len(cmd.Args) != len(want) {
+		t.Fatalf("expected %v, got %s %v", want, cmd.Path, cmd.Args)
+	}
+	for i := range want {
+		if cmd.Args[i] != want[i] {
+			t.Errorf("expected %v, got %v", want, cmd.Args)
+			break
+		}
+	}
+	if cmd.SysProcAttr.Cloneflags&syscall.CLONE_NEWPID == 0 {
+		t.Error("expected a new pid namespace")
+	}
+}
+
+func TestPidNamespaceOfHostProcess(t *testing.T) {
+	if os.Getpid() == 1 {
+		t.Skip("we are an init ourselves")
+	}
+	if ns := pidNamespace(int32(os.Getpid())); ns != nil {
+		t.Errorf("expected no pid namespace of our own, got %v", ns)
+	}
+}
@@ -0,0 +1,213 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/containerd/console"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Shell jobs restored with a pty get a new pty pair: CRIU is handed the slave as
+// the job's terminal, the daemon keeps the master. It drains the master into a
+// backlog, so the job never blocks on a terminal nobody reads, and forwards the
+// output to whoever is attached.
+
+// output of a pty replayed on attach
+const ptyBacklog = 64 << 10
+
+// ttyKey is the CRIU inherit-fd key of the terminal the standard streams of pid
+// are open to, empty if they aren't
+func ttyKey(pid int32) string {
+	for fd := 0; fd < 3; fd++ {
+		path := fmt.Sprintf("/proc/%d/fd/%d", pid, fd)
+		link, err := os.Readlink(path)
+		if err != nil || !strings.HasPrefix(link, "/dev/pts/") {
+			continue
+		}
+		var st syscall.Stat_t
+		if err := syscall.Stat(path, &st); err != nil {
+			continue
+		}
+		return fmt.Sprintf("tty[%x:%x]", st.Rdev, st.Dev)
+	}
+	return ""
+}
+
+// newPty opens a pty pair, the slave to give to the restored job
+func newPty() (console.Console, *os.File, error) {
+	master, slavePath, err := console.NewPty()
+	if err != nil {
+		return nil, nil, err
+	}
+	slave, err := os.OpenFile(slavePath, os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		master.Close()
+		return nil, nil, err
+	}
+	return master, slave, nil
+}
+
+// ptySession is the master of the pty of a restored job
+type ptySession struct {
+	master console.Console
+
+	mu       sync.Mutex
+	backlog  []byte
+	attached chan []byte
+
+	// closed once the job is gone and the master with it
+	done chan struct{}
+}
+
+// holdPty keeps the master of the pty of pid until every process on it is gone
+func (c *Client) holdPty(pid int32, master console.Console) {
+	p := &ptySession{master: master, done: make(chan struct{})}
+	c.ptys.Store(pid, p)
+	go func() {
+		p.drain()
+		c.ptys.Delete(pid)
+	}()
+}
+
+func (p *ptySession) drain() {
+	defer close(p.done)
+	defer p.master.Close()
+
+	buf := make([]byte, 32<<10)
+	for {
+		// fails with EIO once the slave is closed for good
+		n, err := p.master.Read(buf)
+		if n > 0 {
+			out := append([]byte(nil), buf[:n]...)
+			p.mu.Lock()
+			p.backlog = append(p.backlog, out...)
+			if len(p.backlog) > ptyBacklog {
+				p.backlog = append([]byte(nil), p.backlog[len(p.backlog)-ptyBacklog:]...)
+			}
+			if p.attached != nil {
+				// a client too slow to keep up loses output, the job doesn't stall
+				select {
+				case p.attached <- out:
+				default:
+				}
+			}
+			p.mu.Unlock()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// attach makes the caller the receiver of the output, returning what the job
+// wrote recently. Only one client is attached at a time.
+func (p *ptySession) attach() ([]byte, chan []byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.attached != nil {
+		return nil, nil, fmt.Errorf("another client is attached")
+	}
+	p.attached = make(chan []byte, 256)
+	return append([]byte(nil), p.backlog...), p.attached, nil
+}
+
+func (p *ptySession) detach(out chan []byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.attached == out {
+		p.attached = nil
+	}
+}
+
+func (p *ptySession) resize(rows, cols uint32) error {
+	if rows == 0 || cols == 0 {
+		return nil
+	}
+	return p.master.Resize(console.WinSize{Height: uint16(rows), Width: uint16(cols)})
+}
+
+func (s *service) Attach(stream task.TaskService_AttachServer) error {
+	args, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	pid := args.PID
+	if args.JobID != "" {
+		state, err := s.client.db.GetStateFromID(args.JobID)
+		if err != nil || state == nil {
+			return status.Error(codes.NotFound, fmt.Sprintf("job %s not found", args.JobID))
+		}
+		pid = state.PID
+	}
+	held, ok := s.client.ptys.Load(pid)
+	if !ok {
+		return status.Error(codes.NotFound, fmt.Sprintf("no terminal held for pid %d, it has to be restored with a pty to attach to it", pid))
+	}
+	p := held.(*ptySession)
+
+	backlog, out, err := p.attach()
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	defer p.detach(out)
+
+	if err := p.resize(args.Rows, args.Cols); err != nil {
+		s.logger.Warn().Msgf("could not resize terminal of pid %d: %v", pid, err)
+	}
+	if len(backlog) > 0 {
+		if err := stream.Send(&task.AttachResp{Output: backlog}); err != nil {
+			return err
+		}
+	}
+
+	input := make(chan error, 1)
+	go func() {
+		for {
+			args, err := stream.Recv()
+			if err != nil {
+				input <- err
+				return
+			}
+			if err := p.resize(args.Rows, args.Cols); err != nil {
+				s.logger.Warn().Msgf("could not resize terminal of pid %d: %v", pid, err)
+			}
+			if len(args.Input) > 0 {
+				if _, err := p.master.Write(args.Input); err != nil {
+					input <- err
+					return
+				}
+			}
+		}
+	}()
+
+	for {
+		select {
+		case data := <-out:
+			if err := stream.Send(&task.AttachResp{Output: data}); err != nil {
+				return err
+			}
+		case <-p.done:
+			// what the job wrote last may still be queued
+			for len(out) > 0 {
+				if err := stream.Send(&task.AttachResp{Output: <-out}); err != nil {
+					return err
+				}
+			}
+			return stream.Send(&task.AttachResp{Exited: true})
+		case err := <-input:
+			// the client detached
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestPtySession(t *testing.T) {
+	master, slave, err := newPty()
+	if err != nil {
+		t.Skipf("no ptys here: %v", err)
+	}
+
+	c := &Client{}
+	c.holdPty(42, master)
+	held, ok := c.ptys.Load(int32(42))
+	if !ok {
+		t.Fatal("expected the pty to be held")
+	}
+	p := held.(*ptySession)
+
+	if _, err := slave.Write([]byte("before attach\n")); err != nil {
+		t.Fatal(err)
+	}
+	// let the drain pick it up
+	deadline := time.Now().Add(2 * time.Second)
+	var backlog []byte
+	var out chan []byte
+	for time.Now().Before(deadline) {
+		p.mu.Lock()
+		n := len(p.backlog)
+		p.mu.Unlock()
+		if n > 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if backlog, out, err = p.attach(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(backlog, []byte("before attach")) {
+		t.Errorf("expected the backlog replayed, got %q", backlog)
+	}
+	if _, _, err := p.attach(); err == nil {
+		t.Error("expected a second client to be refused")
+	}
+
+	slave.Write([]byte("attached\n"))
+	select {
+	case data := <-out:
+		if !bytes.Contains(data, []byte("attached")) {
+			t.Errorf("unexpected output %q", data)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("no output forwarded")
+	}
+
+	// the job is gone once the last slave is
+	slave.Close()
+	select {
+	case <-p.done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("pty not released after the slave closed")
+	}
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(10 * time.Millisecond) {
+		if _, ok := c.ptys.Load(int32(42)); !ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected the pty to be forgotten")
+		}
+	}
+}
@@ -3,13 +3,17 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -18,9 +22,12 @@ import (
 	"github.com/cedana/cedana/container"
 	"github.com/cedana/cedana/utils"
 	"github.com/checkpoint-restore/go-criu/v6/rpc"
+	"github.com/containerd/console"
 	"github.com/containerd/containerd/identifiers"
 	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/typeurl/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
 	"github.com/shirou/gopsutil/v3/process"
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
@@ -31,86 +38,129 @@ import (
 	rspec "github.com/opencontainers/runtime-spec/specs-go"
 )
 
-func (c *Client) prepareRestore(ctx context.Context, opts *rpc.CriuOpts, checkpointPath string) (*string, *task.ProcessState, []*os.File, error) {
-	var isShellJob bool
-	var inheritFds []*rpc.InheritFd
-	var tcpEstablished bool
-	var extraFiles []*os.File
-
+// prepareRestore extracts the checkpoint as it is read into the working directory
+// dir of the restore, and sets the criu options to restore from it. Chunks of
+// deduplicated checkpoints missing locally are fetched from remote, if set. A
+// checkpoint downloaded with a checksum must have it, see unarchive.
+func (c *Client) prepareRestore(ctx context.Context, opts *rpc.CriuOpts, dir string, checkpoint io.Reader, checksum string, remote utils.ChunkSource, streams *stdio) (*task.ProcessState, []*os.File, error) {
 	_, prepareRestoreSpan := c.tracer.Start(ctx, "prepare_restore")
 	defer prepareRestoreSpan.End()
-	tmpdir := "/tmp/cedana_restore"
 
-	// check if tmpdir exists
-	if _, err := os.Stat(tmpdir); os.IsNotExist(err) {
-		err := os.Mkdir(tmpdir, 0755)
-		if err != nil {
-			return nil, nil, nil, err
-		}
+	c.logger.Info().Msgf("decompressing checkpoint to %s", dir)
+	err := c.unarchive(ctx, checkpoint, dir, remote, checksum)
+	if err != nil {
+		prepareRestoreSpan.RecordError(err)
+		return nil, nil, fmt.Errorf("error decompressing checkpoint: %w", err)
+	}
+	if err := c.verifyCheckpoint(ctx, dir); err != nil {
+		return nil, nil, err
+	}
+
+	return c.prepareRestoreDir(ctx, opts, dir, streams)
+}
+
+// startRestore creates the working directory of a new restore operation under the
+// restore root, named after its ID, so concurrent restores stay out of each
+// other's way. Failed restores older than the TTL get swept on the way.
+func (c *Client) startRestore() (id string, dir string, err error) {
+	root := c.config.Restore.Dir()
+	if ttl, err := c.config.Restore.TTL(); err != nil {
+		c.logger.Warn().Msgf("not sweeping failed restores: %v", err)
 	} else {
-		// likely an old checkpoint hanging around, delete
-		err := os.RemoveAll(tmpdir)
+		swept, err := utils.SweepRestoreDirs(root, ttl, func(name string) bool {
+			_, active := c.restoring.Load(name)
+			return active
+		})
 		if err != nil {
-			return nil, nil, nil, err
+			c.logger.Warn().Msgf("could not sweep failed restores in %s: %v", root, err)
 		}
-		err = os.Mkdir(tmpdir, 0755)
-		if err != nil {
-			return nil, nil, nil, err
+		for _, path := range swept {
+			c.logger.Info().Msgf("deleted failed restore %s, past its ttl", path)
 		}
 	}
 
-	c.logger.Info().Msgf("decompressing %s to %s", checkpointPath, tmpdir)
-	err := utils.UntarFolder(checkpointPath, tmpdir)
+	id = uuid.New().String()
+	dir = filepath.Join(root, id)
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return "", "", err
+	}
+	// the checkpoint is decrypted in there, it's root's alone
+	if err := os.Mkdir(dir, 0o700); err != nil {
+		return "", "", err
+	}
+	c.restoring.Store(id, struct{}{})
+	return id, dir, nil
+}
 
-	if err != nil {
-		c.logger.Fatal().Err(err).Msg("error decompressing checkpoint")
+// finishRestore deletes the working directory of a successful restore. A failed
+// one is kept with its logs until the TTL runs out, counted from now, unless what
+// it failed on is a corrupted download, which isn't worth keeping.
+func (c *Client) finishRestore(id, dir string, err error) error {
+	defer c.restoring.Delete(id)
+
+	if err == nil || errors.Is(err, utils.ErrCorruptDownload) {
+		if err := os.RemoveAll(dir); err != nil {
+			c.logger.Warn().Msgf("could not delete restore dir %s: %v", dir, err)
+		}
+		return err
 	}
 
+	now := time.Now()
+	os.Chtimes(dir, now, now)
+	c.logger.Warn().Msgf("restore %s failed, its files and logs are kept in %s", id, dir)
+	return fmt.Errorf("%w (restore files kept in %s)", err, dir)
+}
+
+// prepareRestoreDir sets the criu options to restore from an already decompressed
+// and verified checkpoint in dir, with the standard streams redirected to streams.
+func (c *Client) prepareRestoreDir(ctx context.Context, opts *rpc.CriuOpts, dir string, streams *stdio) (*task.ProcessState, []*os.File, error) {
+	var isShellJob bool
+	var inheritFds []*rpc.InheritFd
+	var tcpEstablished bool
+	var extraFiles []*os.File
+
 	// read serialized cedanaCheckpoint
-	_, err = os.Stat(filepath.Join(tmpdir, "checkpoint_state.json"))
+	_, err := os.Stat(filepath.Join(dir, "checkpoint_state.json"))
 	if err != nil {
 		c.logger.Fatal().Err(err).Msg("checkpoint_state.json not found, likely error in creating checkpoint")
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
-	data, err := os.ReadFile(filepath.Join(tmpdir, "checkpoint_state.json"))
+	data, err := os.ReadFile(filepath.Join(dir, "checkpoint_state.json"))
 	if err != nil {
 		c.logger.Fatal().Err(err).Msg("error reading checkpoint_state.json")
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
 	var checkpointState task.ProcessState
 	err = json.Unmarshal(data, &checkpointState)
 	if err != nil {
 		c.logger.Fatal().Err(err).Msg("error unmarshaling checkpoint_state.json")
-		return nil, nil, nil, err
-	}
-
-	open_fds := checkpointState.ProcessInfo.OpenFds
-
-	// create logfile for redirection
-	filename := fmt.Sprintf("/var/log/cedana-output-%s.log", fmt.Sprint(time.Now().Unix()))
-	file, err := os.Create(filename)
-	if err != nil {
-		c.logger.Fatal().Err(err).Msg("error creating logfile")
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
-	for _, f := range open_fds {
+	for _, f := range checkpointState.ProcessInfo.OpenFds {
 		if strings.Contains(f.Path, "pts") {
 			isShellJob = true
 		}
-		// if stdout or stderr, always redirect fds
-		if f.Stream == task.OpenFilesStat_STDOUT || f.Stream == task.OpenFilesStat_STDERR {
-			// strip leading slash from f
-			f.Path = strings.TrimPrefix(f.Path, "/")
+	}
 
-			extraFiles = append(extraFiles, file)
-			inheritFds = append(inheritFds, &rpc.InheritFd{
-				Fd:  proto.Int32(2 + int32(len(extraFiles))),
-				Key: proto.String(f.Path),
-			})
-		}
+	// the standard streams go wherever the caller asked, a log file by default
+	files, err := inheritFiles(&checkpointState, streams, filepath.Base(dir))
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		extraFiles = append(extraFiles, files[key])
+		inheritFds = append(inheritFds, &rpc.InheritFd{
+			Fd:  proto.Int32(2 + int32(len(extraFiles))),
+			Key: proto.String(key),
+		})
 	}
 
 	// TODO NR - wtf?
@@ -124,12 +174,76 @@ func (c *Client) prepareRestore(ctx context.Context, opts *rpc.CriuOpts, checkpo
 	opts.InheritFd = inheritFds
 	opts.TcpEstablished = proto.Bool(tcpEstablished)
 
-	if err := chmodRecursive(tmpdir, 0o777); err != nil {
+	// decrypted images stay readable by root alone
+	perm := os.FileMode(0o777)
+	if c.config.Encryption.Enabled() {
+		perm = 0o700
+	}
+	if err := chmodRecursive(dir, perm); err != nil {
+		closeFiles(files)
 		c.logger.Fatal().Err(err).Msg("error changing permissions")
-		return nil, nil, nil, err
+		return nil, nil, err
+	}
+
+	return &checkpointState, extraFiles, nil
+}
+
+// verifyCheckpoint checks the checkpoint in dir against its manifest, and the
+// manifest against its signature, before CRIU gets anywhere near it. Checkpoints
+// taken before manifests existed pass the checksums, but not an enforced signature.
+// Enforcing signatures also refuses files the manifest doesn't list, but for the
+// ones matching a pattern of unlisted.
+func (c *Client) verifyCheckpoint(ctx context.Context, dir string, unlisted ...string) error {
+	_, verifySpan := c.tracer.Start(ctx, "verify")
+	defer verifySpan.End()
+
+	strict := c.config.Signing.Policy == utils.SignaturePolicyEnforce
+	manifest, err := utils.VerifyManifest(dir, strict, unlisted...)
+	if errors.Is(err, os.ErrNotExist) {
+		c.logger.Warn().Msgf("checkpoint in %s has no manifest, skipping verification", dir)
+	} else if err != nil {
+		verifySpan.RecordError(err)
+		return err
+	} else {
+		verifySpan.SetAttributes(attribute.String("digest", manifest.Digest))
+		c.logger.Info().Msgf("verified %d files of checkpoint %s", len(manifest.Files), manifest.Digest)
+	}
+
+	if err := c.verifySignature(dir); err != nil {
+		verifySpan.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// verifySignature applies the signature policy to the checkpoint in dir: off skips
+// the check, warn only logs untrusted checkpoints and enforce refuses them.
+func (c *Client) verifySignature(dir string) error {
+	policy := c.config.Signing.Policy
+	if err := utils.ValidateSignaturePolicy(policy); err != nil {
+		return err
+	}
+	if policy == "" || policy == utils.SignaturePolicyOff {
+		return nil
+	}
+
+	trust, err := utils.LoadTrustStore(c.config.Signing.TrustStore)
+	if err != nil {
+		return err
+	}
+
+	keyID, err := trust.VerifySignature(dir)
+	if err != nil {
+		if policy == utils.SignaturePolicyWarn {
+			c.logger.Warn().Msgf("restoring untrusted checkpoint in %s: %v", dir, err)
+			return nil
+		}
+		return err
 	}
 
-	return &tmpdir, &checkpointState, extraFiles, nil
+	c.logger.Info().Msgf("checkpoint in %s signed by trusted key %s", dir, keyID)
+	return nil
 }
 
 // chmodRecursive changes the permissions of the given path and all its contents.
@@ -145,6 +259,11 @@ func chmodRecursive(path string, mode os.FileMode) error {
 func (c *Client) ContainerRestore(imgPath string, containerId string) error {
 	logger := utils.GetLogger()
 	logger.Info().Msgf("restoring container %s from %s", containerId, imgPath)
+	// image references that aren't a local checkpoint dir have nothing to verify,
+	// so only go through under a policy that doesn't enforce signatures
+	if err := c.verifyCheckpoint(context.Background(), imgPath); err != nil {
+		return err
+	}
 	err := container.Restore(imgPath, containerId)
 	if err != nil {
 		return err
@@ -152,40 +271,143 @@ func (c *Client) ContainerRestore(imgPath string, containerId string) error {
 	return nil
 }
 
-// restoreFiles looks at the files copied during checkpoint and copies them back to the
-// original path, creating folders along the way.
-func (c *Client) restoreFiles(ps *task.ProcessState, dir string) {
-	_, err := os.Stat(dir)
-	if err != nil {
-		return
+// restoreFiles puts the files captured into the checkpoint in dir back where
+// they were, creating folders along the way. CRIU reopens them at their offsets,
+// and refuses files whose size changed since the dump. Putting them back writes
+// anywhere on the host, so it takes a checkpoint signed by a trusted key or allow
+// from the caller. The files they replace are backed up into dir, to put back if
+// the restore fails.
+func (c *Client) restoreFiles(ps *task.ProcessState, dir string, allow bool) (*filesBackup, error) {
+	backup := &filesBackup{dir: filepath.Join(dir, capturedFilesBackupDir)}
+	if len(ps.CapturedFiles) == 0 {
+		return backup, nil
 	}
-	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
+	if !allow && !c.trustedCheckpoint(dir) {
+		return nil, fmt.Errorf("checkpoint has %d captured files but is not signed by a trusted key, restore with files allowed to put them back", len(ps.CapturedFiles))
+	}
+	for _, f := range ps.CapturedFiles {
+		if f.Name == "" || f.Name == "." || f.Name == ".." || f.Name != filepath.Base(f.Name) {
+			return nil, fmt.Errorf("invalid name %q of captured file %s", f.Name, f.Path)
 		}
-		for _, f := range ps.ProcessInfo.OpenFds {
-			if info.Name() == filepath.Base(f.Path) {
-				// copy file to path
-				err = os.MkdirAll(filepath.Dir(f.Path), 0755)
-				if err != nil {
-					return err
-				}
-
-				c.logger.Info().Msgf("copying file %s to %s", path, f)
-				// copyFile copies to folder, so grab folder path
-				err := utils.CopyFile(path, filepath.Dir(f.Path))
-				if err != nil {
-					return err
-				}
+		if !filepath.IsAbs(f.Path) || filepath.Clean(f.Path) != f.Path {
+			return nil, fmt.Errorf("captured file path %q is not absolute and clean", f.Path)
+		}
+	}
+	if err := os.MkdirAll(backup.dir, 0o700); err != nil {
+		return nil, err
+	}
 
-			}
+	for _, f := range ps.CapturedFiles {
+		if err := c.restoreFile(f, dir, backup); err != nil {
+			backup.rollback(c.logger)
+			return nil, err
 		}
-		return nil
-	})
+	}
+	return backup, nil
+}
+
+func (c *Client) restoreFile(f *task.CapturedFile, dir string, backup *filesBackup) error {
+	if err := os.MkdirAll(filepath.Dir(f.Path), 0o755); err != nil {
+		return err
+	}
+	if err := backup.save(f.Path); err != nil {
+		return fmt.Errorf("could not back up %s: %w", f.Path, err)
+	}
+
+	c.logger.Info().Msgf("restoring captured file %s (%d bytes)", f.Path, f.Size)
+	if err := utils.CopyFileTo(filepath.Join(dir, capturedFilesDir, f.Name), f.Path); err != nil {
+		return fmt.Errorf("could not restore captured file %s: %w", f.Path, err)
+	}
+	if err := os.Truncate(f.Path, f.Size); err != nil {
+		return err
+	}
+	if err := os.Chmod(f.Path, os.FileMode(f.Mode)); err != nil {
+		return err
+	}
+	if err := os.Chown(f.Path, int(f.UID), int(f.GID)); err != nil {
+		c.logger.Warn().Msgf("could not restore owner of %s: %v", f.Path, err)
+	}
+	if f.Offset > f.Size {
+		c.logger.Warn().Msgf("captured file %s is %d bytes, but was open at offset %d", f.Path, f.Size, f.Offset)
+	}
+	return nil
+}
 
+// trustedCheckpoint tells whether the checkpoint in dir is signed by a key of the
+// trust store, whatever the signature policy
+func (c *Client) trustedCheckpoint(dir string) bool {
+	trust, err := utils.LoadTrustStore(c.config.Signing.TrustStore)
 	if err != nil {
-		c.logger.Fatal().Err(err).Msg("error copying files")
+		return false
 	}
+	_, err = trust.VerifySignature(dir)
+	return err == nil
+}
+
+// filesBackup holds the files on the host replaced by captured ones
+type filesBackup struct {
+	dir   string
+	saved []savedFile
+}
+
+type savedFile struct {
+	path string
+	// copy of the original, empty if there was none
+	copy     string
+	mode     os.FileMode
+	uid, gid int
+}
+
+// save backs up the file at path before it gets replaced. Anything but a regular
+// file is refused, not to write through links.
+func (b *filesBackup) save(path string) error {
+	fi, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		b.saved = append(b.saved, savedFile{path: path})
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !fi.Mode().IsRegular() {
+		return fmt.Errorf("%s is not a regular file", path)
+	}
+
+	saved := savedFile{
+		path: path,
+		copy: filepath.Join(b.dir, strconv.Itoa(len(b.saved))),
+		mode: fi.Mode().Perm(),
+		uid:  -1,
+		gid:  -1,
+	}
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+		saved.uid, saved.gid = int(st.Uid), int(st.Gid)
+	}
+	if err := utils.CopyFileTo(path, saved.copy); err != nil {
+		return err
+	}
+	b.saved = append(b.saved, saved)
+	return nil
+}
+
+// rollback puts the backed up files back, and removes the ones that weren't there
+func (b *filesBackup) rollback(logger *zerolog.Logger) {
+	for i := len(b.saved) - 1; i >= 0; i-- {
+		saved := b.saved[i]
+		if saved.copy == "" {
+			if err := os.Remove(saved.path); err != nil && !os.IsNotExist(err) {
+				logger.Warn().Msgf("could not remove restored file %s: %v", saved.path, err)
+			}
+			continue
+		}
+		if err := utils.CopyFileTo(saved.copy, saved.path); err != nil {
+			logger.Warn().Msgf("could not put back %s: %v", saved.path, err)
+			continue
+		}
+		os.Chmod(saved.path, saved.mode)
+		os.Chown(saved.path, saved.uid, saved.gid)
+	}
+	b.saved = nil
 }
 
 func (c *Client) prepareRestoreOpts() *rpc.CriuOpts {
@@ -198,9 +420,13 @@ func (c *Client) prepareRestoreOpts() *rpc.CriuOpts {
 
 }
 
-func (c *Client) criuRestore(ctx context.Context, opts *rpc.CriuOpts, nfy Notify, dir string, extraFiles []*os.File) (*int32, error) {
+// criuRestore restores the process in dir, into a new pid namespace under a
+// cedana init if pidns is set. Returns the host pid of the restored process, and
+// of the init if there is one.
+func (c *Client) criuRestore(ctx context.Context, opts *rpc.CriuOpts, nfy Notify, dir string, extraFiles []*os.File, pidns bool) (*int32, int32, error) {
 	_, restoreSpan := c.tracer.Start(ctx, "restore")
 	restoreSpan.SetAttributes(attribute.Bool("container", false))
+	restoreSpan.SetAttributes(attribute.Bool("pidns", pidns))
 	defer restoreSpan.End()
 
 	img, err := os.Open(dir)
@@ -211,19 +437,45 @@ func (c *Client) criuRestore(ctx context.Context, opts *rpc.CriuOpts, nfy Notify
 
 	opts.ImagesDirFd = proto.Int32(int32(img.Fd()))
 
-	resp, err := c.CRIU.Restore(opts, &nfy, extraFiles)
+	var resp *rpc.CriuResp
+	var init *exec.Cmd
+	if pidns {
+		// criu runs under the init, so the process is restored inside the
+		// namespace and reparented to the init once criu exits
+		resp, err = c.CRIU.RestoreWrapped(opts, &nfy, extraFiles, func(cmd *exec.Cmd) error {
+			init = cmd
+			return inPidns(cmd)
+		})
+		if init != nil && init.Process != nil {
+			go init.Wait()
+		}
+	} else {
+		resp, err = c.CRIU.Restore(opts, &nfy, extraFiles)
+	}
 	if err != nil {
-		// cleanup along the way
-		os.RemoveAll(dir)
 		c.logger.Warn().Msgf("error restoring process: %v", err)
 		restoreSpan.RecordError(err)
-		return nil, err
+		if init != nil && init.Process != nil {
+			init.Process.Kill()
+		}
+		return nil, 0, err
 	}
 
 	c.logger.Info().Msgf("process restored: %v", resp)
 
 	c.cleanupClient()
-	return resp.Restore.Pid, nil
+	if init == nil {
+		return resp.Restore.Pid, 0, nil
+	}
+
+	// criu tells the pid in the namespace
+	initPid := int32(init.Process.Pid)
+	pid, err := nsHostPid(initPid, resp.GetRestore().GetPid())
+	if err != nil {
+		init.Process.Kill()
+		return nil, 0, err
+	}
+	return &pid, initPid, nil
 }
 
 func patchPodmanRestore(ctx context.Context, opts *container.RuncOpts, containerId, imgPath string) error {
@@ -320,11 +572,24 @@ type linkPairs struct {
 	Value string
 }
 
-func (c *Client) RuncRestore(ctx context.Context, imgPath, containerId string, isK3s bool, sources []string, opts *container.RuncOpts) error {
+// RuncRestore restores a runc container from imgPath. Given the page server of a
+// lazy dump, memory is faulted in from it by a lazy-pages daemon instead.
+func (c *Client) RuncRestore(ctx context.Context, imgPath, containerId string, isK3s bool, sources []string, opts *container.RuncOpts, lazy *container.CriuPageServerInfo) error {
+	return c.runcRestore(ctx, imgPath, containerId, isK3s, sources, opts, lazy, nil)
+}
+
+// runcRestore is RuncRestore, calling done once a successful restore is through
+// with imgPath. The lazy-pages daemon and the podman import keep using it after
+// the container is back.
+func (c *Client) runcRestore(ctx context.Context, imgPath, containerId string, isK3s bool, sources []string, opts *container.RuncOpts, lazy *container.CriuPageServerInfo, done func()) error {
 	ctx, restoreSpan := c.tracer.Start(ctx, "restore")
 	restoreSpan.SetAttributes(attribute.Bool("container", true))
 	defer restoreSpan.End()
 
+	if err := c.verifyCheckpoint(ctx, imgPath); err != nil {
+		return err
+	}
+
 	bundle := Bundle{Bundle: opts.Bundle}
 
 	isPodman := checkIfPodman(bundle)
@@ -431,21 +696,232 @@ func (c *Client) RuncRestore(ctx context.Context, imgPath, containerId string, i
 		}
 	}
 
+	// incremental checkpoints reference their pre-dumps through parent links,
+	// which CRIU follows on its own - make sure the whole chain made it here
+	if err := validateParentChain(imgPath); err != nil {
+		return err
+	}
+
+	var lazyPages *exec.Cmd
+	var lazyExited <-chan struct{}
+	opts.LazyPages = lazy != nil
+	if lazy != nil {
+		var err error
+		lazyPages, lazyExited, err = c.startLazyPagesDaemon(ctx, imgPath, *lazy)
+		if err != nil {
+			return err
+		}
+	}
+
 	err := container.RuncRestore(imgPath, containerId, *opts)
 	if err != nil {
+		if lazyPages != nil {
+			lazyPages.Process.Kill()
+		}
 		return err
 	}
 
+	var inUse sync.WaitGroup
+	inUse.Add(1)
 	go func() {
+		defer inUse.Done()
 		if isPodman {
 			if err := patchPodmanRestore(ctx, opts, containerId, imgPath); err != nil {
 				log.Fatal(err)
 			}
 		}
 	}()
+	if lazyExited != nil {
+		inUse.Add(1)
+		go func() {
+			defer inUse.Done()
+			<-lazyExited
+		}()
+	}
+	if done != nil {
+		go func() {
+			inUse.Wait()
+			done()
+		}()
+	}
 	return nil
 }
 
+// startLazyPagesDaemon runs CRIU's lazy-pages daemon on an images directory, fetching
+// pages from the dump's page server as the restored container faults on them. It
+// returns once the daemon is ready to accept a lazy restore on the same directory.
+// The daemon exits on its own after all pages have been transferred, closing the
+// returned channel.
+func (c *Client) startLazyPagesDaemon(ctx context.Context, imgPath string, ps container.CriuPageServerInfo) (*exec.Cmd, <-chan struct{}, error) {
+	_, lazySpan := c.tracer.Start(ctx, "lazy-pages")
+	defer lazySpan.End()
+
+	if ps.Address == "" || ps.Port == 0 {
+		return nil, nil, fmt.Errorf("lazy restore requires the page server address and port of the dump")
+	}
+
+	status, statusWrite, err := os.Pipe()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer status.Close()
+
+	cmd := exec.Command("criu", "lazy-pages",
+		"--page-server",
+		"--address", ps.Address,
+		"--port", fmt.Sprint(ps.Port),
+		"--images-dir", imgPath,
+		"--log-file", "lazy-pages.log",
+		"--status-fd", "3",
+	)
+	cmd.ExtraFiles = []*os.File{statusWrite}
+
+	err = cmd.Start()
+	statusWrite.Close()
+	if err != nil {
+		lazySpan.RecordError(err)
+		return nil, nil, err
+	}
+
+	// a \0 on the status fd means the daemon is listening for the restore,
+	// EOF means it died before getting there
+	buf := make([]byte, 1)
+	if _, err := status.Read(buf); err != nil {
+		cmd.Wait()
+		err = fmt.Errorf("lazy-pages daemon failed to start, see lazy-pages.log in %s", imgPath)
+		lazySpan.RecordError(err)
+		return nil, nil, err
+	}
+
+	c.logger.Info().Msgf("lazy-pages daemon fetching from %s:%d", ps.Address, ps.Port)
+
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		if err := cmd.Wait(); err != nil {
+			c.logger.Error().Msgf("lazy-pages daemon exited: %v", err)
+			return
+		}
+		c.logger.Info().Msgf("all pages for %s fetched", imgPath)
+	}()
+
+	return cmd, exited, nil
+}
+
+// validateParentChain walks the parent links CRIU leaves in an images directory
+// after an incremental dump, and errors out if any image in the chain is missing.
+func validateParentChain(imgPath string) error {
+	seen := map[string]bool{}
+	dir := filepath.Clean(imgPath)
+	for {
+		if seen[dir] {
+			return fmt.Errorf("parent image chain of %s loops back to %s", imgPath, dir)
+		}
+		seen[dir] = true
+
+		parentLink := filepath.Join(dir, "parent")
+		target, err := os.Readlink(parentLink)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("could not read parent link in %s: %w", dir, err)
+		}
+
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(dir, target)
+		}
+		target = filepath.Clean(target)
+		info, err := os.Stat(target)
+		if err != nil {
+			return fmt.Errorf("parent image %s of %s is missing: %w", target, dir, err)
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("parent image %s of %s is not a directory", target, dir)
+		}
+
+		dir = target
+	}
+}
+
+// restoreRuncArchive restores a runc container from an archived checkpoint, which
+// is extracted into the working directory of a new restore first, pre-dump images
+// and the links between them included. The decrypted images go with the directory
+// once the restore is through with them, a failed restore keeping them until its
+// TTL runs out. A checkpoint downloaded with a checksum must have it, see unarchive.
+func (c *Client) restoreRuncArchive(ctx context.Context, checkpoint io.Reader, checksum string, remote utils.ChunkSource, containerId string, isK3s bool, opts *container.RuncOpts, lazy *container.CriuPageServerInfo) error {
+	id, dir, err := c.startRestore()
+	if err != nil {
+		return err
+	}
+
+	_, extractSpan := c.tracer.Start(ctx, "extract")
+	c.logger.Info().Msgf("decompressing checkpoint to %s", dir)
+	err = c.unarchive(ctx, checkpoint, dir, remote, checksum)
+	if err != nil {
+		extractSpan.RecordError(err)
+	}
+	extractSpan.End()
+	if err != nil {
+		return c.finishRestore(id, dir, fmt.Errorf("failed to decompress checkpoint: %w", err))
+	}
+
+	err = c.runcRestore(ctx, dir, containerId, isK3s, []string{}, opts, lazy, func() {
+		c.finishRestore(id, dir, nil)
+	})
+	if err != nil {
+		return c.finishRestore(id, dir, err)
+	}
+	return nil
+}
+
+// unarchive extracts a checkpoint into dest as it's read. With checksum set, the
+// checkpoint read must have it, which is checked once it's extracted, before CRIU
+// gets anywhere near it.
+func (c *Client) unarchive(ctx context.Context, checkpoint io.Reader, dest string, remote utils.ChunkSource, checksum string) error {
+	if checksum == "" {
+		return c.extract(ctx, checkpoint, dest, remote)
+	}
+
+	download := utils.NewChecksumReader(checkpoint)
+	err := c.extract(ctx, download, dest, remote)
+	// a corrupted download is the likeliest reason for the extraction to fail too
+	if err := download.Verify(checksum); err != nil {
+		return err
+	}
+	return err
+}
+
+// extract extracts a checkpoint into dest, whether it's a tar, compressed and
+// encrypted or not, or the index of a deduplicated checkpoint to reassemble from
+// the chunk store.
+func (c *Client) extract(ctx context.Context, checkpoint io.Reader, dest string, remote utils.ChunkSource) error {
+	keys, err := utils.LoadKeyring(c.config.Encryption)
+	if err != nil {
+		return err
+	}
+
+	index, archive, err := utils.ReadChunkIndex(checkpoint)
+	if err != nil {
+		return err
+	}
+	if index == nil {
+		return utils.UnarchiveFromReader(archive, dest, keys)
+	}
+
+	chunks, err := c.chunkStore(keys)
+	if err != nil {
+		return err
+	}
+	if remote != nil {
+		if err := chunks.Fetch(ctx, index, remote); err != nil {
+			return err
+		}
+	}
+
+	return chunks.Reassemble(index, dest)
+}
+
 // Bundle represents an OCI bundle
 type OCIBundle struct {
 	// ID of the bundle
@@ -595,18 +1071,155 @@ func rsyncDirectories(source, destination string) error {
 	return nil
 }
 
-func (c *Client) Restore(ctx context.Context, args *task.RestoreArgs) (*int32, error) {
-	var dir *string
-	var pid *int32
+// downloadChecksum is the checksum the download of remote checkpoint cid is checked
+// against, the one given or else the one it was uploaded with from here. Empty
+// when there's none, for the download to be restored unverified.
+func (c *Client) downloadChecksum(cid, checksum string) string {
+	if checksum != "" {
+		return checksum
+	}
+	recorded, err := c.db.CheckpointChecksum(cid)
+	if err != nil {
+		c.logger.Warn().Msgf("could not look up checksum of checkpoint %s: %v", cid, err)
+	}
+	if recorded == "" {
+		c.logger.Warn().Msgf("no checksum for checkpoint %s, restoring it unverified", cid)
+	}
+	return recorded
+}
+
+// Restore restores a checkpoint, returning the pid it came back as along with the
+// roots of the other process trees of its session, if it was dumped with some.
+// The standard streams of the process go to streams, if given, which is left
+// with where they actually went.
+func (c *Client) Restore(ctx context.Context, args *task.RestoreArgs, streams *stdio) (*int32, []int32, error) {
+	var checkpoint io.ReadCloser
+	var checksum string
+	var remote utils.ChunkSource
+	var err error
+
+	switch args.Type {
+	case task.RestoreArgs_REMOTE:
+		// extract the download as it comes in, checked once it's all in
+		store := utils.NewCedanaStore(c.config, c.tracer)
+		remote = store
+		checkpoint, err = store.GetCheckpointStream(ctx, args.CheckpointId)
+		if err == nil {
+			checksum = c.downloadChecksum(args.CheckpointId, args.Checksum)
+		}
+	default:
+		checkpoint, err = os.Open(args.CheckpointPath)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer checkpoint.Close()
+
+	profile, err := c.criuProfile(args.CriuOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id, dir, err := c.startRestore()
+	if err != nil {
+		return nil, nil, err
+	}
+	c.logger.Info().Msgf("restore %s working in %s", id, dir)
 
 	opts := c.prepareRestoreOpts()
-	nfy := Notify{
-		Logger: c.logger,
+	if streams == nil {
+		streams = &stdio{}
+	}
+	state, extraFiles, err := c.prepareRestore(ctx, opts, dir, checkpoint, checksum, remote, streams)
+	if err != nil {
+		return nil, nil, c.finishRestore(id, dir, err)
+	}
+	// the cgroup is named after the job, or after the restore without one
+	cgroupName := args.JobID
+	if cgroupName == "" {
+		cgroupName = id
+	}
+	cgroup, err := c.restoreCgroup(opts, state, cgroupName, args.Limits)
+	if err != nil {
+		closeExtraFiles(extraFiles)
+		return nil, nil, c.finishRestore(id, dir, err)
+	}
+	// gone with the job, or right away if the restore fails
+	defer c.reapCgroup(cgroup)
+	if profile != nil {
+		profile.Apply(opts)
+	}
+
+	// the job's terminal is a new pty, the daemon keeps the master for attaching
+	var master console.Console
+	if args.Pty {
+		if state.TtyKey == "" {
+			closeExtraFiles(extraFiles)
+			return nil, nil, c.finishRestore(id, dir, fmt.Errorf("checkpoint has no terminal to restore onto a pty"))
+		}
+		var slave *os.File
+		if master, slave, err = newPty(); err != nil {
+			closeExtraFiles(extraFiles)
+			return nil, nil, c.finishRestore(id, dir, err)
+		}
+		extraFiles = append(extraFiles, slave)
+		opts.ShellJob = proto.Bool(true)
+		opts.InheritFd = append(opts.InheritFd, &rpc.InheritFd{
+			Fd:  proto.Int32(2 + int32(len(extraFiles))),
+			Key: proto.String(state.TtyKey),
+		})
 	}
 
-	dir, state, extraFiles, err := c.prepareRestore(ctx, opts, args.CheckpointPath)
+	// checkpoints of jobs in a pid namespace bring theirs along
+	pidns := args.PidNamespace && state.PidNamespace == nil
+	if pidns {
+		if _, err := os.Stat(filepath.Join(dir, treesDir)); err == nil {
+			closeExtraFiles(extraFiles)
+			if master != nil {
+				master.Close()
+			}
+			return nil, nil, c.finishRestore(id, dir, fmt.Errorf("jobs of more than one process tree cannot be restored into a pid namespace"))
+		}
+	}
+
+	backup, err := c.restoreFiles(state, dir, args.RestoreFiles)
 	if err != nil {
-		return nil, err
+		closeExtraFiles(extraFiles)
+		if master != nil {
+			master.Close()
+		}
+		return nil, nil, c.finishRestore(id, dir, err)
+	}
+
+	pid, trees, err := c.restore(ctx, opts, dir, state, extraFiles, pidns)
+	if err != nil {
+		backup.rollback(c.logger)
+		if master != nil {
+			master.Close()
+		}
+		return nil, nil, c.finishRestore(id, dir, err)
+	}
+	if master != nil {
+		c.holdPty(*pid, master)
+	}
+	c.finishRestore(id, dir, nil)
+	return pid, trees, nil
+}
+
+func closeExtraFiles(files []*os.File) {
+	for _, f := range files {
+		f.Close()
+	}
+}
+
+// restore runs the criu restore of a prepared checkpoint directory, bringing
+// up the gpu controller alongside if the checkpoint has gpu state. The other
+// trees of a session job are restored after the leader's, and a job stopped
+// for its dump gets continued once all of them are back. Restored into a new pid namespace, the
+// job's pid is the one of the namespace's init.
+func (c *Client) restore(ctx context.Context, opts *rpc.CriuOpts, dir string, state *task.ProcessState, extraFiles []*os.File, pidns bool) (*int32, []int32, error) {
+	nfy := Notify{
+		Logger: c.logger,
 	}
 
 	var gpuCmd *exec.Cmd
@@ -615,15 +1228,47 @@ func (c *Client) Restore(ctx context.Context, args *task.RestoreArgs) (*int32, e
 			Avail: true,
 			Callback: func() error {
 				var err error
-				gpuCmd, err = c.gpuRestore(ctx, *dir)
+				gpuCmd, err = c.gpuRestore(ctx, dir)
 				return err
 			},
 		}
 	}
 
-	pid, err = c.criuRestore(ctx, opts, nfy, *dir, extraFiles)
+	// the restored process has its own copies of the stdio files by now
+	defer closeExtraFiles(extraFiles)
+
+	pid, init, err := c.criuRestore(ctx, opts, nfy, dir, extraFiles, pidns)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	trees, err := c.restoreTrees(ctx, dir, opts)
+	if err != nil {
+		// a session is restored whole or not at all
+		for _, root := range append([]int32{*pid}, trees...) {
+			if err := killTree(root); err != nil {
+				c.logger.Warn().Msgf("could not kill restored tree of pid %d: %v", root, err)
+			}
+		}
+		return nil, nil, err
+	}
+
+	// the network locked since the dump opens up again, from inside the restored
+	// network namespace if the job has one
+	if lock := c.networkLock(state, *pid); lock != nil {
+		if err := lock.Unlock(); err != nil {
+			c.logger.Warn().Msgf("could not unlock network of pid %d: %v", *pid, err)
+		}
+	}
+
+	if state.Stopped {
+		members, err := treeMembers(append([]int32{*pid}, trees...))
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := signalMembers(members, syscall.SIGCONT); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	if state.GPUCheckpointed {
@@ -645,7 +1290,10 @@ func (c *Client) Restore(ctx context.Context, args *task.RestoreArgs) (*int32, e
 		}()
 	}
 
-	return pid, nil
+	if init != 0 {
+		return &init, trees, nil
+	}
+	return pid, trees, nil
 }
 
 func (c *Client) gpuRestore(ctx context.Context, dir string) (*exec.Cmd, error) {
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/cedana/cedana/utils"
+)
+
+func TestRestoreFiles(t *testing.T) {
+	logger := utils.GetLogger()
+	c := &Client{config: &utils.Config{}, logger: &logger}
+
+	dir := t.TempDir()
+	host := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, capturedFilesDir), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, capturedFilesDir, "0"), []byte("captured"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	existing := filepath.Join(host, "existing")
+	if err := os.WriteFile(existing, []byte("original"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	created := filepath.Join(host, "new", "created")
+
+	file := func(name, path string) *task.CapturedFile {
+		return &task.CapturedFile{Name: name, Path: path, Size: 8, Mode: 0o644, UID: uint32(os.Getuid()), GID: uint32(os.Getgid())}
+	}
+	state := &task.ProcessState{CapturedFiles: []*task.CapturedFile{file("0", existing), file("0", created)}}
+
+	if _, err := c.restoreFiles(state, dir, false); err == nil {
+		t.Error("expected captured files of an unsigned checkpoint to be refused")
+	}
+	for _, bad := range []*task.CapturedFile{file("../0", existing), file("0", "relative"), file("0", host+"/../etc")} {
+		if _, err := c.restoreFiles(&task.ProcessState{CapturedFiles: []*task.CapturedFile{bad}}, dir, true); err == nil {
+			t.Errorf("expected %s -> %s to be refused", bad.Name, bad.Path)
+		}
+	}
+
+	backup, err := c.restoreFiles(state, dir, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{existing, created} {
+		if data, _ := os.ReadFile(path); string(data) != "captured" {
+			t.Errorf("expected %s restored, got %q", path, data)
+		}
+	}
+
+	// the restore failed
+	backup.rollback(c.logger)
+	if data, _ := os.ReadFile(existing); string(data) != "original" {
+		t.Errorf("expected the original put back, got %q", data)
+	}
+	if fi, err := os.Stat(existing); err != nil || fi.Mode().Perm() != 0o600 {
+		t.Errorf("expected the original mode put back, got %v", fi.Mode())
+	}
+	if _, err := os.Stat(created); !os.IsNotExist(err) {
+		t.Errorf("expected the new file removed, got %v", err)
+	}
+}
+
+// Extracts a download that doesn't have its checksum, which should be refused
+// as corrupted and take its restore dir with it, while a good one extracts.
+func TestUnarchiveChecksum(t *testing.T) {
+	logger := utils.GetLogger()
+	root := t.TempDir()
+	c := &Client{config: &utils.Config{Restore: utils.Restore{Root: root}}, logger: &logger}
+
+	src := t.TempDir()
+	if err := os.WriteFile(filepath.Join(src, "pages-1.img"), bytes.Repeat([]byte("checkpoint"), 1<<10), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var archive bytes.Buffer
+	if err := utils.ArchiveFolderToWriter(src, &archive, utils.Compression{Algorithm: utils.CompressionGzip}, nil); err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(archive.Bytes())
+	checksum := hex.EncodeToString(sum[:])
+
+	id, dir, err := c.startRestore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.unarchive(context.Background(), bytes.NewReader(archive.Bytes()), dir, nil, checksum); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "pages-1.img")); err != nil {
+		t.Error(err)
+	}
+	c.finishRestore(id, dir, nil)
+
+	id, dir, err = c.startRestore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.unarchive(context.Background(), bytes.NewReader(archive.Bytes()), dir, nil, strings.Repeat("0", len(checksum)))
+	if !errors.Is(err, utils.ErrCorruptDownload) {
+		t.Fatalf("expected a corrupted download, got %v", err)
+	}
+	if err := c.finishRestore(id, dir, err); !errors.Is(err, utils.ErrChecksumMismatch) {
+		t.Errorf("expected the restore to fail on the checksum, got %v", err)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("expected the restore dir of a corrupted download to go, got %v", err)
+	}
+}
@@ -0,0 +1,207 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/cedana/cedana/utils"
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// scheduler takes the periodic checkpoints of jobs with a checkpoint policy. Policies
+// live in the db, so schedules pick back up when the daemon restarts.
+type scheduler struct {
+	s      *service
+	logger *zerolog.Logger
+	cron   *cron.Cron
+
+	mu      sync.Mutex
+	entries map[string]cron.EntryID
+}
+
+func newScheduler(s *service) *scheduler {
+	return &scheduler{
+		s:       s,
+		logger:  s.logger,
+		cron:    cron.New(),
+		entries: map[string]cron.EntryID{},
+	}
+}
+
+// start schedules the policies stored in the db along with garbage collection
+// and job metrics, and starts running them
+func (sc *scheduler) start() error {
+	policies, err := sc.s.client.db.GetCheckpointPolicies()
+	if err != nil {
+		return err
+	}
+
+	for jobID, policy := range policies {
+		if err := sc.schedule(jobID, policy); err != nil {
+			sc.logger.Warn().Msgf("could not schedule checkpoints of job %s: %v", jobID, err)
+		}
+	}
+
+	if err := sc.scheduleGC(sc.s.client.config.Retention, sc.s.client.config.SharedStorage.Dedup); err != nil {
+		sc.logger.Warn().Msgf("could not schedule garbage collection: %v", err)
+	}
+	if err := sc.scheduleMetrics(sc.s.client.config.Metrics); err != nil {
+		sc.logger.Warn().Msgf("could not schedule job metrics: %v", err)
+	}
+
+	sc.cron.Start()
+	return nil
+}
+
+// set stores the policy of a job and (re)schedules its checkpoints. A nil policy
+// stops them.
+func (sc *scheduler) set(jobID string, policy *task.CheckpointPolicy) error {
+	if policy != nil {
+		if _, err := parseSchedule(policy); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if err := sc.s.client.db.SetCheckpointPolicy(jobID, policy); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if policy == nil {
+		sc.remove(jobID)
+		return nil
+	}
+
+	if err := sc.schedule(jobID, policy); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func (sc *scheduler) schedule(jobID string, policy *task.CheckpointPolicy) error {
+	schedule, err := parseSchedule(policy)
+	if err != nil {
+		return err
+	}
+
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	if id, ok := sc.entries[jobID]; ok {
+		sc.cron.Remove(id)
+	}
+
+	// a checkpoint still running when the next one is due skips that one
+	job := cron.NewChain(cron.SkipIfStillRunning(cron.DiscardLogger)).Then(cron.FuncJob(func() {
+		sc.checkpoint(jobID, policy)
+	}))
+	sc.entries[jobID] = sc.cron.Schedule(schedule, job)
+
+	sc.logger.Info().Msgf("scheduled checkpoints of job %s", jobID)
+	return nil
+}
+
+func (sc *scheduler) remove(jobID string) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	if id, ok := sc.entries[jobID]; ok {
+		sc.cron.Remove(id)
+		delete(sc.entries, jobID)
+		sc.logger.Info().Msgf("stopped scheduled checkpoints of job %s", jobID)
+	}
+}
+
+// checkpoint takes a scheduled checkpoint of a job, then prunes the ones over
+// the policy's maximum. The job is left running whatever the config says, it's
+// only being checkpointed in case it goes down.
+func (sc *scheduler) checkpoint(jobID string, policy *task.CheckpointPolicy) {
+	state, err := sc.s.client.db.GetStateFromID(jobID)
+	if err != nil {
+		sc.logger.Warn().Msgf("skipping scheduled checkpoint of job %s: %v", jobID, err)
+		return
+	}
+	if state.Flag != task.FlagEnum_JOB_RUNNING {
+		sc.logger.Info().Msgf("skipping scheduled checkpoint of job %s, not running", jobID)
+		return
+	}
+
+	dir := policy.Dir
+	if dir == "" {
+		dir = sc.s.client.config.SharedStorage.DumpStorageDir
+	}
+
+	_, err = sc.s.Dump(context.Background(), &task.DumpArgs{
+		PID:      state.PID,
+		Dir:      dir,
+		JobID:    jobID,
+		Type:     policy.Type,
+		Reason:   &task.CheckpointReason{Reason: task.CheckpointReason_HEARTBEAT},
+		CriuOpts: &task.CriuProcessOpts{LeaveRunning: proto.Bool(true)},
+	})
+	if err != nil {
+		sc.logger.Warn().Msgf("scheduled checkpoint of job %s failed: %v", jobID, err)
+		return
+	}
+
+	sc.logger.Info().Msgf("took scheduled checkpoint of job %s in %s", jobID, dir)
+
+	if policy.MaxKeep > 0 {
+		sc.prune(jobID, int(policy.MaxKeep))
+	}
+}
+
+// prune deletes the oldest scheduled checkpoints of a job, keeping maxKeep
+func (sc *scheduler) prune(jobID string, maxKeep int) {
+	scheduled := func(record *CheckpointRecord) bool {
+		return record.Reason.GetReason() == task.CheckpointReason_HEARTBEAT
+	}
+
+	// the chunks they leave unreferenced go with the next chunk sweep
+	_, err := sc.s.collect(context.Background(), jobID, utils.Retention{KeepLast: maxKeep}, scheduled, false, false)
+	if err != nil {
+		sc.logger.Warn().Msgf("could not prune checkpoints of job %s: %v", jobID, err)
+	}
+}
+
+func parseSchedule(policy *task.CheckpointPolicy) (cron.Schedule, error) {
+	switch {
+	case policy.IntervalSeconds > 0 && policy.Cron != "":
+		return nil, fmt.Errorf("checkpoint policy takes either an interval or a cron expression, not both")
+	case policy.IntervalSeconds > 0:
+		return cron.Every(time.Duration(policy.IntervalSeconds) * time.Second), nil
+	case policy.Cron != "":
+		schedule, err := cron.ParseStandard(policy.Cron)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %v", policy.Cron, err)
+		}
+		return schedule, nil
+	default:
+		return nil, fmt.Errorf("checkpoint policy needs an interval or a cron expression")
+	}
+}
+
+func (s *service) SetCheckpointPolicy(ctx context.Context, args *task.SetCheckpointPolicyArgs) (*task.SetCheckpointPolicyResp, error) {
+	if args.JobID == "" {
+		return nil, status.Error(codes.InvalidArgument, "job id cannot be empty")
+	}
+
+	if _, err := s.client.db.GetPID(args.JobID); err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("job %s not found: %v", args.JobID, err))
+	}
+
+	if err := s.scheduler.set(args.JobID, args.Policy); err != nil {
+		return nil, err
+	}
+
+	if args.Policy == nil {
+		return &task.SetCheckpointPolicyResp{Message: fmt.Sprintf("Stopped scheduled checkpoints of job %s", args.JobID)}, nil
+	}
+	return &task.SetCheckpointPolicyResp{Message: fmt.Sprintf("Scheduled checkpoints of job %s", args.JobID)}, nil
+}
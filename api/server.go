@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -55,6 +56,12 @@ type service struct {
 	ClientStateStream task.TaskService_ClientStateStreamingServer
 	r                 *os.File
 	w                 *os.File
+	// migrations prepared on this daemon as the target, by id
+	migrations   map[string]*migration
+	migrationsMu sync.Mutex
+	scheduler    *scheduler
+	// one garbage collection at a time
+	gcMu sync.Mutex
 	task.UnimplementedTaskServiceServer
 }
 
@@ -62,10 +69,20 @@ func (s *service) Dump(ctx context.Context, args *task.DumpArgs) (*task.DumpResp
 	ctx, dumpTracer := s.client.tracer.Start(ctx, "dump-ckpt")
 	dumpTracer.SetAttributes(attribute.String("jobID", args.JobID))
 	defer dumpTracer.End()
-	s.client.jobID = args.JobID
 
-	s.r.Close()
-	s.w.Close()
+	if err := utils.ValidateCompression(args.Compression); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := s.client.criuProfile(args.CriuOpts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// a dump turned away can't touch the state of the one running
+	release, err := s.client.reserveDump(args.JobID)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	defer release()
 
 	cfg, err := utils.InitConfig()
 	if err != nil {
@@ -83,13 +100,24 @@ func (s *service) Dump(ctx context.Context, args *task.DumpArgs) (*task.DumpResp
 	state.Flag = task.FlagEnum_JOB_RUNNING
 	state.PID = pid
 
+	if args.JobID != "" {
+		if prev, err := s.client.db.GetStateFromID(args.JobID); err == nil && prev != nil {
+			// jobs are dumped with the scope they were started with, unless asked otherwise
+			if args.Scope == task.JobScope_UNSPECIFIED {
+				args.Scope = prev.Scope
+			}
+			state.Trees = prev.Trees
+		}
+	}
+	state.Scope = args.Scope
+
 	err = s.client.db.CreateOrUpdateCedanaProcess(args.JobID, &state)
 	if err != nil {
 		err = status.Error(codes.Internal, err.Error())
 		return nil, err
 	}
 
-	err = s.client.Dump(ctx, args.Dir, args.PID)
+	err = s.client.dump(ctx, args, nil, false)
 	if err != nil {
 		st := status.New(codes.Internal, err.Error())
 		dumpTracer.RecordError(st.Err())
@@ -119,66 +147,47 @@ func (s *service) Dump(ctx context.Context, args *task.DumpArgs) (*task.DumpResp
 			return nil, st.Err()
 		}
 
-		checkpointPath := state.CheckpointPath
-
-		file, err := os.Open(checkpointPath)
-		if err != nil {
-			st := status.New(codes.NotFound, "checkpoint zip not found")
-			st.WithDetails(&errdetails.ErrorInfo{
-				Reason: err.Error(),
-			})
-			return nil, st.Err()
-		}
-		defer file.Close()
-
-		fileInfo, err := file.Stat()
-		if err != nil {
-			st := status.New(codes.Internal, "checkpoint zip stat failed")
-			st.WithDetails(&errdetails.ErrorInfo{
-				Reason: err.Error(),
-			})
-			return nil, st.Err()
-		}
-
-		// Get the size
-		size := fileInfo.Size()
-
-		// zipFileSize += 4096
-		checkpointFullSize := int64(size)
-
+		// stream the checkpoint straight from its directory into the upload
 		ctx, uploadSpan := s.client.tracer.Start(ctx, "upload-ckpt")
-		multipartCheckpointResp, cid, err := store.CreateMultiPartUpload(ctx, checkpointFullSize)
+		multipartCheckpointResp, meta, err := s.client.uploadCheckpoint(ctx, store, state.CheckpointPath, s.client.compression(args.Compression, args.CompressionLevel))
 		if err != nil {
-			st := status.New(codes.Internal, fmt.Sprintf("CreateMultiPartUpload failed with error: %s", err.Error()))
+			st := status.New(codes.Internal, err.Error())
 			uploadSpan.RecordError(st.Err())
+			uploadSpan.End()
 			return nil, st.Err()
 		}
+		uploadSpan.End()
 
-		err = store.StartMultiPartUpload(ctx, cid, multipartCheckpointResp, checkpointPath)
-		if err != nil {
-			st := status.New(codes.Internal, fmt.Sprintf("StartMultiPartUpload failed with error: %s", err.Error()))
-			uploadSpan.RecordError(st.Err())
-			return nil, st.Err()
+		// the job comes back on whichever host downloads the checkpoint, the
+		// lock kept here since the dump has nothing left to hold
+		if lock := s.client.networkLock(state, state.PID); lock != nil {
+			if err := lock.Unlock(); err != nil {
+				s.logger.Warn().Msgf("could not unlock network of pid %d: %v", state.PID, err)
+			}
 		}
 
-		err = store.CompleteMultiPartUpload(ctx, *multipartCheckpointResp, cid)
-		if err != nil {
-			st := status.New(codes.Internal, fmt.Sprintf("CompleteMultiPartUpload failed with error: %s", err.Error()))
-			uploadSpan.RecordError(st.Err())
-			return nil, st.Err()
+		// encrypted checkpoints are only kept sealed, up in the store
+		if s.client.config.Encryption.Enabled() {
+			if err := os.RemoveAll(state.CheckpointPath); err != nil {
+				s.logger.Warn().Msgf("could not remove unencrypted checkpoint %s: %v", state.CheckpointPath, err)
+			}
 		}
-		uploadSpan.End()
 
-		remoteState := &task.RemoteState{CheckpointID: cid, UploadID: multipartCheckpointResp.UploadID, Timestamp: time.Now().Unix()}
+		remoteState := &task.RemoteState{CheckpointID: meta.ID, UploadID: multipartCheckpointResp.UploadID, Timestamp: time.Now().Unix(), Checksum: meta.Checksum}
 
 		state.RemoteState = append(state.RemoteState, remoteState)
 
 		s.client.db.UpdateProcessStateWithID(args.JobID, state)
 
+		if err := s.client.db.SetCheckpointRecordID(args.JobID, state.CheckpointPath, meta.ID, meta.Checksum); err != nil {
+			s.logger.Warn().Msgf("could not record remote checkpoint %s: %v", meta.ID, err)
+		}
+
 		resp = task.DumpResp{
-			Message:      fmt.Sprintf("Dumped process %d to %s, multipart checkpoint id: %s", args.PID, args.Dir, multipartCheckpointResp.UploadID),
-			CheckpointID: cid,
+			Message:      fmt.Sprintf("Dumped process %d to %s, multipart checkpoint id: %s, sha256: %s", args.PID, args.Dir, multipartCheckpointResp.UploadID, meta.Checksum),
+			CheckpointID: meta.ID,
 			UploadID:     multipartCheckpointResp.UploadID,
+			Checksum:     meta.Checksum,
 		}
 	}
 
@@ -191,15 +200,26 @@ func (s *service) Restore(ctx context.Context, args *task.RestoreArgs) (*task.Re
 	defer restoreTracer.End()
 	var resp task.RestoreResp
 
+	if _, err := s.client.criuProfile(args.CriuOpts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	streams := &stdio{Stdin: args.Stdin, Stdout: args.Stdout, Stderr: args.Stderr}
+	if err := streams.validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validateLimits(args.Limits); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	switch args.Type {
 	case task.RestoreArgs_LOCAL:
 		if args.CheckpointPath == "" {
 			return nil, status.Error(codes.InvalidArgument, "checkpoint path cannot be empty")
 		}
 		// assume a suitable file has been passed to args
-		pid, err := s.client.Restore(ctx, args)
+		pid, trees, err := s.client.Restore(ctx, args, streams)
 		if err != nil {
-			staterr := status.Error(codes.Internal, fmt.Sprintf("failed to restore process: %v", err))
+			staterr := status.Error(restoreErrorCode(err), fmt.Sprintf("failed to restore process: %v", err))
 			restoreTracer.RecordError(staterr)
 			return nil, staterr
 		}
@@ -207,6 +227,7 @@ func (s *service) Restore(ctx context.Context, args *task.RestoreArgs) (*task.Re
 		resp = task.RestoreResp{
 			Message: fmt.Sprintf("Successfully restored process: %v", *pid),
 			NewPID:  *pid,
+			Members: s.restoredJob(args.JobID, *pid, trees),
 		}
 
 	case task.RestoreArgs_REMOTE:
@@ -214,27 +235,20 @@ func (s *service) Restore(ctx context.Context, args *task.RestoreArgs) (*task.Re
 			return nil, status.Error(codes.InvalidArgument, "checkpoint id cannot be empty")
 		}
 
-		cfg, err := utils.InitConfig()
+		// the checkpoint is streamed from the store into the restore dir
+		pid, trees, err := s.client.Restore(ctx, &task.RestoreArgs{
+			Type:         task.RestoreArgs_REMOTE,
+			CheckpointId: args.CheckpointId,
+			CriuOpts:     args.CriuOpts,
+			Pty:          args.Pty,
+			PidNamespace: args.PidNamespace,
+			JobID:        args.JobID,
+			Limits:       args.Limits,
+			RestoreFiles: args.RestoreFiles,
+			Checksum:     args.Checksum,
+		}, streams)
 		if err != nil {
-			err = status.Error(codes.Internal, err.Error())
-			return nil, err
-		}
-
-		store := utils.NewCedanaStore(cfg, s.client.tracer)
-
-		zipFile, err := store.GetCheckpoint(ctx, args.CheckpointId)
-		if err != nil {
-			return nil, err
-		}
-
-		pid, err := s.client.Restore(ctx, &task.RestoreArgs{
-			Type:           task.RestoreArgs_REMOTE,
-			CheckpointId:   args.CheckpointId,
-			CheckpointPath: *zipFile,
-		})
-
-		if err != nil {
-			staterr := status.Error(codes.Internal, fmt.Sprintf("failed to restore process: %v", err))
+			staterr := status.Error(restoreErrorCode(err), fmt.Sprintf("failed to restore process: %v", err))
 			restoreTracer.RecordError(staterr)
 			return nil, staterr
 		}
@@ -242,9 +256,11 @@ func (s *service) Restore(ctx context.Context, args *task.RestoreArgs) (*task.Re
 		resp = task.RestoreResp{
 			Message: fmt.Sprintf("Successfully restored process: %v", *pid),
 			NewPID:  *pid,
+			Members: s.restoredJob(args.JobID, *pid, trees),
 		}
 	}
 
+	resp.Stdin, resp.Stdout, resp.Stderr = streams.Stdin, streams.Stdout, streams.Stderr
 	return &resp, nil
 }
 
@@ -259,6 +275,9 @@ func (s *service) ContainerDump(ctx context.Context, args *task.ContainerDumpArg
 
 func (s *service) ContainerRestore(ctx context.Context, args *task.ContainerRestoreArgs) (*task.ContainerRestoreResp, error) {
 	err := s.client.ContainerRestore(args.ImgPath, args.ContainerId)
+	if code := restoreErrorCode(err); code != codes.Internal {
+		return nil, status.Error(code, err.Error())
+	}
 	if err != nil {
 		err = status.Error(codes.InvalidArgument, "arguments are invalid, container not found")
 		return nil, err
@@ -269,6 +288,47 @@ func (s *service) ContainerRestore(ctx context.Context, args *task.ContainerRest
 func (s *service) RuncDump(ctx context.Context, args *task.RuncDumpArgs) (*task.RuncDumpResp, error) {
 	var uploadID string
 	var checkpointId string
+	var checksum string
+
+	criuOpts := &container.CriuOpts{
+		ImagesDirectory: args.CriuOpts.ImagesDirectory,
+		WorkDirectory:   args.CriuOpts.WorkDirectory,
+		ParentImage:     args.CriuOpts.ParentImage,
+		LeaveRunning:    true,
+		TcpEstablished:  args.CriuOpts.TcpEstablished,
+		AutoDedup:       args.CriuOpts.AutoDedup,
+	}
+
+	if args.Lazy {
+		if args.PageServerPort == 0 {
+			return nil, status.Error(codes.InvalidArgument, "lazy dump requires a page server port")
+		}
+		if args.CriuOpts.PreDump {
+			return nil, status.Error(codes.InvalidArgument, "lazy dump cannot be a pre-dump")
+		}
+		// the container moves over to wherever its pages get faulted in
+		criuOpts.LeaveRunning = false
+		criuOpts.LazyPages = true
+		criuOpts.PageServer = container.CriuPageServerInfo{
+			Address: args.PageServerAddress,
+			Port:    args.PageServerPort,
+		}
+	}
+
+	// pre-dumps only extend the container's parent image chain, the checkpoint
+	// is produced (and uploaded) by the final dump
+	if args.CriuOpts.PreDump {
+		preDumpDir, err := s.client.RuncPreDump(ctx, args.Root, args.ContainerId, criuOpts)
+		if err != nil {
+			st := status.New(codes.Internal, "Runc pre-dump failed")
+			st.WithDetails(&errdetails.ErrorInfo{
+				Reason: err.Error(),
+			})
+			return nil, st.Err()
+		}
+		return &task.RuncDumpResp{Message: fmt.Sprintf("Pre-dumped container %s to %s", args.ContainerId, preDumpDir)}, nil
+	}
+
 	//TODO BS: This will be done at controller level, just doing it here for now...
 	jobId := uuid.New().String()
 	pid, err := runc.GetPidByContainerId(args.ContainerId, args.Root)
@@ -288,14 +348,6 @@ func (s *service) RuncDump(ctx context.Context, args *task.RuncDumpArgs) (*task.
 		return nil, err
 	}
 
-	s.client.jobID = jobId
-
-	criuOpts := &container.CriuOpts{
-		ImagesDirectory: args.CriuOpts.ImagesDirectory,
-		WorkDirectory:   args.CriuOpts.WorkDirectory,
-		LeaveRunning:    true,
-		TcpEstablished:  args.CriuOpts.TcpEstablished,
-	}
 	cfg, err := utils.InitConfig()
 	if err != nil {
 		err = status.Error(codes.Internal, err.Error())
@@ -303,7 +355,7 @@ func (s *service) RuncDump(ctx context.Context, args *task.RuncDumpArgs) (*task.
 	}
 	store := utils.NewCedanaStore(cfg, s.client.tracer)
 
-	err = s.client.RuncDump(ctx, args.Root, args.ContainerId, criuOpts)
+	err = s.client.RuncDump(ctx, args.Root, args.ContainerId, jobId, criuOpts, args.Type == task.RuncDumpArgs_LOCAL)
 	if err != nil {
 		st := status.New(codes.Internal, "Runc dump failed")
 		st.WithDetails(&errdetails.ErrorInfo{
@@ -327,54 +379,17 @@ func (s *service) RuncDump(ctx context.Context, args *task.RuncDumpArgs) (*task.
 			return nil, st.Err()
 		}
 
-		checkpointPath := state.CheckpointPath
-
-		file, err := os.Open(checkpointPath)
-		if err != nil {
-			st := status.New(codes.NotFound, "checkpoint zip not found")
-			st.WithDetails(&errdetails.ErrorInfo{
-				Reason: err.Error(),
-			})
-			return nil, st.Err()
-		}
-		defer file.Close()
-
-		fileInfo, err := file.Stat()
-		if err != nil {
-			st := status.New(codes.Internal, "checkpoint zip stat failed")
-			st.WithDetails(&errdetails.ErrorInfo{
-				Reason: err.Error(),
-			})
-			return nil, st.Err()
-		}
-
-		// Get the size
-		size := fileInfo.Size()
-
-		// zipFileSize += 4096
-		checkpointFullSize := int64(size)
-
-		multipartCheckpointResp, cid, err := store.CreateMultiPartUpload(ctx, checkpointFullSize)
+		// stream the checkpoint straight from its directory into the upload
+		multipartCheckpointResp, meta, err := s.client.uploadCheckpoint(ctx, store, state.CheckpointPath, s.client.compression("", 0))
 		if err != nil {
-			st := status.New(codes.Internal, fmt.Sprintf("CreateMultiPartUpload failed with error: %s", err.Error()))
-			return nil, st.Err()
-		}
-
-		checkpointId = cid
-
-		err = store.StartMultiPartUpload(ctx, cid, multipartCheckpointResp, checkpointPath)
-		if err != nil {
-			st := status.New(codes.Internal, fmt.Sprintf("StartMultiPartUpload failed with error: %s", err.Error()))
+			st := status.New(codes.Internal, err.Error())
 			return nil, st.Err()
 		}
 
-		err = store.CompleteMultiPartUpload(ctx, *multipartCheckpointResp, cid)
-		if err != nil {
-			st := status.New(codes.Internal, fmt.Sprintf("CompleteMultiPartUpload failed with error: %s", err.Error()))
-			return nil, st.Err()
-		}
+		checkpointId = meta.ID
+		checksum = meta.Checksum
 
-		remoteState := &task.RemoteState{CheckpointID: cid, UploadID: multipartCheckpointResp.UploadID, Timestamp: time.Now().Unix()}
+		remoteState := &task.RemoteState{CheckpointID: meta.ID, UploadID: multipartCheckpointResp.UploadID, Timestamp: time.Now().Unix(), Checksum: meta.Checksum}
 
 		state.RemoteState = append(state.RemoteState, remoteState)
 
@@ -384,7 +399,12 @@ func (s *service) RuncDump(ctx context.Context, args *task.RuncDumpArgs) (*task.
 
 	}
 
-	return &task.RuncDumpResp{Message: fmt.Sprintf("Dumped process %s to %s, multipart checkpoint id: %s", jobId, args.CriuOpts.ImagesDirectory, uploadID), CheckpointId: checkpointId}, nil
+	message := fmt.Sprintf("Dumped process %s to %s, multipart checkpoint id: %s", jobId, args.CriuOpts.ImagesDirectory, uploadID)
+	if args.Lazy {
+		message += fmt.Sprintf(", serving pages on %s:%d", criuOpts.PageServer.Address, criuOpts.PageServer.Port)
+	}
+
+	return &task.RuncDumpResp{Message: message, CheckpointId: checkpointId, Checksum: checksum}, nil
 }
 
 func (s *service) RuncRestore(ctx context.Context, args *task.RuncRestoreArgs) (*task.RuncRestoreResp, error) {
@@ -396,9 +416,34 @@ func (s *service) RuncRestore(ctx context.Context, args *task.RuncRestoreArgs) (
 		Detatch:       args.Opts.Detatch,
 		NetPid:        int(args.Opts.NetPid),
 	}
+	var lazy *container.CriuPageServerInfo
+	if args.Lazy {
+		if args.PageServerAddress == "" || args.PageServerPort == 0 {
+			return nil, status.Error(codes.InvalidArgument, "lazy restore requires the page server address and port of the dump")
+		}
+		lazy = &container.CriuPageServerInfo{
+			Address: args.PageServerAddress,
+			Port:    args.PageServerPort,
+		}
+	}
+
 	switch args.Type {
 	case task.RuncRestoreArgs_LOCAL:
-		err := s.client.RuncRestore(ctx, args.ImagePath, args.ContainerId, args.IsK3S, []string{}, opts)
+		var err error
+		// archives, which encrypted checkpoints only are, get extracted first
+		if fi, statErr := os.Stat(args.ImagePath); statErr == nil && fi.Mode().IsRegular() {
+			checkpoint, err := os.Open(args.ImagePath)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			defer checkpoint.Close()
+			err = s.client.restoreRuncArchive(ctx, checkpoint, "", nil, args.ContainerId, args.IsK3S, opts, lazy)
+		} else {
+			err = s.client.RuncRestore(ctx, args.ImagePath, args.ContainerId, args.IsK3S, []string{}, opts, lazy)
+		}
+		if code := restoreErrorCode(err); code != codes.Internal {
+			return nil, status.Error(code, err.Error())
+		}
 		if err != nil {
 			err = status.Error(codes.InvalidArgument, "invalid argument")
 			return nil, err
@@ -417,15 +462,16 @@ func (s *service) RuncRestore(ctx context.Context, args *task.RuncRestoreArgs) (
 
 		store := utils.NewCedanaStore(cfg, s.client.tracer)
 
-		zipFile, err := store.GetCheckpoint(ctx, args.CheckpointId)
+		checkpoint, err := store.GetCheckpointStream(ctx, args.CheckpointId)
 		if err != nil {
 			return nil, err
 		}
+		defer checkpoint.Close()
 
-		err = s.client.RuncRestore(ctx, *zipFile, args.ContainerId, args.IsK3S, []string{}, opts)
-
+		checksum := s.client.downloadChecksum(args.CheckpointId, args.Checksum)
+		err = s.client.restoreRuncArchive(ctx, checkpoint, checksum, store, args.ContainerId, args.IsK3S, opts, lazy)
 		if err != nil {
-			staterr := status.Error(codes.Internal, fmt.Sprintf("failed to restore process: %v", err))
+			staterr := status.Error(restoreErrorCode(err), fmt.Sprintf("failed to restore process: %v", err))
 			return nil, staterr
 		}
 
@@ -434,6 +480,22 @@ func (s *service) RuncRestore(ctx context.Context, args *task.RuncRestoreArgs) (
 	return &task.RuncRestoreResp{Message: fmt.Sprintf("Restored %v, succesfully", args.ContainerId)}, nil
 }
 
+// restoreErrorCode maps a failed restore to its grpc code, a checkpoint that doesn't
+// match its manifest being data loss rather than an internal error, one we don't
+// have the key for a precondition and an untrusted one a permission error.
+func restoreErrorCode(err error) codes.Code {
+	if errors.Is(err, utils.ErrChecksumMismatch) {
+		return codes.DataLoss
+	}
+	if errors.Is(err, utils.ErrUnknownKey) {
+		return codes.FailedPrecondition
+	}
+	if errors.Is(err, utils.ErrUntrustedCheckpoint) {
+		return codes.PermissionDenied
+	}
+	return codes.Internal
+}
+
 func (s *service) ListContainers(ctx context.Context, args *task.ListArgs) (*task.ListResp, error) {
 	var containers []*task.Container
 
@@ -488,21 +550,13 @@ func (s *service) GetPausePid(ctx context.Context, args *task.PausePidArgs) (*ta
 }
 
 func (s *service) publishStateContinous(rate int) {
-	// get PID from id
-	pid, err := s.client.db.GetPID(s.client.jobID)
-	if err != nil {
-		s.logger.Warn().Msgf("could not get pid: %v", err)
-	}
-	s.logger.Info().Msgf("pid: %d", pid)
 	ticker := time.NewTicker(time.Duration(rate) * time.Second)
 	for range ticker.C {
-		if pid != 0 {
-			args := &task.ProcessState{}
+		args := &task.ProcessState{}
 
-			if err := s.ClientStateStream.Send(args); err != nil {
-				log.Printf("Error sending LogStreamingArgs to client: %v", err)
-				return
-			}
+		if err := s.ClientStateStream.Send(args); err != nil {
+			log.Printf("Error sending LogStreamingArgs to client: %v", err)
+			return
 		}
 	}
 }
@@ -568,7 +622,7 @@ func (s *service) ClientStateStreaming(stream task.TaskService_ClientStateStream
 	return nil
 }
 
-func (s *service) runTask(ctx context.Context, task, workingDir, logOutputFile string, uid, gid uint32) (int32, error) {
+func (s *service) runTask(ctx context.Context, task, workingDir, logOutputFile string, uid, gid uint32, pidns bool, cgroup string) (int32, error) {
 	ctx, span := s.client.tracer.Start(ctx, "exec")
 	span.SetAttributes(attribute.String("task", task))
 	defer span.End()
@@ -603,6 +657,23 @@ func (s *service) runTask(ctx context.Context, task, workingDir, logOutputFile s
 		},
 	}
 
+	// the init of the namespace is the job's pid from here on
+	if pidns {
+		if err := inPidns(cmd); err != nil {
+			return 0, err
+		}
+	}
+
+	// nothing runs until the job is in its cgroup
+	var cgroupSync *os.File
+	if cgroup != "" {
+		defer s.client.reapCgroup(cgroup)
+		if cgroupSync, err = inCgroup(cmd); err != nil {
+			return 0, err
+		}
+		defer cgroupSync.Close()
+	}
+
 	// working dir needs to be consistent on the checkpoint and restore side
 	if workingDir != "" {
 		cmd.Dir = workingDir
@@ -626,6 +697,10 @@ func (s *service) runTask(ctx context.Context, task, workingDir, logOutputFile s
 	cmd.Env = os.Environ()
 
 	err = cmd.Start()
+	if cgroupSync != nil {
+		// the end the job waits on
+		cmd.ExtraFiles[len(cmd.ExtraFiles)-1].Close()
+	}
 	if err != nil {
 		return 0, err
 	}
@@ -647,6 +722,17 @@ func (s *service) runTask(ctx context.Context, task, workingDir, logOutputFile s
 	pid = int32(cmd.Process.Pid)
 	ppid := int32(os.Getpid())
 
+	if cgroup != "" {
+		if err := utils.AddToCgroup(cgroup, pid); err != nil {
+			cmd.Process.Kill()
+			return 0, fmt.Errorf("could not move task into cgroup %s: %w", cgroup, err)
+		}
+		if _, err := cgroupSync.Write([]byte{0}); err != nil {
+			cmd.Process.Kill()
+			return 0, fmt.Errorf("could not start task in cgroup %s: %w", cgroup, err)
+		}
+	}
+
 	closeCommonFds(ppid, pid)
 	return pid, nil
 }
@@ -703,13 +789,47 @@ func (s *service) StartTask(ctx context.Context, args *task.StartTaskArgs) (*tas
 		taskToRun = args.Task
 	}
 
-	pid, err := s.runTask(ctx, taskToRun, args.WorkingDir, args.LogOutputFile, args.UID, args.GID)
+	// a policy that can't be scheduled is refused before the task runs, not after
+	if args.CheckpointPolicy != nil {
+		if args.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "a checkpoint policy needs a job id to schedule checkpoints of")
+		}
+		if _, err := parseSchedule(args.CheckpointPolicy); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if err := validateLimits(args.Limits); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if args.Limits != nil && args.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "limits need a job id to name the cgroup of the job after")
+	}
+
+	// jobs get a cgroup of their own, which they can't go without if they have limits
+	var cgroup string
+	if args.Id != "" {
+		var err error
+		if cgroup, err = s.client.jobCgroup(args.Id, args.Limits); err != nil {
+			if args.Limits != nil {
+				return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("could not create the cgroup of the job: %v", err))
+			}
+			s.logger.Warn().Msgf("job %s runs without a cgroup of its own: %v", args.Id, err)
+		}
+	}
+
+	pid, err := s.runTask(ctx, taskToRun, args.WorkingDir, args.LogOutputFile, args.UID, args.GID, args.PidNamespace, cgroup)
 
 	if err == nil {
 		s.client.logger.Info().Msgf("managing process with pid %d", pid)
 
 		state.Flag = task.FlagEnum_JOB_RUNNING
 		state.PID = pid
+		state.Scope = args.Scope
+		if args.PidNamespace {
+			state.PidNamespace = pidNamespace(pid)
+		}
+		state.Cgroup = cgroup
+		state.Limits = cgroupLimits(cgroup)
 	} else {
 		// TODO BS: this should be at market level
 		s.client.logger.Info().Msgf("failed to run task with error: %v, attempt %d", err, 1)
@@ -728,6 +848,13 @@ func (s *service) StartTask(ctx context.Context, args *task.StartTaskArgs) (*tas
 		return nil, err
 	}
 
+	// the job runs either way, it can get its policy again with SetCheckpointPolicy
+	if args.CheckpointPolicy != nil {
+		if err := s.scheduler.set(args.Id, args.CheckpointPolicy); err != nil {
+			s.logger.Warn().Msgf("job %s runs without its checkpoint policy: %v", args.Id, err)
+		}
+	}
+
 	return &task.StartTaskResp{
 		Message: fmt.Sprintf("Started task: %v", pid),
 		PID:     pid,
@@ -750,8 +877,16 @@ func (s *Server) New() (*grpc.Server, error) {
 	logger := utils.GetLogger()
 
 	service := &service{
-		client: client,
-		logger: &logger,
+		client:     client,
+		logger:     &logger,
+		migrations: map[string]*migration{},
+	}
+
+	client.reapCgroups()
+
+	service.scheduler = newScheduler(service)
+	if err := service.scheduler.start(); err != nil {
+		logger.Warn().Msgf("could not load checkpoint policies: %v", err)
 	}
 
 	task.RegisterTaskServiceServer(grpcServer, service)
@@ -765,8 +900,8 @@ func (s *Server) serveGRPC(l net.Listener) error {
 	return s.grpcServer.Serve(l)
 }
 
-func (s *Server) start() {
-	lis, err := net.Listen("tcp", ":8080")
+func (s *Server) start(port int) {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		panic(err)
 	}
@@ -783,7 +918,7 @@ func addGRPC() (*Server, error) {
 	return server, nil
 }
 
-func StartGRPCServer(isK8s bool) (*grpc.Server, error) {
+func StartGRPCServer(isK8s bool, port int) (*grpc.Server, error) {
 	var wg sync.WaitGroup
 
 	// Create a context with a cancel function
@@ -799,7 +934,7 @@ func StartGRPCServer(isK8s bool) (*grpc.Server, error) {
 	wg.Add(2)
 	go func() {
 		defer wg.Done()
-		srv.start()
+		srv.start(port)
 		close(startCh) // Signal that the server has started
 	}()
 
@@ -114,6 +114,102 @@ func (c *ServiceClient) StartTask(args *task.StartTaskArgs) (*task.StartTaskResp
 	return resp, nil
 }
 
+func (c *ServiceClient) Migrate(args *task.MigrateArgs) (*task.MigrateResp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Minute)
+	defer cancel()
+	resp, err := c.taskService.Migrate(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *ServiceClient) SetCheckpointPolicy(args *task.SetCheckpointPolicyArgs) (*task.SetCheckpointPolicyResp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+	resp, err := c.taskService.SetCheckpointPolicy(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *ServiceClient) GarbageCollect(args *task.GarbageCollectArgs) (*task.GarbageCollectResp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+	resp, err := c.taskService.GarbageCollect(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *ServiceClient) CheckHost(args *task.CheckHostArgs) (*task.CheckHostResp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+	resp, err := c.taskService.CheckHost(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *ServiceClient) Analyze(args *task.AnalyzeArgs) (*task.AnalyzeResp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+	resp, err := c.taskService.Analyze(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *ServiceClient) GroupDump(args *task.GroupDumpArgs) (*task.GroupDumpResp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+	resp, err := c.taskService.GroupDump(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *ServiceClient) GroupRestore(args *task.GroupRestoreArgs) (*task.GroupRestoreResp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+	resp, err := c.taskService.GroupRestore(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Attach opens a terminal session to a job restored with a pty, for as long as
+// ctx lasts
+func (c *ServiceClient) Attach(ctx context.Context) (task.TaskService_AttachClient, error) {
+	return c.taskService.Attach(ctx)
+}
+
+func (c *ServiceClient) UpdateJob(args *task.UpdateJobArgs) (*task.UpdateJobResp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+	resp, err := c.taskService.UpdateJob(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *ServiceClient) GetJobMetrics(args *task.GetJobMetricsArgs) (*task.GetJobMetricsResp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+	resp, err := c.taskService.GetJobMetrics(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *ServiceClient) Close() {
 	c.taskConn.Close()
 }
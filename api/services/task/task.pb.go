@@ -20,6 +20,112 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// A job is its process and all of its descendants, or its whole session (which
+// takes in the processes that daemonized out of the tree)
+type JobScope int32
+
+const (
+	// the scope of the job for dumps of one, a tree otherwise. Comes first so
+	// that scopes stored before it keep their meaning.
+	JobScope_UNSPECIFIED JobScope = 0
+	JobScope_SESSION     JobScope = 1
+	JobScope_TREE        JobScope = 2
+)
+
+// Enum value maps for JobScope.
+var (
+	JobScope_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "SESSION",
+		2: "TREE",
+	}
+	JobScope_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"SESSION":     1,
+		"TREE":        2,
+	}
+)
+
+func (x JobScope) Enum() *JobScope {
+	p := new(JobScope)
+	*p = x
+	return p
+}
+
+func (x JobScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_proto_enumTypes[0].Descriptor()
+}
+
+func (JobScope) Type() protoreflect.EnumType {
+	return &file_task_proto_enumTypes[0]
+}
+
+func (x JobScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobScope.Descriptor instead.
+func (JobScope) EnumDescriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{0}
+}
+
+// Which files open by a job get copied into its checkpoints, for restores on
+// hosts that don't have them
+type FileCapture int32
+
+const (
+	FileCapture_NO_FILES FileCapture = 0
+	// files open for writing
+	FileCapture_WRITTEN_FILES FileCapture = 1
+	// every regular file open
+	FileCapture_ALL_FILES FileCapture = 2
+)
+
+// Enum value maps for FileCapture.
+var (
+	FileCapture_name = map[int32]string{
+		0: "NO_FILES",
+		1: "WRITTEN_FILES",
+		2: "ALL_FILES",
+	}
+	FileCapture_value = map[string]int32{
+		"NO_FILES":      0,
+		"WRITTEN_FILES": 1,
+		"ALL_FILES":     2,
+	}
+)
+
+func (x FileCapture) Enum() *FileCapture {
+	p := new(FileCapture)
+	*p = x
+	return p
+}
+
+func (x FileCapture) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FileCapture) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_proto_enumTypes[1].Descriptor()
+}
+
+func (FileCapture) Type() protoreflect.EnumType {
+	return &file_task_proto_enumTypes[1]
+}
+
+func (x FileCapture) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FileCapture.Descriptor instead.
+func (FileCapture) EnumDescriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{1}
+}
+
 type FlagEnum int32
 
 const (
@@ -65,11 +171,11 @@ func (x FlagEnum) String() string {
 }
 
 func (FlagEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_task_proto_enumTypes[0].Descriptor()
+	return file_task_proto_enumTypes[2].Descriptor()
 }
 
 func (FlagEnum) Type() protoreflect.EnumType {
-	return &file_task_proto_enumTypes[0]
+	return &file_task_proto_enumTypes[2]
 }
 
 func (x FlagEnum) Number() protoreflect.EnumNumber {
@@ -78,7 +184,7 @@ func (x FlagEnum) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FlagEnum.Descriptor instead.
 func (FlagEnum) EnumDescriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{0}
+	return file_task_proto_rawDescGZIP(), []int{2}
 }
 
 type CheckpointState int32
@@ -117,11 +223,11 @@ func (x CheckpointState) String() string {
 }
 
 func (CheckpointState) Descriptor() protoreflect.EnumDescriptor {
-	return file_task_proto_enumTypes[1].Descriptor()
+	return file_task_proto_enumTypes[3].Descriptor()
 }
 
 func (CheckpointState) Type() protoreflect.EnumType {
-	return &file_task_proto_enumTypes[1]
+	return &file_task_proto_enumTypes[3]
 }
 
 func (x CheckpointState) Number() protoreflect.EnumNumber {
@@ -130,7 +236,106 @@ func (x CheckpointState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CheckpointState.Descriptor instead.
 func (CheckpointState) EnumDescriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{1}
+	return file_task_proto_rawDescGZIP(), []int{3}
+}
+
+type HostCheck_Status int32
+
+const (
+	HostCheck_PASS HostCheck_Status = 0
+	HostCheck_WARN HostCheck_Status = 1
+	HostCheck_FAIL HostCheck_Status = 2
+)
+
+// Enum value maps for HostCheck_Status.
+var (
+	HostCheck_Status_name = map[int32]string{
+		0: "PASS",
+		1: "WARN",
+		2: "FAIL",
+	}
+	HostCheck_Status_value = map[string]int32{
+		"PASS": 0,
+		"WARN": 1,
+		"FAIL": 2,
+	}
+)
+
+func (x HostCheck_Status) Enum() *HostCheck_Status {
+	p := new(HostCheck_Status)
+	*p = x
+	return p
+}
+
+func (x HostCheck_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HostCheck_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_proto_enumTypes[4].Descriptor()
+}
+
+func (HostCheck_Status) Type() protoreflect.EnumType {
+	return &file_task_proto_enumTypes[4]
+}
+
+func (x HostCheck_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HostCheck_Status.Descriptor instead.
+func (HostCheck_Status) EnumDescriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type Finding_Level int32
+
+const (
+	Finding_INFO Finding_Level = 0
+	Finding_WARN Finding_Level = 1
+	// CRIU can't dump it, the dump fails
+	Finding_BLOCKER Finding_Level = 2
+)
+
+// Enum value maps for Finding_Level.
+var (
+	Finding_Level_name = map[int32]string{
+		0: "INFO",
+		1: "WARN",
+		2: "BLOCKER",
+	}
+	Finding_Level_value = map[string]int32{
+		"INFO":    0,
+		"WARN":    1,
+		"BLOCKER": 2,
+	}
+)
+
+func (x Finding_Level) Enum() *Finding_Level {
+	p := new(Finding_Level)
+	*p = x
+	return p
+}
+
+func (x Finding_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Finding_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_proto_enumTypes[5].Descriptor()
+}
+
+func (Finding_Level) Type() protoreflect.EnumType {
+	return &file_task_proto_enumTypes[5]
+}
+
+func (x Finding_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Finding_Level.Descriptor instead.
+func (Finding_Level) EnumDescriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{15, 0}
 }
 
 type DumpArgs_DumpType int32
@@ -163,11 +368,11 @@ func (x DumpArgs_DumpType) String() string {
 }
 
 func (DumpArgs_DumpType) Descriptor() protoreflect.EnumDescriptor {
-	return file_task_proto_enumTypes[2].Descriptor()
+	return file_task_proto_enumTypes[6].Descriptor()
 }
 
 func (DumpArgs_DumpType) Type() protoreflect.EnumType {
-	return &file_task_proto_enumTypes[2]
+	return &file_task_proto_enumTypes[6]
 }
 
 func (x DumpArgs_DumpType) Number() protoreflect.EnumNumber {
@@ -176,7 +381,7 @@ func (x DumpArgs_DumpType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DumpArgs_DumpType.Descriptor instead.
 func (DumpArgs_DumpType) EnumDescriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{5, 0}
+	return file_task_proto_rawDescGZIP(), []int{27, 0}
 }
 
 type RestoreArgs_RestoreType int32
@@ -209,11 +414,11 @@ func (x RestoreArgs_RestoreType) String() string {
 }
 
 func (RestoreArgs_RestoreType) Descriptor() protoreflect.EnumDescriptor {
-	return file_task_proto_enumTypes[3].Descriptor()
+	return file_task_proto_enumTypes[7].Descriptor()
 }
 
 func (RestoreArgs_RestoreType) Type() protoreflect.EnumType {
-	return &file_task_proto_enumTypes[3]
+	return &file_task_proto_enumTypes[7]
 }
 
 func (x RestoreArgs_RestoreType) Number() protoreflect.EnumNumber {
@@ -222,7 +427,56 @@ func (x RestoreArgs_RestoreType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RestoreArgs_RestoreType.Descriptor instead.
 func (RestoreArgs_RestoreType) EnumDescriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{7, 0}
+	return file_task_proto_rawDescGZIP(), []int{29, 0}
+}
+
+type StdioTarget_TargetType int32
+
+const (
+	StdioTarget_FILE   StdioTarget_TargetType = 0
+	StdioTarget_FIFO   StdioTarget_TargetType = 1
+	StdioTarget_SOCKET StdioTarget_TargetType = 2
+)
+
+// Enum value maps for StdioTarget_TargetType.
+var (
+	StdioTarget_TargetType_name = map[int32]string{
+		0: "FILE",
+		1: "FIFO",
+		2: "SOCKET",
+	}
+	StdioTarget_TargetType_value = map[string]int32{
+		"FILE":   0,
+		"FIFO":   1,
+		"SOCKET": 2,
+	}
+)
+
+func (x StdioTarget_TargetType) Enum() *StdioTarget_TargetType {
+	p := new(StdioTarget_TargetType)
+	*p = x
+	return p
+}
+
+func (x StdioTarget_TargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StdioTarget_TargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_proto_enumTypes[8].Descriptor()
+}
+
+func (StdioTarget_TargetType) Type() protoreflect.EnumType {
+	return &file_task_proto_enumTypes[8]
+}
+
+func (x StdioTarget_TargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StdioTarget_TargetType.Descriptor instead.
+func (StdioTarget_TargetType) EnumDescriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{30, 0}
 }
 
 type ProcessState_ContainerRuntimeOpts int32
@@ -255,11 +509,11 @@ func (x ProcessState_ContainerRuntimeOpts) String() string {
 }
 
 func (ProcessState_ContainerRuntimeOpts) Descriptor() protoreflect.EnumDescriptor {
-	return file_task_proto_enumTypes[4].Descriptor()
+	return file_task_proto_enumTypes[9].Descriptor()
 }
 
 func (ProcessState_ContainerRuntimeOpts) Type() protoreflect.EnumType {
-	return &file_task_proto_enumTypes[4]
+	return &file_task_proto_enumTypes[9]
 }
 
 func (x ProcessState_ContainerRuntimeOpts) Number() protoreflect.EnumNumber {
@@ -268,7 +522,7 @@ func (x ProcessState_ContainerRuntimeOpts) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProcessState_ContainerRuntimeOpts.Descriptor instead.
 func (ProcessState_ContainerRuntimeOpts) EnumDescriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{13, 0}
+	return file_task_proto_rawDescGZIP(), []int{43, 0}
 }
 
 type OpenFilesStat_StreamType int32
@@ -307,11 +561,11 @@ func (x OpenFilesStat_StreamType) String() string {
 }
 
 func (OpenFilesStat_StreamType) Descriptor() protoreflect.EnumDescriptor {
-	return file_task_proto_enumTypes[5].Descriptor()
+	return file_task_proto_enumTypes[10].Descriptor()
 }
 
 func (OpenFilesStat_StreamType) Type() protoreflect.EnumType {
-	return &file_task_proto_enumTypes[5]
+	return &file_task_proto_enumTypes[10]
 }
 
 func (x OpenFilesStat_StreamType) Number() protoreflect.EnumNumber {
@@ -320,7 +574,7 @@ func (x OpenFilesStat_StreamType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OpenFilesStat_StreamType.Descriptor instead.
 func (OpenFilesStat_StreamType) EnumDescriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{17, 0}
+	return file_task_proto_rawDescGZIP(), []int{52, 0}
 }
 
 type CheckpointReason_CheckpointReasonEnum int32
@@ -356,11 +610,11 @@ func (x CheckpointReason_CheckpointReasonEnum) String() string {
 }
 
 func (CheckpointReason_CheckpointReasonEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_task_proto_enumTypes[6].Descriptor()
+	return file_task_proto_enumTypes[11].Descriptor()
 }
 
 func (CheckpointReason_CheckpointReasonEnum) Type() protoreflect.EnumType {
-	return &file_task_proto_enumTypes[6]
+	return &file_task_proto_enumTypes[11]
 }
 
 func (x CheckpointReason_CheckpointReasonEnum) Number() protoreflect.EnumNumber {
@@ -369,7 +623,7 @@ func (x CheckpointReason_CheckpointReasonEnum) Number() protoreflect.EnumNumber
 
 // Deprecated: Use CheckpointReason_CheckpointReasonEnum.Descriptor instead.
 func (CheckpointReason_CheckpointReasonEnum) EnumDescriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{22, 0}
+	return file_task_proto_rawDescGZIP(), []int{58, 0}
 }
 
 type RuncDumpArgs_DumpType int32
@@ -402,11 +656,11 @@ func (x RuncDumpArgs_DumpType) String() string {
 }
 
 func (RuncDumpArgs_DumpType) Descriptor() protoreflect.EnumDescriptor {
-	return file_task_proto_enumTypes[7].Descriptor()
+	return file_task_proto_enumTypes[12].Descriptor()
 }
 
 func (RuncDumpArgs_DumpType) Type() protoreflect.EnumType {
-	return &file_task_proto_enumTypes[7]
+	return &file_task_proto_enumTypes[12]
 }
 
 func (x RuncDumpArgs_DumpType) Number() protoreflect.EnumNumber {
@@ -415,7 +669,56 @@ func (x RuncDumpArgs_DumpType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RuncDumpArgs_DumpType.Descriptor instead.
 func (RuncDumpArgs_DumpType) EnumDescriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{35, 0}
+	return file_task_proto_rawDescGZIP(), []int{71, 0}
+}
+
+type CriuProcessOpts_TcpMode int32
+
+const (
+	CriuProcessOpts_TCP_DEFAULT     CriuProcessOpts_TcpMode = 0
+	CriuProcessOpts_TCP_ESTABLISHED CriuProcessOpts_TcpMode = 1
+	CriuProcessOpts_TCP_CLOSE       CriuProcessOpts_TcpMode = 2
+)
+
+// Enum value maps for CriuProcessOpts_TcpMode.
+var (
+	CriuProcessOpts_TcpMode_name = map[int32]string{
+		0: "TCP_DEFAULT",
+		1: "TCP_ESTABLISHED",
+		2: "TCP_CLOSE",
+	}
+	CriuProcessOpts_TcpMode_value = map[string]int32{
+		"TCP_DEFAULT":     0,
+		"TCP_ESTABLISHED": 1,
+		"TCP_CLOSE":       2,
+	}
+)
+
+func (x CriuProcessOpts_TcpMode) Enum() *CriuProcessOpts_TcpMode {
+	p := new(CriuProcessOpts_TcpMode)
+	*p = x
+	return p
+}
+
+func (x CriuProcessOpts_TcpMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CriuProcessOpts_TcpMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_proto_enumTypes[13].Descriptor()
+}
+
+func (CriuProcessOpts_TcpMode) Type() protoreflect.EnumType {
+	return &file_task_proto_enumTypes[13]
+}
+
+func (x CriuProcessOpts_TcpMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CriuProcessOpts_TcpMode.Descriptor instead.
+func (CriuProcessOpts_TcpMode) EnumDescriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{73, 0}
 }
 
 type RuncRestoreArgs_RestoreType int32
@@ -448,11 +751,11 @@ func (x RuncRestoreArgs_RestoreType) String() string {
 }
 
 func (RuncRestoreArgs_RestoreType) Descriptor() protoreflect.EnumDescriptor {
-	return file_task_proto_enumTypes[8].Descriptor()
+	return file_task_proto_enumTypes[14].Descriptor()
 }
 
 func (RuncRestoreArgs_RestoreType) Type() protoreflect.EnumType {
-	return &file_task_proto_enumTypes[8]
+	return &file_task_proto_enumTypes[14]
 }
 
 func (x RuncRestoreArgs_RestoreType) Number() protoreflect.EnumNumber {
@@ -461,20 +764,26 @@ func (x RuncRestoreArgs_RestoreType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RuncRestoreArgs_RestoreType.Descriptor instead.
 func (RuncRestoreArgs_RestoreType) EnumDescriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{38, 0}
+	return file_task_proto_rawDescGZIP(), []int{75, 0}
 }
 
-type ListArgs struct {
+// Migration args
+type MigrateArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Root      string `protobuf:"bytes,1,opt,name=Root,proto3" json:"Root,omitempty"`
-	Namespace string `protobuf:"bytes,2,opt,name=Namespace,proto3" json:"Namespace,omitempty"`
+	JobID string `protobuf:"bytes,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	// address of the target cedana daemon, host:port
+	Target string `protobuf:"bytes,2,opt,name=Target,proto3" json:"Target,omitempty"`
+	// address the target's page server is reachable on, defaults to the target host
+	PageServerAddress string `protobuf:"bytes,3,opt,name=PageServerAddress,proto3" json:"PageServerAddress,omitempty"`
+	// local directory to dump the non-memory images to before sending them over
+	Dir string `protobuf:"bytes,4,opt,name=Dir,proto3" json:"Dir,omitempty"`
 }
 
-func (x *ListArgs) Reset() {
-	*x = ListArgs{}
+func (x *MigrateArgs) Reset() {
+	*x = MigrateArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -482,13 +791,13 @@ func (x *ListArgs) Reset() {
 	}
 }
 
-func (x *ListArgs) String() string {
+func (x *MigrateArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListArgs) ProtoMessage() {}
+func (*MigrateArgs) ProtoMessage() {}
 
-func (x *ListArgs) ProtoReflect() protoreflect.Message {
+func (x *MigrateArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -500,35 +809,51 @@ func (x *ListArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListArgs.ProtoReflect.Descriptor instead.
-func (*ListArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use MigrateArgs.ProtoReflect.Descriptor instead.
+func (*MigrateArgs) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *ListArgs) GetRoot() string {
+func (x *MigrateArgs) GetJobID() string {
 	if x != nil {
-		return x.Root
+		return x.JobID
 	}
 	return ""
 }
 
-func (x *ListArgs) GetNamespace() string {
+func (x *MigrateArgs) GetTarget() string {
 	if x != nil {
-		return x.Namespace
+		return x.Target
 	}
 	return ""
 }
 
-type ListResp struct {
+func (x *MigrateArgs) GetPageServerAddress() string {
+	if x != nil {
+		return x.PageServerAddress
+	}
+	return ""
+}
+
+func (x *MigrateArgs) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+type MigrateResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Containers []*Container `protobuf:"bytes,1,rep,name=containers,proto3" json:"containers,omitempty"`
+	Message     string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	MigrationID string `protobuf:"bytes,2,opt,name=MigrationID,proto3" json:"MigrationID,omitempty"`
+	NewPID      int32  `protobuf:"varint,3,opt,name=NewPID,proto3" json:"NewPID,omitempty"`
 }
 
-func (x *ListResp) Reset() {
-	*x = ListResp{}
+func (x *MigrateResp) Reset() {
+	*x = MigrateResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -536,13 +861,13 @@ func (x *ListResp) Reset() {
 	}
 }
 
-func (x *ListResp) String() string {
+func (x *MigrateResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResp) ProtoMessage() {}
+func (*MigrateResp) ProtoMessage() {}
 
-func (x *ListResp) ProtoReflect() protoreflect.Message {
+func (x *MigrateResp) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -554,33 +879,42 @@ func (x *ListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResp.ProtoReflect.Descriptor instead.
-func (*ListResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use MigrateResp.ProtoReflect.Descriptor instead.
+func (*MigrateResp) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ListResp) GetContainers() []*Container {
+func (x *MigrateResp) GetMessage() string {
 	if x != nil {
-		return x.Containers
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type Container struct {
+func (x *MigrateResp) GetMigrationID() string {
+	if x != nil {
+		return x.MigrationID
+	}
+	return ""
+}
+
+func (x *MigrateResp) GetNewPID() int32 {
+	if x != nil {
+		return x.NewPID
+	}
+	return 0
+}
+
+type PrepareMigrationArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContainerName    string `protobuf:"bytes,1,opt,name=ContainerName,proto3" json:"ContainerName,omitempty"`
-	ImageName        string `protobuf:"bytes,2,opt,name=ImageName,proto3" json:"ImageName,omitempty"`
-	SandboxId        string `protobuf:"bytes,3,opt,name=SandboxId,proto3" json:"SandboxId,omitempty"`
-	SandboxName      string `protobuf:"bytes,4,opt,name=SandboxName,proto3" json:"SandboxName,omitempty"`
-	SandboxNamespace string `protobuf:"bytes,5,opt,name=SandboxNamespace,proto3" json:"SandboxNamespace,omitempty"`
-	SandboxUid       string `protobuf:"bytes,6,opt,name=SandboxUid,proto3" json:"SandboxUid,omitempty"`
+	JobID string `protobuf:"bytes,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
 }
 
-func (x *Container) Reset() {
-	*x = Container{}
+func (x *PrepareMigrationArgs) Reset() {
+	*x = PrepareMigrationArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -588,13 +922,13 @@ func (x *Container) Reset() {
 	}
 }
 
-func (x *Container) String() string {
+func (x *PrepareMigrationArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Container) ProtoMessage() {}
+func (*PrepareMigrationArgs) ProtoMessage() {}
 
-func (x *Container) ProtoReflect() protoreflect.Message {
+func (x *PrepareMigrationArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -606,63 +940,29 @@ func (x *Container) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Container.ProtoReflect.Descriptor instead.
-func (*Container) Descriptor() ([]byte, []int) {
+// Deprecated: Use PrepareMigrationArgs.ProtoReflect.Descriptor instead.
+func (*PrepareMigrationArgs) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Container) GetContainerName() string {
-	if x != nil {
-		return x.ContainerName
-	}
-	return ""
-}
-
-func (x *Container) GetImageName() string {
-	if x != nil {
-		return x.ImageName
-	}
-	return ""
-}
-
-func (x *Container) GetSandboxId() string {
-	if x != nil {
-		return x.SandboxId
-	}
-	return ""
-}
-
-func (x *Container) GetSandboxName() string {
-	if x != nil {
-		return x.SandboxName
-	}
-	return ""
-}
-
-func (x *Container) GetSandboxNamespace() string {
-	if x != nil {
-		return x.SandboxNamespace
-	}
-	return ""
-}
-
-func (x *Container) GetSandboxUid() string {
+func (x *PrepareMigrationArgs) GetJobID() string {
 	if x != nil {
-		return x.SandboxUid
+		return x.JobID
 	}
 	return ""
 }
 
-type Annotation struct {
+type PrepareMigrationResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Annotations map[string]string `protobuf:"bytes,1,rep,name=Annotations,proto3" json:"Annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MigrationID    string `protobuf:"bytes,1,opt,name=MigrationID,proto3" json:"MigrationID,omitempty"`
+	PageServerPort int32  `protobuf:"varint,2,opt,name=PageServerPort,proto3" json:"PageServerPort,omitempty"`
 }
 
-func (x *Annotation) Reset() {
-	*x = Annotation{}
+func (x *PrepareMigrationResp) Reset() {
+	*x = PrepareMigrationResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -670,13 +970,13 @@ func (x *Annotation) Reset() {
 	}
 }
 
-func (x *Annotation) String() string {
+func (x *PrepareMigrationResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Annotation) ProtoMessage() {}
+func (*PrepareMigrationResp) ProtoMessage() {}
 
-func (x *Annotation) ProtoReflect() protoreflect.Message {
+func (x *PrepareMigrationResp) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -688,28 +988,37 @@ func (x *Annotation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
-func (*Annotation) Descriptor() ([]byte, []int) {
+// Deprecated: Use PrepareMigrationResp.ProtoReflect.Descriptor instead.
+func (*PrepareMigrationResp) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Annotation) GetAnnotations() map[string]string {
+func (x *PrepareMigrationResp) GetMigrationID() string {
 	if x != nil {
-		return x.Annotations
+		return x.MigrationID
 	}
-	return nil
+	return ""
 }
 
-type External struct {
+func (x *PrepareMigrationResp) GetPageServerPort() int32 {
+	if x != nil {
+		return x.PageServerPort
+	}
+	return 0
+}
+
+type MigrationChunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	String_ []string `protobuf:"bytes,1,rep,name=String,proto3" json:"String,omitempty"`
+	// set on the first chunk only
+	MigrationID string `protobuf:"bytes,1,opt,name=MigrationID,proto3" json:"MigrationID,omitempty"`
+	Data        []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
 }
 
-func (x *External) Reset() {
-	*x = External{}
+func (x *MigrationChunk) Reset() {
+	*x = MigrationChunk{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -717,13 +1026,13 @@ func (x *External) Reset() {
 	}
 }
 
-func (x *External) String() string {
+func (x *MigrationChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*External) ProtoMessage() {}
+func (*MigrationChunk) ProtoMessage() {}
 
-func (x *External) ProtoReflect() protoreflect.Message {
+func (x *MigrationChunk) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -735,31 +1044,44 @@ func (x *External) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use External.ProtoReflect.Descriptor instead.
-func (*External) Descriptor() ([]byte, []int) {
+// Deprecated: Use MigrationChunk.ProtoReflect.Descriptor instead.
+func (*MigrationChunk) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *External) GetString_() []string {
+func (x *MigrationChunk) GetMigrationID() string {
 	if x != nil {
-		return x.String_
+		return x.MigrationID
+	}
+	return ""
+}
+
+func (x *MigrationChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
-type DumpArgs struct {
+// Periodic checkpoints of a job, taken by the daemon on an interval or a cron
+// schedule (one or the other).
+type CheckpointPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PID   int32             `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
-	Dir   string            `protobuf:"bytes,2,opt,name=Dir,proto3" json:"Dir,omitempty"`
-	Type  DumpArgs_DumpType `protobuf:"varint,3,opt,name=Type,proto3,enum=cedana.services.task.DumpArgs_DumpType" json:"Type,omitempty"`
-	JobID string            `protobuf:"bytes,4,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	IntervalSeconds int64 `protobuf:"varint,1,opt,name=IntervalSeconds,proto3" json:"IntervalSeconds,omitempty"`
+	// standard 5 field cron expression
+	Cron string            `protobuf:"bytes,2,opt,name=Cron,proto3" json:"Cron,omitempty"`
+	Type DumpArgs_DumpType `protobuf:"varint,3,opt,name=Type,proto3,enum=cedana.services.task.DumpArgs_DumpType" json:"Type,omitempty"`
+	// defaults to the shared storage dir
+	Dir string `protobuf:"bytes,4,opt,name=Dir,proto3" json:"Dir,omitempty"`
+	// scheduled checkpoints to keep, older ones get deleted. 0 keeps them all
+	MaxKeep int32 `protobuf:"varint,5,opt,name=MaxKeep,proto3" json:"MaxKeep,omitempty"`
 }
 
-func (x *DumpArgs) Reset() {
-	*x = DumpArgs{}
+func (x *CheckpointPolicy) Reset() {
+	*x = CheckpointPolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -767,13 +1089,13 @@ func (x *DumpArgs) Reset() {
 	}
 }
 
-func (x *DumpArgs) String() string {
+func (x *CheckpointPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DumpArgs) ProtoMessage() {}
+func (*CheckpointPolicy) ProtoMessage() {}
 
-func (x *DumpArgs) ProtoReflect() protoreflect.Message {
+func (x *CheckpointPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -785,51 +1107,58 @@ func (x *DumpArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DumpArgs.ProtoReflect.Descriptor instead.
-func (*DumpArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckpointPolicy.ProtoReflect.Descriptor instead.
+func (*CheckpointPolicy) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DumpArgs) GetPID() int32 {
+func (x *CheckpointPolicy) GetIntervalSeconds() int64 {
 	if x != nil {
-		return x.PID
+		return x.IntervalSeconds
 	}
 	return 0
 }
 
-func (x *DumpArgs) GetDir() string {
+func (x *CheckpointPolicy) GetCron() string {
 	if x != nil {
-		return x.Dir
+		return x.Cron
 	}
 	return ""
 }
 
-func (x *DumpArgs) GetType() DumpArgs_DumpType {
+func (x *CheckpointPolicy) GetType() DumpArgs_DumpType {
 	if x != nil {
 		return x.Type
 	}
 	return DumpArgs_LOCAL
 }
 
-func (x *DumpArgs) GetJobID() string {
+func (x *CheckpointPolicy) GetDir() string {
 	if x != nil {
-		return x.JobID
+		return x.Dir
 	}
 	return ""
 }
 
-type DumpResp struct {
+func (x *CheckpointPolicy) GetMaxKeep() int32 {
+	if x != nil {
+		return x.MaxKeep
+	}
+	return 0
+}
+
+type SetCheckpointPolicyArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message      string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
-	CheckpointID string `protobuf:"bytes,2,opt,name=CheckpointID,proto3" json:"CheckpointID,omitempty"`
-	UploadID     string `protobuf:"bytes,3,opt,name=UploadID,proto3" json:"UploadID,omitempty"`
+	JobID string `protobuf:"bytes,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	// an unset policy stops scheduled checkpoints of the job
+	Policy *CheckpointPolicy `protobuf:"bytes,2,opt,name=Policy,proto3" json:"Policy,omitempty"`
 }
 
-func (x *DumpResp) Reset() {
-	*x = DumpResp{}
+func (x *SetCheckpointPolicyArgs) Reset() {
+	*x = SetCheckpointPolicyArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -837,13 +1166,13 @@ func (x *DumpResp) Reset() {
 	}
 }
 
-func (x *DumpResp) String() string {
+func (x *SetCheckpointPolicyArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DumpResp) ProtoMessage() {}
+func (*SetCheckpointPolicyArgs) ProtoMessage() {}
 
-func (x *DumpResp) ProtoReflect() protoreflect.Message {
+func (x *SetCheckpointPolicyArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -855,45 +1184,35 @@ func (x *DumpResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DumpResp.ProtoReflect.Descriptor instead.
-func (*DumpResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetCheckpointPolicyArgs.ProtoReflect.Descriptor instead.
+func (*SetCheckpointPolicyArgs) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DumpResp) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *DumpResp) GetCheckpointID() string {
+func (x *SetCheckpointPolicyArgs) GetJobID() string {
 	if x != nil {
-		return x.CheckpointID
+		return x.JobID
 	}
 	return ""
 }
 
-func (x *DumpResp) GetUploadID() string {
+func (x *SetCheckpointPolicyArgs) GetPolicy() *CheckpointPolicy {
 	if x != nil {
-		return x.UploadID
+		return x.Policy
 	}
-	return ""
+	return nil
 }
 
-type RestoreArgs struct {
+type SetCheckpointPolicyResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type           RestoreArgs_RestoreType `protobuf:"varint,1,opt,name=Type,proto3,enum=cedana.services.task.RestoreArgs_RestoreType" json:"Type,omitempty"`
-	CheckpointId   string                  `protobuf:"bytes,2,opt,name=CheckpointId,proto3" json:"CheckpointId,omitempty"`
-	CheckpointPath string                  `protobuf:"bytes,3,opt,name=CheckpointPath,proto3" json:"CheckpointPath,omitempty"`
-	JobID          string                  `protobuf:"bytes,4,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
 }
 
-func (x *RestoreArgs) Reset() {
-	*x = RestoreArgs{}
+func (x *SetCheckpointPolicyResp) Reset() {
+	*x = SetCheckpointPolicyResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -901,13 +1220,13 @@ func (x *RestoreArgs) Reset() {
 	}
 }
 
-func (x *RestoreArgs) String() string {
+func (x *SetCheckpointPolicyResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreArgs) ProtoMessage() {}
+func (*SetCheckpointPolicyResp) ProtoMessage() {}
 
-func (x *RestoreArgs) ProtoReflect() protoreflect.Message {
+func (x *SetCheckpointPolicyResp) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -919,50 +1238,32 @@ func (x *RestoreArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreArgs.ProtoReflect.Descriptor instead.
-func (*RestoreArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetCheckpointPolicyResp.ProtoReflect.Descriptor instead.
+func (*SetCheckpointPolicyResp) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *RestoreArgs) GetType() RestoreArgs_RestoreType {
-	if x != nil {
-		return x.Type
-	}
-	return RestoreArgs_LOCAL
-}
-
-func (x *RestoreArgs) GetCheckpointId() string {
-	if x != nil {
-		return x.CheckpointId
-	}
-	return ""
-}
-
-func (x *RestoreArgs) GetCheckpointPath() string {
-	if x != nil {
-		return x.CheckpointPath
-	}
-	return ""
-}
-
-func (x *RestoreArgs) GetJobID() string {
+func (x *SetCheckpointPolicyResp) GetMessage() string {
 	if x != nil {
-		return x.JobID
+		return x.Message
 	}
 	return ""
 }
 
-type RestoreResp struct {
+// Deletes the checkpoints past the retention policy of the client config
+type GarbageCollectArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
-	NewPID  int32  `protobuf:"varint,2,opt,name=NewPID,proto3" json:"NewPID,omitempty"`
+	// only list what would be deleted
+	DryRun bool `protobuf:"varint,1,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	// collect the checkpoints of this job only, empty for all
+	JobID string `protobuf:"bytes,2,opt,name=JobID,proto3" json:"JobID,omitempty"`
 }
 
-func (x *RestoreResp) Reset() {
-	*x = RestoreResp{}
+func (x *GarbageCollectArgs) Reset() {
+	*x = GarbageCollectArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -970,13 +1271,13 @@ func (x *RestoreResp) Reset() {
 	}
 }
 
-func (x *RestoreResp) String() string {
+func (x *GarbageCollectArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreResp) ProtoMessage() {}
+func (*GarbageCollectArgs) ProtoMessage() {}
 
-func (x *RestoreResp) ProtoReflect() protoreflect.Message {
+func (x *GarbageCollectArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -988,40 +1289,41 @@ func (x *RestoreResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreResp.ProtoReflect.Descriptor instead.
-func (*RestoreResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GarbageCollectArgs.ProtoReflect.Descriptor instead.
+func (*GarbageCollectArgs) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RestoreResp) GetMessage() string {
+func (x *GarbageCollectArgs) GetDryRun() bool {
 	if x != nil {
-		return x.Message
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-func (x *RestoreResp) GetNewPID() int32 {
+func (x *GarbageCollectArgs) GetJobID() string {
 	if x != nil {
-		return x.NewPID
+		return x.JobID
 	}
-	return 0
+	return ""
 }
 
-type StartTaskArgs struct {
+type CollectedCheckpoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Task          string `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"`
-	Id            string `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
-	WorkingDir    string `protobuf:"bytes,3,opt,name=WorkingDir,proto3" json:"WorkingDir,omitempty"`
-	LogOutputFile string `protobuf:"bytes,4,opt,name=LogOutputFile,proto3" json:"LogOutputFile,omitempty"`
-	UID           uint32 `protobuf:"varint,5,opt,name=UID,proto3" json:"UID,omitempty"`
-	GID           uint32 `protobuf:"varint,6,opt,name=GID,proto3" json:"GID,omitempty"`
+	JobID          string `protobuf:"bytes,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	CheckpointPath string `protobuf:"bytes,2,opt,name=CheckpointPath,proto3" json:"CheckpointPath,omitempty"`
+	// set for checkpoints also uploaded
+	CheckpointID string `protobuf:"bytes,3,opt,name=CheckpointID,proto3" json:"CheckpointID,omitempty"`
+	Size         int64  `protobuf:"varint,4,opt,name=Size,proto3" json:"Size,omitempty"`
+	// unix seconds
+	Timestamp int64 `protobuf:"varint,5,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 }
 
-func (x *StartTaskArgs) Reset() {
-	*x = StartTaskArgs{}
+func (x *CollectedCheckpoint) Reset() {
+	*x = CollectedCheckpoint{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1029,13 +1331,13 @@ func (x *StartTaskArgs) Reset() {
 	}
 }
 
-func (x *StartTaskArgs) String() string {
+func (x *CollectedCheckpoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTaskArgs) ProtoMessage() {}
+func (*CollectedCheckpoint) ProtoMessage() {}
 
-func (x *StartTaskArgs) ProtoReflect() protoreflect.Message {
+func (x *CollectedCheckpoint) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1047,64 +1349,59 @@ func (x *StartTaskArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTaskArgs.ProtoReflect.Descriptor instead.
-func (*StartTaskArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use CollectedCheckpoint.ProtoReflect.Descriptor instead.
+func (*CollectedCheckpoint) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *StartTaskArgs) GetTask() string {
-	if x != nil {
-		return x.Task
-	}
-	return ""
-}
-
-func (x *StartTaskArgs) GetId() string {
+func (x *CollectedCheckpoint) GetJobID() string {
 	if x != nil {
-		return x.Id
+		return x.JobID
 	}
 	return ""
 }
 
-func (x *StartTaskArgs) GetWorkingDir() string {
+func (x *CollectedCheckpoint) GetCheckpointPath() string {
 	if x != nil {
-		return x.WorkingDir
+		return x.CheckpointPath
 	}
 	return ""
 }
 
-func (x *StartTaskArgs) GetLogOutputFile() string {
+func (x *CollectedCheckpoint) GetCheckpointID() string {
 	if x != nil {
-		return x.LogOutputFile
+		return x.CheckpointID
 	}
 	return ""
 }
 
-func (x *StartTaskArgs) GetUID() uint32 {
+func (x *CollectedCheckpoint) GetSize() int64 {
 	if x != nil {
-		return x.UID
+		return x.Size
 	}
 	return 0
 }
 
-func (x *StartTaskArgs) GetGID() uint32 {
+func (x *CollectedCheckpoint) GetTimestamp() int64 {
 	if x != nil {
-		return x.GID
+		return x.Timestamp
 	}
 	return 0
 }
 
-type StartTaskResp struct {
+type GarbageCollectResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
-	PID     int32  `protobuf:"varint,2,opt,name=PID,proto3" json:"PID,omitempty"`
+	Checkpoints []*CollectedCheckpoint `protobuf:"bytes,1,rep,name=Checkpoints,proto3" json:"Checkpoints,omitempty"`
+	// bytes freed on disk, deduplicated chunks included
+	FreedBytes  int64 `protobuf:"varint,2,opt,name=FreedBytes,proto3" json:"FreedBytes,omitempty"`
+	FreedChunks int64 `protobuf:"varint,3,opt,name=FreedChunks,proto3" json:"FreedChunks,omitempty"`
 }
 
-func (x *StartTaskResp) Reset() {
-	*x = StartTaskResp{}
+func (x *GarbageCollectResp) Reset() {
+	*x = GarbageCollectResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1112,13 +1409,13 @@ func (x *StartTaskResp) Reset() {
 	}
 }
 
-func (x *StartTaskResp) String() string {
+func (x *GarbageCollectResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTaskResp) ProtoMessage() {}
+func (*GarbageCollectResp) ProtoMessage() {}
 
-func (x *StartTaskResp) ProtoReflect() protoreflect.Message {
+func (x *GarbageCollectResp) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1130,39 +1427,41 @@ func (x *StartTaskResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTaskResp.ProtoReflect.Descriptor instead.
-func (*StartTaskResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GarbageCollectResp.ProtoReflect.Descriptor instead.
+func (*GarbageCollectResp) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *StartTaskResp) GetMessage() string {
+func (x *GarbageCollectResp) GetCheckpoints() []*CollectedCheckpoint {
 	if x != nil {
-		return x.Message
+		return x.Checkpoints
 	}
-	return ""
+	return nil
 }
 
-func (x *StartTaskResp) GetPID() int32 {
+func (x *GarbageCollectResp) GetFreedBytes() int64 {
 	if x != nil {
-		return x.PID
+		return x.FreedBytes
 	}
 	return 0
 }
 
-// Log Streaming args
-type LogStreamingArgs struct {
+func (x *GarbageCollectResp) GetFreedChunks() int64 {
+	if x != nil {
+		return x.FreedChunks
+	}
+	return 0
+}
+
+// Readiness of the host to checkpoint and restore
+type CheckHostArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Timestamp string `protobuf:"bytes,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	Source    string `protobuf:"bytes,2,opt,name=Source,proto3" json:"Source,omitempty"`
-	Level     string `protobuf:"bytes,3,opt,name=Level,proto3" json:"Level,omitempty"`
-	Msg       string `protobuf:"bytes,4,opt,name=Msg,proto3" json:"Msg,omitempty"`
 }
 
-func (x *LogStreamingArgs) Reset() {
-	*x = LogStreamingArgs{}
+func (x *CheckHostArgs) Reset() {
+	*x = CheckHostArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1170,13 +1469,13 @@ func (x *LogStreamingArgs) Reset() {
 	}
 }
 
-func (x *LogStreamingArgs) String() string {
+func (x *CheckHostArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogStreamingArgs) ProtoMessage() {}
+func (*CheckHostArgs) ProtoMessage() {}
 
-func (x *LogStreamingArgs) ProtoReflect() protoreflect.Message {
+func (x *CheckHostArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1188,64 +1487,101 @@ func (x *LogStreamingArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogStreamingArgs.ProtoReflect.Descriptor instead.
-func (*LogStreamingArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckHostArgs.ProtoReflect.Descriptor instead.
+func (*CheckHostArgs) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *LogStreamingArgs) GetTimestamp() string {
-	if x != nil {
-		return x.Timestamp
-	}
-	return ""
-}
-
-func (x *LogStreamingArgs) GetSource() string {
+type HostCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string           `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Result  HostCheck_Status `protobuf:"varint,2,opt,name=Result,proto3,enum=cedana.services.task.HostCheck_Status" json:"Result,omitempty"`
+	Message string           `protobuf:"bytes,3,opt,name=Message,proto3" json:"Message,omitempty"`
+}
+
+func (x *HostCheck) Reset() {
+	*x = HostCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostCheck) ProtoMessage() {}
+
+func (x *HostCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostCheck.ProtoReflect.Descriptor instead.
+func (*HostCheck) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *HostCheck) GetName() string {
 	if x != nil {
-		return x.Source
+		return x.Name
 	}
 	return ""
 }
 
-func (x *LogStreamingArgs) GetLevel() string {
+func (x *HostCheck) GetResult() HostCheck_Status {
 	if x != nil {
-		return x.Level
+		return x.Result
 	}
-	return ""
+	return HostCheck_PASS
 }
 
-func (x *LogStreamingArgs) GetMsg() string {
+func (x *HostCheck) GetMessage() string {
 	if x != nil {
-		return x.Msg
+		return x.Message
 	}
 	return ""
 }
 
-type LogStreamingResp struct {
+type CheckHostResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	Checks []*HostCheck `protobuf:"bytes,1,rep,name=Checks,proto3" json:"Checks,omitempty"`
+	// worst result of the checks
+	Result HostCheck_Status `protobuf:"varint,2,opt,name=Result,proto3,enum=cedana.services.task.HostCheck_Status" json:"Result,omitempty"`
 }
 
-func (x *LogStreamingResp) Reset() {
-	*x = LogStreamingResp{}
+func (x *CheckHostResp) Reset() {
+	*x = CheckHostResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[12]
+		mi := &file_task_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LogStreamingResp) String() string {
+func (x *CheckHostResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogStreamingResp) ProtoMessage() {}
+func (*CheckHostResp) ProtoMessage() {}
 
-func (x *LogStreamingResp) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[12]
+func (x *CheckHostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,53 +1592,51 @@ func (x *LogStreamingResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogStreamingResp.ProtoReflect.Descriptor instead.
-func (*LogStreamingResp) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CheckHostResp.ProtoReflect.Descriptor instead.
+func (*CheckHostResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *LogStreamingResp) GetStatus() string {
+func (x *CheckHostResp) GetChecks() []*HostCheck {
 	if x != nil {
-		return x.Status
+		return x.Checks
 	}
-	return ""
+	return nil
 }
 
-type ProcessState struct {
+func (x *CheckHostResp) GetResult() HostCheck_Status {
+	if x != nil {
+		return x.Result
+	}
+	return HostCheck_PASS
+}
+
+// Checkpointability of a process tree, ahead of dumping it
+type AnalyzeArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PID              int32                             `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
-	Task             string                            `protobuf:"bytes,2,opt,name=Task,proto3" json:"Task,omitempty"`
-	ContainerRuntime ProcessState_ContainerRuntimeOpts `protobuf:"varint,3,opt,name=ContainerRuntime,proto3,enum=cedana.services.task.ProcessState_ContainerRuntimeOpts" json:"ContainerRuntime,omitempty"`
-	ContainerId      string                            `protobuf:"bytes,4,opt,name=ContainerId,proto3" json:"ContainerId,omitempty"`
-	StartedAt        string                            `protobuf:"bytes,5,opt,name=StartedAt,proto3" json:"StartedAt,omitempty"`
-	ProcessInfo      *ProcessInfo                      `protobuf:"bytes,6,opt,name=ProcessInfo,proto3" json:"ProcessInfo,omitempty"`
-	CheckpointPath   string                            `protobuf:"bytes,7,opt,name=CheckpointPath,proto3" json:"CheckpointPath,omitempty"`
-	CheckpointState  CheckpointState                   `protobuf:"varint,8,opt,name=CheckpointState,proto3,enum=cedana.services.task.CheckpointState" json:"CheckpointState,omitempty"`
-	Flag             FlagEnum                          `protobuf:"varint,9,opt,name=Flag,proto3,enum=cedana.services.task.FlagEnum" json:"Flag,omitempty"`
-	RemoteState      []*RemoteState                    `protobuf:"bytes,10,rep,name=RemoteState,proto3" json:"RemoteState,omitempty"`
-	GPUCheckpointed  bool                              `protobuf:"varint,11,opt,name=GPUCheckpointed,proto3" json:"GPUCheckpointed,omitempty"`
+	PID int32 `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
 }
 
-func (x *ProcessState) Reset() {
-	*x = ProcessState{}
+func (x *AnalyzeArgs) Reset() {
+	*x = AnalyzeArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[13]
+		mi := &file_task_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProcessState) String() string {
+func (x *AnalyzeArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessState) ProtoMessage() {}
+func (*AnalyzeArgs) ProtoMessage() {}
 
-func (x *ProcessState) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[13]
+func (x *AnalyzeArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1313,115 +1647,129 @@ func (x *ProcessState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessState.ProtoReflect.Descriptor instead.
-func (*ProcessState) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use AnalyzeArgs.ProtoReflect.Descriptor instead.
+func (*AnalyzeArgs) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ProcessState) GetPID() int32 {
+func (x *AnalyzeArgs) GetPID() int32 {
 	if x != nil {
 		return x.PID
 	}
 	return 0
 }
 
-func (x *ProcessState) GetTask() string {
-	if x != nil {
-		return x.Task
-	}
-	return ""
+type Finding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PID      int32         `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
+	Severity Finding_Level `protobuf:"varint,2,opt,name=Severity,proto3,enum=cedana.services.task.Finding_Level" json:"Severity,omitempty"`
+	// kind of resource, e.g. io_uring or deleted_file
+	Resource string `protobuf:"bytes,3,opt,name=Resource,proto3" json:"Resource,omitempty"`
+	// the fd, path or address in question
+	Detail string `protobuf:"bytes,4,opt,name=Detail,proto3" json:"Detail,omitempty"`
+	// the CRIU option or hook that deals with it
+	Fix string `protobuf:"bytes,5,opt,name=Fix,proto3" json:"Fix,omitempty"`
 }
 
-func (x *ProcessState) GetContainerRuntime() ProcessState_ContainerRuntimeOpts {
-	if x != nil {
-		return x.ContainerRuntime
+func (x *Finding) Reset() {
+	*x = Finding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ProcessState_CONTAINERD
 }
 
-func (x *ProcessState) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
-	}
-	return ""
+func (x *Finding) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ProcessState) GetStartedAt() string {
-	if x != nil {
-		return x.StartedAt
+func (*Finding) ProtoMessage() {}
+
+func (x *Finding) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ProcessState) GetProcessInfo() *ProcessInfo {
-	if x != nil {
-		return x.ProcessInfo
-	}
-	return nil
+// Deprecated: Use Finding.ProtoReflect.Descriptor instead.
+func (*Finding) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ProcessState) GetCheckpointPath() string {
+func (x *Finding) GetPID() int32 {
 	if x != nil {
-		return x.CheckpointPath
+		return x.PID
 	}
-	return ""
+	return 0
 }
 
-func (x *ProcessState) GetCheckpointState() CheckpointState {
+func (x *Finding) GetSeverity() Finding_Level {
 	if x != nil {
-		return x.CheckpointState
+		return x.Severity
 	}
-	return CheckpointState_CHECKPOINTED
+	return Finding_INFO
 }
 
-func (x *ProcessState) GetFlag() FlagEnum {
+func (x *Finding) GetResource() string {
 	if x != nil {
-		return x.Flag
+		return x.Resource
 	}
-	return FlagEnum_JOB_STARTUP_FAILED
+	return ""
 }
 
-func (x *ProcessState) GetRemoteState() []*RemoteState {
+func (x *Finding) GetDetail() string {
 	if x != nil {
-		return x.RemoteState
+		return x.Detail
 	}
-	return nil
+	return ""
 }
 
-func (x *ProcessState) GetGPUCheckpointed() bool {
+func (x *Finding) GetFix() string {
 	if x != nil {
-		return x.GPUCheckpointed
+		return x.Fix
 	}
-	return false
+	return ""
 }
 
-type RemoteState struct {
+type AnalyzeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CheckpointID string `protobuf:"bytes,1,opt,name=CheckpointID,proto3" json:"CheckpointID,omitempty"`
-	UploadID     string `protobuf:"bytes,2,opt,name=UploadID,proto3" json:"UploadID,omitempty"`
-	Timestamp    int64  `protobuf:"varint,3,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	// the analyzed tree, the process itself first
+	PIDs     []int32    `protobuf:"varint,1,rep,packed,name=PIDs,proto3" json:"PIDs,omitempty"`
+	Findings []*Finding `protobuf:"bytes,2,rep,name=Findings,proto3" json:"Findings,omitempty"`
+	// no blockers found
+	Checkpointable bool `protobuf:"varint,3,opt,name=Checkpointable,proto3" json:"Checkpointable,omitempty"`
 }
 
-func (x *RemoteState) Reset() {
-	*x = RemoteState{}
+func (x *AnalyzeResp) Reset() {
+	*x = AnalyzeResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[14]
+		mi := &file_task_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoteState) String() string {
+func (x *AnalyzeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoteState) ProtoMessage() {}
+func (*AnalyzeResp) ProtoMessage() {}
 
-func (x *RemoteState) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[14]
+func (x *AnalyzeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1432,62 +1780,69 @@ func (x *RemoteState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoteState.ProtoReflect.Descriptor instead.
-func (*RemoteState) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AnalyzeResp.ProtoReflect.Descriptor instead.
+func (*AnalyzeResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *RemoteState) GetCheckpointID() string {
+func (x *AnalyzeResp) GetPIDs() []int32 {
 	if x != nil {
-		return x.CheckpointID
+		return x.PIDs
 	}
-	return ""
+	return nil
 }
 
-func (x *RemoteState) GetUploadID() string {
+func (x *AnalyzeResp) GetFindings() []*Finding {
 	if x != nil {
-		return x.UploadID
+		return x.Findings
 	}
-	return ""
+	return nil
 }
 
-func (x *RemoteState) GetTimestamp() int64 {
+func (x *AnalyzeResp) GetCheckpointable() bool {
 	if x != nil {
-		return x.Timestamp
+		return x.Checkpointable
 	}
-	return 0
+	return false
 }
 
-type ClientInfo struct {
+// Jobs and runc containers checkpointed together, as of the same moment
+type GroupMember struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Hostname        string `protobuf:"bytes,2,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
-	Platform        string `protobuf:"bytes,3,opt,name=Platform,proto3" json:"Platform,omitempty"`
-	OS              string `protobuf:"bytes,4,opt,name=OS,proto3" json:"OS,omitempty"`
-	Uptime          uint64 `protobuf:"varint,5,opt,name=Uptime,proto3" json:"Uptime,omitempty"`
-	RemainingMemory uint64 `protobuf:"varint,6,opt,name=RemainingMemory,proto3" json:"RemainingMemory,omitempty"`
-}
-
-func (x *ClientInfo) Reset() {
-	*x = ClientInfo{}
+	// either a job, or a runc container
+	JobID       string `protobuf:"bytes,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	ContainerID string `protobuf:"bytes,2,opt,name=ContainerID,proto3" json:"ContainerID,omitempty"`
+	Root        string `protobuf:"bytes,3,opt,name=Root,proto3" json:"Root,omitempty"`
+	// bundle to restore the container from, from its runc state if not set
+	Bundle         string `protobuf:"bytes,4,opt,name=Bundle,proto3" json:"Bundle,omitempty"`
+	PID            int32  `protobuf:"varint,5,opt,name=PID,proto3" json:"PID,omitempty"`
+	CheckpointPath string `protobuf:"bytes,6,opt,name=CheckpointPath,proto3" json:"CheckpointPath,omitempty"`
+	// processes of a job already stopped before the group was frozen, or a
+	// container already frozen, which are left that way when the group resumes
+	Stopped []int32 `protobuf:"varint,7,rep,packed,name=Stopped,proto3" json:"Stopped,omitempty"`
+	Frozen  bool    `protobuf:"varint,8,opt,name=Frozen,proto3" json:"Frozen,omitempty"`
+}
+
+func (x *GroupMember) Reset() {
+	*x = GroupMember{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[15]
+		mi := &file_task_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClientInfo) String() string {
+func (x *GroupMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientInfo) ProtoMessage() {}
+func (*GroupMember) ProtoMessage() {}
 
-func (x *ClientInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[15]
+func (x *GroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1498,85 +1853,97 @@ func (x *ClientInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientInfo.ProtoReflect.Descriptor instead.
-func (*ClientInfo) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GroupMember.ProtoReflect.Descriptor instead.
+func (*GroupMember) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ClientInfo) GetId() string {
+func (x *GroupMember) GetJobID() string {
 	if x != nil {
-		return x.Id
+		return x.JobID
 	}
 	return ""
 }
 
-func (x *ClientInfo) GetHostname() string {
+func (x *GroupMember) GetContainerID() string {
 	if x != nil {
-		return x.Hostname
+		return x.ContainerID
 	}
 	return ""
 }
 
-func (x *ClientInfo) GetPlatform() string {
+func (x *GroupMember) GetRoot() string {
 	if x != nil {
-		return x.Platform
+		return x.Root
 	}
 	return ""
 }
 
-func (x *ClientInfo) GetOS() string {
+func (x *GroupMember) GetBundle() string {
 	if x != nil {
-		return x.OS
+		return x.Bundle
 	}
 	return ""
 }
 
-func (x *ClientInfo) GetUptime() uint64 {
+func (x *GroupMember) GetPID() int32 {
 	if x != nil {
-		return x.Uptime
+		return x.PID
 	}
 	return 0
 }
 
-func (x *ClientInfo) GetRemainingMemory() uint64 {
+func (x *GroupMember) GetCheckpointPath() string {
 	if x != nil {
-		return x.RemainingMemory
+		return x.CheckpointPath
 	}
-	return 0
+	return ""
 }
 
-type ProcessInfo struct {
+func (x *GroupMember) GetStopped() []int32 {
+	if x != nil {
+		return x.Stopped
+	}
+	return nil
+}
+
+func (x *GroupMember) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+type GroupDumpArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PID                     int32             `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
-	AttachedToHardwareAccel bool              `protobuf:"varint,2,opt,name=AttachedToHardwareAccel,proto3" json:"AttachedToHardwareAccel,omitempty"`
-	OpenFds                 []*OpenFilesStat  `protobuf:"bytes,3,rep,name=OpenFds,proto3" json:"OpenFds,omitempty"`
-	WorkingDir              string            `protobuf:"bytes,4,opt,name=WorkingDir,proto3" json:"WorkingDir,omitempty"`
-	OpenConnections         []*ConnectionStat `protobuf:"bytes,5,rep,name=OpenConnections,proto3" json:"OpenConnections,omitempty"`
-	MemoryPercent           float32           `protobuf:"fixed32,6,opt,name=MemoryPercent,proto3" json:"MemoryPercent,omitempty"`
-	IsRunning               bool              `protobuf:"varint,7,opt,name=IsRunning,proto3" json:"IsRunning,omitempty"`
-	Status                  string            `protobuf:"bytes,8,opt,name=Status,proto3" json:"Status,omitempty"`
+	JobIDs []string `protobuf:"bytes,1,rep,name=JobIDs,proto3" json:"JobIDs,omitempty"`
+	// ContainerID and Root of each container, Bundle optionally
+	Containers []*GroupMember `protobuf:"bytes,2,rep,name=Containers,proto3" json:"Containers,omitempty"`
+	Dir        string         `protobuf:"bytes,3,opt,name=Dir,proto3" json:"Dir,omitempty"`
+	// resume the members after the dump, rather than killing them
+	LeaveRunning bool `protobuf:"varint,4,opt,name=LeaveRunning,proto3" json:"LeaveRunning,omitempty"`
 }
 
-func (x *ProcessInfo) Reset() {
-	*x = ProcessInfo{}
+func (x *GroupDumpArgs) Reset() {
+	*x = GroupDumpArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[16]
+		mi := &file_task_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProcessInfo) String() string {
+func (x *GroupDumpArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessInfo) ProtoMessage() {}
+func (*GroupDumpArgs) ProtoMessage() {}
 
-func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[16]
+func (x *GroupDumpArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1587,95 +1954,65 @@ func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessInfo.ProtoReflect.Descriptor instead.
-func (*ProcessInfo) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GroupDumpArgs.ProtoReflect.Descriptor instead.
+func (*GroupDumpArgs) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ProcessInfo) GetPID() int32 {
+func (x *GroupDumpArgs) GetJobIDs() []string {
 	if x != nil {
-		return x.PID
+		return x.JobIDs
 	}
-	return 0
+	return nil
 }
 
-func (x *ProcessInfo) GetAttachedToHardwareAccel() bool {
+func (x *GroupDumpArgs) GetContainers() []*GroupMember {
 	if x != nil {
-		return x.AttachedToHardwareAccel
-	}
-	return false
-}
-
-func (x *ProcessInfo) GetOpenFds() []*OpenFilesStat {
-	if x != nil {
-		return x.OpenFds
+		return x.Containers
 	}
 	return nil
 }
 
-func (x *ProcessInfo) GetWorkingDir() string {
+func (x *GroupDumpArgs) GetDir() string {
 	if x != nil {
-		return x.WorkingDir
+		return x.Dir
 	}
 	return ""
 }
 
-func (x *ProcessInfo) GetOpenConnections() []*ConnectionStat {
-	if x != nil {
-		return x.OpenConnections
-	}
-	return nil
-}
-
-func (x *ProcessInfo) GetMemoryPercent() float32 {
-	if x != nil {
-		return x.MemoryPercent
-	}
-	return 0
-}
-
-func (x *ProcessInfo) GetIsRunning() bool {
+func (x *GroupDumpArgs) GetLeaveRunning() bool {
 	if x != nil {
-		return x.IsRunning
+		return x.LeaveRunning
 	}
 	return false
 }
 
-func (x *ProcessInfo) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-type OpenFilesStat struct {
+type GroupDumpResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path   string                   `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
-	Fd     uint64                   `protobuf:"varint,2,opt,name=Fd,proto3" json:"Fd,omitempty"`
-	Mode   string                   `protobuf:"bytes,3,opt,name=Mode,proto3" json:"Mode,omitempty"`
-	Stream OpenFilesStat_StreamType `protobuf:"varint,5,opt,name=Stream,proto3,enum=cedana.services.task.OpenFilesStat_StreamType" json:"Stream,omitempty"`
+	GroupID string         `protobuf:"bytes,1,opt,name=GroupID,proto3" json:"GroupID,omitempty"`
+	Members []*GroupMember `protobuf:"bytes,2,rep,name=Members,proto3" json:"Members,omitempty"`
 }
 
-func (x *OpenFilesStat) Reset() {
-	*x = OpenFilesStat{}
+func (x *GroupDumpResp) Reset() {
+	*x = GroupDumpResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[17]
+		mi := &file_task_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenFilesStat) String() string {
+func (x *GroupDumpResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenFilesStat) ProtoMessage() {}
+func (*GroupDumpResp) ProtoMessage() {}
 
-func (x *OpenFilesStat) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[17]
+func (x *GroupDumpResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1686,71 +2023,50 @@ func (x *OpenFilesStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenFilesStat.ProtoReflect.Descriptor instead.
-func (*OpenFilesStat) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *OpenFilesStat) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *OpenFilesStat) GetFd() uint64 {
-	if x != nil {
-		return x.Fd
-	}
-	return 0
+// Deprecated: Use GroupDumpResp.ProtoReflect.Descriptor instead.
+func (*GroupDumpResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *OpenFilesStat) GetMode() string {
+func (x *GroupDumpResp) GetGroupID() string {
 	if x != nil {
-		return x.Mode
+		return x.GroupID
 	}
 	return ""
 }
 
-func (x *OpenFilesStat) GetStream() OpenFilesStat_StreamType {
+func (x *GroupDumpResp) GetMembers() []*GroupMember {
 	if x != nil {
-		return x.Stream
+		return x.Members
 	}
-	return OpenFilesStat_STDIN
+	return nil
 }
 
-type ConnectionStat struct {
+type GroupRestoreArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fd     uint32  `protobuf:"varint,1,opt,name=Fd,proto3" json:"Fd,omitempty"`
-	Family uint32  `protobuf:"varint,2,opt,name=Family,proto3" json:"Family,omitempty"`
-	Type   uint32  `protobuf:"varint,3,opt,name=Type,proto3" json:"Type,omitempty"`
-	Laddr  *Addr   `protobuf:"bytes,4,opt,name=Laddr,proto3" json:"Laddr,omitempty"`
-	Raddr  *Addr   `protobuf:"bytes,5,opt,name=Raddr,proto3" json:"Raddr,omitempty"`
-	Status string  `protobuf:"bytes,6,opt,name=Status,proto3" json:"Status,omitempty"`
-	Uids   []int32 `protobuf:"varint,7,rep,packed,name=Uids,proto3" json:"Uids,omitempty"`
-	PID    int32   `protobuf:"varint,8,opt,name=PID,proto3" json:"PID,omitempty"`
+	GroupID string `protobuf:"bytes,1,opt,name=GroupID,proto3" json:"GroupID,omitempty"`
 }
 
-func (x *ConnectionStat) Reset() {
-	*x = ConnectionStat{}
+func (x *GroupRestoreArgs) Reset() {
+	*x = GroupRestoreArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[18]
+		mi := &file_task_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConnectionStat) String() string {
+func (x *GroupRestoreArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectionStat) ProtoMessage() {}
+func (*GroupRestoreArgs) ProtoMessage() {}
 
-func (x *ConnectionStat) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[18]
+func (x *GroupRestoreArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1761,93 +2077,92 @@ func (x *ConnectionStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectionStat.ProtoReflect.Descriptor instead.
-func (*ConnectionStat) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GroupRestoreArgs.ProtoReflect.Descriptor instead.
+func (*GroupRestoreArgs) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ConnectionStat) GetFd() uint32 {
+func (x *GroupRestoreArgs) GetGroupID() string {
 	if x != nil {
-		return x.Fd
+		return x.GroupID
 	}
-	return 0
+	return ""
 }
 
-func (x *ConnectionStat) GetFamily() uint32 {
-	if x != nil {
-		return x.Family
-	}
-	return 0
-}
+type GroupRestoreResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ConnectionStat) GetType() uint32 {
-	if x != nil {
-		return x.Type
-	}
-	return 0
+	// PID is the restored pid of each member
+	Members []*GroupMember `protobuf:"bytes,1,rep,name=Members,proto3" json:"Members,omitempty"`
 }
 
-func (x *ConnectionStat) GetLaddr() *Addr {
-	if x != nil {
-		return x.Laddr
+func (x *GroupRestoreResp) Reset() {
+	*x = GroupRestoreResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ConnectionStat) GetRaddr() *Addr {
-	if x != nil {
-		return x.Raddr
-	}
-	return nil
+func (x *GroupRestoreResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ConnectionStat) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (*GroupRestoreResp) ProtoMessage() {}
+
+func (x *GroupRestoreResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ConnectionStat) GetUids() []int32 {
-	if x != nil {
-		return x.Uids
-	}
-	return nil
+// Deprecated: Use GroupRestoreResp.ProtoReflect.Descriptor instead.
+func (*GroupRestoreResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ConnectionStat) GetPID() int32 {
+func (x *GroupRestoreResp) GetMembers() []*GroupMember {
 	if x != nil {
-		return x.PID
+		return x.Members
 	}
-	return 0
+	return nil
 }
 
-type Addr struct {
+type ListArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IP   string `protobuf:"bytes,1,opt,name=IP,proto3" json:"IP,omitempty"`
-	Port uint32 `protobuf:"varint,2,opt,name=Port,proto3" json:"Port,omitempty"`
+	Root      string `protobuf:"bytes,1,opt,name=Root,proto3" json:"Root,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=Namespace,proto3" json:"Namespace,omitempty"`
 }
 
-func (x *Addr) Reset() {
-	*x = Addr{}
+func (x *ListArgs) Reset() {
+	*x = ListArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[19]
+		mi := &file_task_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Addr) String() string {
+func (x *ListArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Addr) ProtoMessage() {}
+func (*ListArgs) ProtoMessage() {}
 
-func (x *Addr) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[19]
+func (x *ListArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1858,50 +2173,50 @@ func (x *Addr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Addr.ProtoReflect.Descriptor instead.
-func (*Addr) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListArgs.ProtoReflect.Descriptor instead.
+func (*ListArgs) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Addr) GetIP() string {
+func (x *ListArgs) GetRoot() string {
 	if x != nil {
-		return x.IP
+		return x.Root
 	}
 	return ""
 }
 
-func (x *Addr) GetPort() uint32 {
+func (x *ListArgs) GetNamespace() string {
 	if x != nil {
-		return x.Port
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-type ClientStateStreamingResp struct {
+type ListResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	Containers []*Container `protobuf:"bytes,1,rep,name=containers,proto3" json:"containers,omitempty"`
 }
 
-func (x *ClientStateStreamingResp) Reset() {
-	*x = ClientStateStreamingResp{}
+func (x *ListResp) Reset() {
+	*x = ListResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[20]
+		mi := &file_task_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClientStateStreamingResp) String() string {
+func (x *ListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientStateStreamingResp) ProtoMessage() {}
+func (*ListResp) ProtoMessage() {}
 
-func (x *ClientStateStreamingResp) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[20]
+func (x *ListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1912,44 +2227,48 @@ func (x *ClientStateStreamingResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientStateStreamingResp.ProtoReflect.Descriptor instead.
-func (*ClientStateStreamingResp) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListResp.ProtoReflect.Descriptor instead.
+func (*ListResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ClientStateStreamingResp) GetStatus() string {
+func (x *ListResp) GetContainers() []*Container {
 	if x != nil {
-		return x.Status
+		return x.Containers
 	}
-	return ""
+	return nil
 }
 
-type MetaStateStreamingArgs struct {
+type Container struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Event            *ProviderEvent    `protobuf:"bytes,1,opt,name=Event,proto3" json:"Event,omitempty"`
-	CheckpointReason *CheckpointReason `protobuf:"bytes,2,opt,name=CheckpointReason,proto3" json:"CheckpointReason,omitempty"`
+	ContainerName    string `protobuf:"bytes,1,opt,name=ContainerName,proto3" json:"ContainerName,omitempty"`
+	ImageName        string `protobuf:"bytes,2,opt,name=ImageName,proto3" json:"ImageName,omitempty"`
+	SandboxId        string `protobuf:"bytes,3,opt,name=SandboxId,proto3" json:"SandboxId,omitempty"`
+	SandboxName      string `protobuf:"bytes,4,opt,name=SandboxName,proto3" json:"SandboxName,omitempty"`
+	SandboxNamespace string `protobuf:"bytes,5,opt,name=SandboxNamespace,proto3" json:"SandboxNamespace,omitempty"`
+	SandboxUid       string `protobuf:"bytes,6,opt,name=SandboxUid,proto3" json:"SandboxUid,omitempty"`
 }
 
-func (x *MetaStateStreamingArgs) Reset() {
-	*x = MetaStateStreamingArgs{}
+func (x *Container) Reset() {
+	*x = Container{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[21]
+		mi := &file_task_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MetaStateStreamingArgs) String() string {
+func (x *Container) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetaStateStreamingArgs) ProtoMessage() {}
+func (*Container) ProtoMessage() {}
 
-func (x *MetaStateStreamingArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[21]
+func (x *Container) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1960,50 +2279,78 @@ func (x *MetaStateStreamingArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetaStateStreamingArgs.ProtoReflect.Descriptor instead.
-func (*MetaStateStreamingArgs) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Container.ProtoReflect.Descriptor instead.
+func (*Container) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MetaStateStreamingArgs) GetEvent() *ProviderEvent {
+func (x *Container) GetContainerName() string {
 	if x != nil {
-		return x.Event
+		return x.ContainerName
 	}
-	return nil
+	return ""
 }
 
-func (x *MetaStateStreamingArgs) GetCheckpointReason() *CheckpointReason {
+func (x *Container) GetImageName() string {
 	if x != nil {
-		return x.CheckpointReason
+		return x.ImageName
 	}
-	return nil
+	return ""
 }
 
-type CheckpointReason struct {
+func (x *Container) GetSandboxId() string {
+	if x != nil {
+		return x.SandboxId
+	}
+	return ""
+}
+
+func (x *Container) GetSandboxName() string {
+	if x != nil {
+		return x.SandboxName
+	}
+	return ""
+}
+
+func (x *Container) GetSandboxNamespace() string {
+	if x != nil {
+		return x.SandboxNamespace
+	}
+	return ""
+}
+
+func (x *Container) GetSandboxUid() string {
+	if x != nil {
+		return x.SandboxUid
+	}
+	return ""
+}
+
+type Annotation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reason CheckpointReason_CheckpointReasonEnum `protobuf:"varint,1,opt,name=Reason,proto3,enum=cedana.services.task.CheckpointReason_CheckpointReasonEnum" json:"Reason,omitempty"`
+	Annotations map[string]string `protobuf:"bytes,1,rep,name=Annotations,proto3" json:"Annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *CheckpointReason) Reset() {
-	*x = CheckpointReason{}
+func (x *Annotation) Reset() {
+	*x = Annotation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[22]
+		mi := &file_task_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckpointReason) String() string {
+func (x *Annotation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckpointReason) ProtoMessage() {}
+func (*Annotation) ProtoMessage() {}
 
-func (x *CheckpointReason) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[22]
+func (x *Annotation) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2014,46 +2361,43 @@ func (x *CheckpointReason) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckpointReason.ProtoReflect.Descriptor instead.
-func (*CheckpointReason) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CheckpointReason) GetReason() CheckpointReason_CheckpointReasonEnum {
+func (x *Annotation) GetAnnotations() map[string]string {
 	if x != nil {
-		return x.Reason
+		return x.Annotations
 	}
-	return CheckpointReason_INSTANCE_TERMINATION
+	return nil
 }
 
-type ProviderEvent struct {
+type External struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceID           string `protobuf:"bytes,1,opt,name=InstanceID,proto3" json:"InstanceID,omitempty"`
-	FaultCode            string `protobuf:"bytes,2,opt,name=FaultCode,proto3" json:"FaultCode,omitempty"`
-	MarkedForTermination bool   `protobuf:"varint,3,opt,name=MarkedForTermination,proto3" json:"MarkedForTermination,omitempty"`
-	TerminationTime      int64  `protobuf:"varint,4,opt,name=TerminationTime,proto3" json:"TerminationTime,omitempty"`
+	String_ []string `protobuf:"bytes,1,rep,name=String,proto3" json:"String,omitempty"`
 }
 
-func (x *ProviderEvent) Reset() {
-	*x = ProviderEvent{}
+func (x *External) Reset() {
+	*x = External{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[23]
+		mi := &file_task_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProviderEvent) String() string {
+func (x *External) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderEvent) ProtoMessage() {}
+func (*External) ProtoMessage() {}
 
-func (x *ProviderEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[23]
+func (x *External) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2064,64 +2408,62 @@ func (x *ProviderEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderEvent.ProtoReflect.Descriptor instead.
-func (*ProviderEvent) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *ProviderEvent) GetInstanceID() string {
-	if x != nil {
-		return x.InstanceID
-	}
-	return ""
-}
-
-func (x *ProviderEvent) GetFaultCode() string {
-	if x != nil {
-		return x.FaultCode
-	}
-	return ""
-}
-
-func (x *ProviderEvent) GetMarkedForTermination() bool {
-	if x != nil {
-		return x.MarkedForTermination
-	}
-	return false
+// Deprecated: Use External.ProtoReflect.Descriptor instead.
+func (*External) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ProviderEvent) GetTerminationTime() int64 {
+func (x *External) GetString_() []string {
 	if x != nil {
-		return x.TerminationTime
+		return x.String_
 	}
-	return 0
+	return nil
 }
 
-type MetaStateStreamingResp struct {
+type DumpArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	PID   int32             `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
+	Dir   string            `protobuf:"bytes,2,opt,name=Dir,proto3" json:"Dir,omitempty"`
+	Type  DumpArgs_DumpType `protobuf:"varint,3,opt,name=Type,proto3,enum=cedana.services.task.DumpArgs_DumpType" json:"Type,omitempty"`
+	JobID string            `protobuf:"bytes,4,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	// number of iterative pre-dump passes to run before the final dump
+	PreDumpIterations int32 `protobuf:"varint,5,opt,name=PreDumpIterations,proto3" json:"PreDumpIterations,omitempty"`
+	// stop pre-dumping once a pass writes fewer than this many pages
+	PreDumpThreshold uint64 `protobuf:"varint,6,opt,name=PreDumpThreshold,proto3" json:"PreDumpThreshold,omitempty"`
+	// compression of the checkpoint archive: none, gzip, lz4 or zstd. Defaults to
+	// the client config
+	Compression      string `protobuf:"bytes,7,opt,name=Compression,proto3" json:"Compression,omitempty"`
+	CompressionLevel int32  `protobuf:"varint,8,opt,name=CompressionLevel,proto3" json:"CompressionLevel,omitempty"`
+	// why the checkpoint is taken, unset for checkpoints on demand
+	Reason *CheckpointReason `protobuf:"bytes,9,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	// what of the process gets checkpointed, defaults to the scope of the job
+	Scope JobScope `protobuf:"varint,10,opt,name=Scope,proto3,enum=cedana.services.task.JobScope" json:"Scope,omitempty"`
+	// files open by the job to copy into the checkpoint, for restores on hosts
+	// that don't have them
+	Files    FileCapture      `protobuf:"varint,11,opt,name=Files,proto3,enum=cedana.services.task.FileCapture" json:"Files,omitempty"`
+	CriuOpts *CriuProcessOpts `protobuf:"bytes,12,opt,name=CriuOpts,proto3" json:"CriuOpts,omitempty"`
 }
 
-func (x *MetaStateStreamingResp) Reset() {
-	*x = MetaStateStreamingResp{}
+func (x *DumpArgs) Reset() {
+	*x = DumpArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[24]
+		mi := &file_task_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MetaStateStreamingResp) String() string {
+func (x *DumpArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetaStateStreamingResp) ProtoMessage() {}
+func (*DumpArgs) ProtoMessage() {}
 
-func (x *MetaStateStreamingResp) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[24]
+func (x *DumpArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2132,90 +2474,124 @@ func (x *MetaStateStreamingResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetaStateStreamingResp.ProtoReflect.Descriptor instead.
-func (*MetaStateStreamingResp) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DumpArgs.ProtoReflect.Descriptor instead.
+func (*DumpArgs) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *MetaStateStreamingResp) GetStatus() string {
+func (x *DumpArgs) GetPID() int32 {
 	if x != nil {
-		return x.Status
+		return x.PID
+	}
+	return 0
+}
+
+func (x *DumpArgs) GetDir() string {
+	if x != nil {
+		return x.Dir
 	}
 	return ""
 }
 
-type PausePidArgs struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *DumpArgs) GetType() DumpArgs_DumpType {
+	if x != nil {
+		return x.Type
+	}
+	return DumpArgs_LOCAL
+}
 
-	BundlePath string `protobuf:"bytes,1,opt,name=BundlePath,proto3" json:"BundlePath,omitempty"`
+func (x *DumpArgs) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
 }
 
-func (x *PausePidArgs) Reset() {
-	*x = PausePidArgs{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *DumpArgs) GetPreDumpIterations() int32 {
+	if x != nil {
+		return x.PreDumpIterations
 	}
+	return 0
 }
 
-func (x *PausePidArgs) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DumpArgs) GetPreDumpThreshold() uint64 {
+	if x != nil {
+		return x.PreDumpThreshold
+	}
+	return 0
 }
 
-func (*PausePidArgs) ProtoMessage() {}
+func (x *DumpArgs) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
 
-func (x *PausePidArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *DumpArgs) GetCompressionLevel() int32 {
+	if x != nil {
+		return x.CompressionLevel
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use PausePidArgs.ProtoReflect.Descriptor instead.
-func (*PausePidArgs) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{25}
+func (x *DumpArgs) GetReason() *CheckpointReason {
+	if x != nil {
+		return x.Reason
+	}
+	return nil
 }
 
-func (x *PausePidArgs) GetBundlePath() string {
+func (x *DumpArgs) GetScope() JobScope {
 	if x != nil {
-		return x.BundlePath
+		return x.Scope
 	}
-	return ""
+	return JobScope_UNSPECIFIED
 }
 
-type PausePidResp struct {
+func (x *DumpArgs) GetFiles() FileCapture {
+	if x != nil {
+		return x.Files
+	}
+	return FileCapture_NO_FILES
+}
+
+func (x *DumpArgs) GetCriuOpts() *CriuProcessOpts {
+	if x != nil {
+		return x.CriuOpts
+	}
+	return nil
+}
+
+type DumpResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PausePid int64 `protobuf:"varint,1,opt,name=PausePid,proto3" json:"PausePid,omitempty"`
+	Message      string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	CheckpointID string `protobuf:"bytes,2,opt,name=CheckpointID,proto3" json:"CheckpointID,omitempty"`
+	UploadID     string `protobuf:"bytes,3,opt,name=UploadID,proto3" json:"UploadID,omitempty"`
+	// sha256 of the uploaded checkpoint, for restores elsewhere to check
+	Checksum string `protobuf:"bytes,4,opt,name=Checksum,proto3" json:"Checksum,omitempty"`
 }
 
-func (x *PausePidResp) Reset() {
-	*x = PausePidResp{}
+func (x *DumpResp) Reset() {
+	*x = DumpResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[26]
+		mi := &file_task_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PausePidResp) String() string {
+func (x *DumpResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PausePidResp) ProtoMessage() {}
+func (*DumpResp) ProtoMessage() {}
 
-func (x *PausePidResp) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[26]
+func (x *DumpResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2226,99 +2602,86 @@ func (x *PausePidResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PausePidResp.ProtoReflect.Descriptor instead.
-func (*PausePidResp) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use DumpResp.ProtoReflect.Descriptor instead.
+func (*DumpResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *PausePidResp) GetPausePid() int64 {
+func (x *DumpResp) GetMessage() string {
 	if x != nil {
-		return x.PausePid
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type CtrByNameArgs struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ContainerName string `protobuf:"bytes,1,opt,name=ContainerName,proto3" json:"ContainerName,omitempty"`
-	Root          string `protobuf:"bytes,2,opt,name=Root,proto3" json:"Root,omitempty"`
-}
-
-func (x *CtrByNameArgs) Reset() {
-	*x = CtrByNameArgs{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CtrByNameArgs) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CtrByNameArgs) ProtoMessage() {}
-
-func (x *CtrByNameArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *DumpResp) GetCheckpointID() string {
+	if x != nil {
+		return x.CheckpointID
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CtrByNameArgs.ProtoReflect.Descriptor instead.
-func (*CtrByNameArgs) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{27}
+	return ""
 }
 
-func (x *CtrByNameArgs) GetContainerName() string {
+func (x *DumpResp) GetUploadID() string {
 	if x != nil {
-		return x.ContainerName
+		return x.UploadID
 	}
 	return ""
 }
 
-func (x *CtrByNameArgs) GetRoot() string {
+func (x *DumpResp) GetChecksum() string {
 	if x != nil {
-		return x.Root
+		return x.Checksum
 	}
 	return ""
 }
 
-type CtrByNameResp struct {
+type RestoreArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RuncContainerName string `protobuf:"bytes,1,opt,name=RuncContainerName,proto3" json:"RuncContainerName,omitempty"`
-	RuncBundlePath    string `protobuf:"bytes,2,opt,name=RuncBundlePath,proto3" json:"RuncBundlePath,omitempty"`
+	Type           RestoreArgs_RestoreType `protobuf:"varint,1,opt,name=Type,proto3,enum=cedana.services.task.RestoreArgs_RestoreType" json:"Type,omitempty"`
+	CheckpointId   string                  `protobuf:"bytes,2,opt,name=CheckpointId,proto3" json:"CheckpointId,omitempty"`
+	CheckpointPath string                  `protobuf:"bytes,3,opt,name=CheckpointPath,proto3" json:"CheckpointPath,omitempty"`
+	JobID          string                  `protobuf:"bytes,4,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	CriuOpts       *CriuProcessOpts        `protobuf:"bytes,5,opt,name=CriuOpts,proto3" json:"CriuOpts,omitempty"`
+	// where the restored process writes its output, a log file under /var/log
+	// when neither is set. Other streams are only redirected when set.
+	Stdin  *StdioTarget `protobuf:"bytes,6,opt,name=Stdin,proto3" json:"Stdin,omitempty"`
+	Stdout *StdioTarget `protobuf:"bytes,7,opt,name=Stdout,proto3" json:"Stdout,omitempty"`
+	Stderr *StdioTarget `protobuf:"bytes,8,opt,name=Stderr,proto3" json:"Stderr,omitempty"`
+	// restore a shell job onto a new pty held by the daemon, to attach to
+	Pty bool `protobuf:"varint,9,opt,name=Pty,proto3" json:"Pty,omitempty"`
+	// restore into a new pid namespace, so the job's pids can't clash with the
+	// host's. Checkpoints of jobs in their own pid namespace always get one.
+	PidNamespace bool `protobuf:"varint,10,opt,name=PidNamespace,proto3" json:"PidNamespace,omitempty"`
+	// limits of the job's cgroup, over the ones it was checkpointed with
+	Limits *ResourceLimits `protobuf:"bytes,11,opt,name=Limits,proto3" json:"Limits,omitempty"`
+	// put back the files captured into a checkpoint that isn't signed by a
+	// trusted key, overwriting them on the host
+	RestoreFiles bool `protobuf:"varint,12,opt,name=RestoreFiles,proto3" json:"RestoreFiles,omitempty"`
+	// sha256 the download of a remote checkpoint must have, the one it was
+	// uploaded with from this host when empty
+	Checksum string `protobuf:"bytes,13,opt,name=Checksum,proto3" json:"Checksum,omitempty"`
 }
 
-func (x *CtrByNameResp) Reset() {
-	*x = CtrByNameResp{}
+func (x *RestoreArgs) Reset() {
+	*x = RestoreArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[28]
+		mi := &file_task_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CtrByNameResp) String() string {
+func (x *RestoreArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CtrByNameResp) ProtoMessage() {}
+func (*RestoreArgs) ProtoMessage() {}
 
-func (x *CtrByNameResp) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[28]
+func (x *RestoreArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2329,82 +2692,118 @@ func (x *CtrByNameResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CtrByNameResp.ProtoReflect.Descriptor instead.
-func (*CtrByNameResp) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use RestoreArgs.ProtoReflect.Descriptor instead.
+func (*RestoreArgs) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CtrByNameResp) GetRuncContainerName() string {
+func (x *RestoreArgs) GetType() RestoreArgs_RestoreType {
 	if x != nil {
-		return x.RuncContainerName
+		return x.Type
+	}
+	return RestoreArgs_LOCAL
+}
+
+func (x *RestoreArgs) GetCheckpointId() string {
+	if x != nil {
+		return x.CheckpointId
 	}
 	return ""
 }
 
-func (x *CtrByNameResp) GetRuncBundlePath() string {
+func (x *RestoreArgs) GetCheckpointPath() string {
 	if x != nil {
-		return x.RuncBundlePath
+		return x.CheckpointPath
 	}
 	return ""
 }
 
-type RuncRoot struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *RestoreArgs) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
 
-	Root string `protobuf:"bytes,1,opt,name=Root,proto3" json:"Root,omitempty"`
+func (x *RestoreArgs) GetCriuOpts() *CriuProcessOpts {
+	if x != nil {
+		return x.CriuOpts
+	}
+	return nil
 }
 
-func (x *RuncRoot) Reset() {
-	*x = RuncRoot{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *RestoreArgs) GetStdin() *StdioTarget {
+	if x != nil {
+		return x.Stdin
 	}
+	return nil
 }
 
-func (x *RuncRoot) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RestoreArgs) GetStdout() *StdioTarget {
+	if x != nil {
+		return x.Stdout
+	}
+	return nil
 }
 
-func (*RuncRoot) ProtoMessage() {}
+func (x *RestoreArgs) GetStderr() *StdioTarget {
+	if x != nil {
+		return x.Stderr
+	}
+	return nil
+}
 
-func (x *RuncRoot) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *RestoreArgs) GetPty() bool {
+	if x != nil {
+		return x.Pty
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use RuncRoot.ProtoReflect.Descriptor instead.
-func (*RuncRoot) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{29}
+func (x *RestoreArgs) GetPidNamespace() bool {
+	if x != nil {
+		return x.PidNamespace
+	}
+	return false
 }
 
-func (x *RuncRoot) GetRoot() string {
+func (x *RestoreArgs) GetLimits() *ResourceLimits {
 	if x != nil {
-		return x.Root
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *RestoreArgs) GetRestoreFiles() bool {
+	if x != nil {
+		return x.RestoreFiles
+	}
+	return false
+}
+
+func (x *RestoreArgs) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
 	}
 	return ""
 }
 
-type RuncList struct {
+// a file, FIFO or unix socket a stream of a restored process is redirected to,
+// or from for stdin. FIFOs need a reader, or a writer for stdin, and sockets a
+// listener, before the restore.
+type StdioTarget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Containers []string `protobuf:"bytes,1,rep,name=Containers,proto3" json:"Containers,omitempty"`
+	Type StdioTarget_TargetType `protobuf:"varint,1,opt,name=Type,proto3,enum=cedana.services.task.StdioTarget_TargetType" json:"Type,omitempty"`
+	Path string                 `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
+	// output files are appended to instead of truncated
+	Append bool `protobuf:"varint,3,opt,name=Append,proto3" json:"Append,omitempty"`
 }
 
-func (x *RuncList) Reset() {
-	*x = RuncList{}
+func (x *StdioTarget) Reset() {
+	*x = StdioTarget{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2412,13 +2811,13 @@ func (x *RuncList) Reset() {
 	}
 }
 
-func (x *RuncList) String() string {
+func (x *StdioTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuncList) ProtoMessage() {}
+func (*StdioTarget) ProtoMessage() {}
 
-func (x *RuncList) ProtoReflect() protoreflect.Message {
+func (x *StdioTarget) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2430,29 +2829,48 @@ func (x *RuncList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuncList.ProtoReflect.Descriptor instead.
-func (*RuncList) Descriptor() ([]byte, []int) {
+// Deprecated: Use StdioTarget.ProtoReflect.Descriptor instead.
+func (*StdioTarget) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RuncList) GetContainers() []string {
+func (x *StdioTarget) GetType() StdioTarget_TargetType {
 	if x != nil {
-		return x.Containers
+		return x.Type
 	}
-	return nil
+	return StdioTarget_FILE
 }
 
-type ContainerDumpArgs struct {
+func (x *StdioTarget) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *StdioTarget) GetAppend() bool {
+	if x != nil {
+		return x.Append
+	}
+	return false
+}
+
+// The first message names the job, or the pid, to attach to. The ones after
+// carry input and terminal resizes.
+type AttachArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContainerId string `protobuf:"bytes,1,opt,name=ContainerId,proto3" json:"ContainerId,omitempty"`
-	Ref         string `protobuf:"bytes,2,opt,name=Ref,proto3" json:"Ref,omitempty"`
+	JobID string `protobuf:"bytes,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	PID   int32  `protobuf:"varint,2,opt,name=PID,proto3" json:"PID,omitempty"`
+	Input []byte `protobuf:"bytes,3,opt,name=Input,proto3" json:"Input,omitempty"`
+	Rows  uint32 `protobuf:"varint,4,opt,name=Rows,proto3" json:"Rows,omitempty"`
+	Cols  uint32 `protobuf:"varint,5,opt,name=Cols,proto3" json:"Cols,omitempty"`
 }
 
-func (x *ContainerDumpArgs) Reset() {
-	*x = ContainerDumpArgs{}
+func (x *AttachArgs) Reset() {
+	*x = AttachArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2460,13 +2878,13 @@ func (x *ContainerDumpArgs) Reset() {
 	}
 }
 
-func (x *ContainerDumpArgs) String() string {
+func (x *AttachArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerDumpArgs) ProtoMessage() {}
+func (*AttachArgs) ProtoMessage() {}
 
-func (x *ContainerDumpArgs) ProtoReflect() protoreflect.Message {
+func (x *AttachArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2478,36 +2896,58 @@ func (x *ContainerDumpArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerDumpArgs.ProtoReflect.Descriptor instead.
-func (*ContainerDumpArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttachArgs.ProtoReflect.Descriptor instead.
+func (*AttachArgs) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ContainerDumpArgs) GetContainerId() string {
+func (x *AttachArgs) GetJobID() string {
 	if x != nil {
-		return x.ContainerId
+		return x.JobID
 	}
 	return ""
 }
 
-func (x *ContainerDumpArgs) GetRef() string {
+func (x *AttachArgs) GetPID() int32 {
 	if x != nil {
-		return x.Ref
+		return x.PID
 	}
-	return ""
+	return 0
 }
 
-type ContainerDumpResp struct {
+func (x *AttachArgs) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *AttachArgs) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *AttachArgs) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+type AttachResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CheckpointPath string `protobuf:"bytes,1,opt,name=CheckpointPath,proto3" json:"CheckpointPath,omitempty"`
-	Message        string `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	Output []byte `protobuf:"bytes,1,opt,name=Output,proto3" json:"Output,omitempty"`
+	// set on the last message, once the process is gone
+	Exited bool `protobuf:"varint,2,opt,name=Exited,proto3" json:"Exited,omitempty"`
 }
 
-func (x *ContainerDumpResp) Reset() {
-	*x = ContainerDumpResp{}
+func (x *AttachResp) Reset() {
+	*x = AttachResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2515,13 +2955,13 @@ func (x *ContainerDumpResp) Reset() {
 	}
 }
 
-func (x *ContainerDumpResp) String() string {
+func (x *AttachResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerDumpResp) ProtoMessage() {}
+func (*AttachResp) ProtoMessage() {}
 
-func (x *ContainerDumpResp) ProtoReflect() protoreflect.Message {
+func (x *AttachResp) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2533,36 +2973,40 @@ func (x *ContainerDumpResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerDumpResp.ProtoReflect.Descriptor instead.
-func (*ContainerDumpResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttachResp.ProtoReflect.Descriptor instead.
+func (*AttachResp) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ContainerDumpResp) GetCheckpointPath() string {
+func (x *AttachResp) GetOutput() []byte {
 	if x != nil {
-		return x.CheckpointPath
+		return x.Output
 	}
-	return ""
+	return nil
 }
 
-func (x *ContainerDumpResp) GetMessage() string {
+func (x *AttachResp) GetExited() bool {
 	if x != nil {
-		return x.Message
+		return x.Exited
 	}
-	return ""
+	return false
 }
 
-type ContainerRestoreArgs struct {
+// cgroup v2 limits of a job. Unset limits are left as they are, zero lifts one.
+type ResourceLimits struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ImgPath     string `protobuf:"bytes,1,opt,name=ImgPath,proto3" json:"ImgPath,omitempty"`
-	ContainerId string `protobuf:"bytes,2,opt,name=ContainerId,proto3" json:"ContainerId,omitempty"`
+	// bytes
+	MemoryMax *int64 `protobuf:"varint,1,opt,name=MemoryMax,proto3,oneof" json:"MemoryMax,omitempty"`
+	// cpus worth of time, e.g. 1.5
+	CPUs    *float64 `protobuf:"fixed64,2,opt,name=CPUs,proto3,oneof" json:"CPUs,omitempty"`
+	PidsMax *int64   `protobuf:"varint,3,opt,name=PidsMax,proto3,oneof" json:"PidsMax,omitempty"`
 }
 
-func (x *ContainerRestoreArgs) Reset() {
-	*x = ContainerRestoreArgs{}
+func (x *ResourceLimits) Reset() {
+	*x = ResourceLimits{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2570,13 +3014,13 @@ func (x *ContainerRestoreArgs) Reset() {
 	}
 }
 
-func (x *ContainerRestoreArgs) String() string {
+func (x *ResourceLimits) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerRestoreArgs) ProtoMessage() {}
+func (*ResourceLimits) ProtoMessage() {}
 
-func (x *ContainerRestoreArgs) ProtoReflect() protoreflect.Message {
+func (x *ResourceLimits) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2588,35 +3032,43 @@ func (x *ContainerRestoreArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerRestoreArgs.ProtoReflect.Descriptor instead.
-func (*ContainerRestoreArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResourceLimits.ProtoReflect.Descriptor instead.
+func (*ResourceLimits) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ContainerRestoreArgs) GetImgPath() string {
-	if x != nil {
-		return x.ImgPath
+func (x *ResourceLimits) GetMemoryMax() int64 {
+	if x != nil && x.MemoryMax != nil {
+		return *x.MemoryMax
 	}
-	return ""
+	return 0
 }
 
-func (x *ContainerRestoreArgs) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
+func (x *ResourceLimits) GetCPUs() float64 {
+	if x != nil && x.CPUs != nil {
+		return *x.CPUs
 	}
-	return ""
+	return 0
 }
 
-type ContainerRestoreResp struct {
+func (x *ResourceLimits) GetPidsMax() int64 {
+	if x != nil && x.PidsMax != nil {
+		return *x.PidsMax
+	}
+	return 0
+}
+
+type UpdateJobArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	JobID  string          `protobuf:"bytes,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	Limits *ResourceLimits `protobuf:"bytes,2,opt,name=Limits,proto3" json:"Limits,omitempty"`
 }
 
-func (x *ContainerRestoreResp) Reset() {
-	*x = ContainerRestoreResp{}
+func (x *UpdateJobArgs) Reset() {
+	*x = UpdateJobArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2624,13 +3076,13 @@ func (x *ContainerRestoreResp) Reset() {
 	}
 }
 
-func (x *ContainerRestoreResp) String() string {
+func (x *UpdateJobArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerRestoreResp) ProtoMessage() {}
+func (*UpdateJobArgs) ProtoMessage() {}
 
-func (x *ContainerRestoreResp) ProtoReflect() protoreflect.Message {
+func (x *UpdateJobArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2642,33 +3094,36 @@ func (x *ContainerRestoreResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerRestoreResp.ProtoReflect.Descriptor instead.
-func (*ContainerRestoreResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateJobArgs.ProtoReflect.Descriptor instead.
+func (*UpdateJobArgs) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ContainerRestoreResp) GetMessage() string {
+func (x *UpdateJobArgs) GetJobID() string {
 	if x != nil {
-		return x.Message
+		return x.JobID
 	}
 	return ""
 }
 
-type RuncDumpArgs struct {
+func (x *UpdateJobArgs) GetLimits() *ResourceLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type UpdateJobResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Root           string                `protobuf:"bytes,1,opt,name=Root,proto3" json:"Root,omitempty"`
-	CheckpointPath string                `protobuf:"bytes,2,opt,name=CheckpointPath,proto3" json:"CheckpointPath,omitempty"`
-	ContainerId    string                `protobuf:"bytes,3,opt,name=ContainerId,proto3" json:"ContainerId,omitempty"`
-	CriuOpts       *CriuOpts             `protobuf:"bytes,4,opt,name=CriuOpts,proto3" json:"CriuOpts,omitempty"`
-	Type           RuncDumpArgs_DumpType `protobuf:"varint,5,opt,name=Type,proto3,enum=cedana.services.task.RuncDumpArgs_DumpType" json:"Type,omitempty"`
-	JobID          string                `protobuf:"bytes,6,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	// the limits the job is under now
+	Limits *ResourceLimits `protobuf:"bytes,1,opt,name=Limits,proto3" json:"Limits,omitempty"`
 }
 
-func (x *RuncDumpArgs) Reset() {
-	*x = RuncDumpArgs{}
+func (x *UpdateJobResp) Reset() {
+	*x = UpdateJobResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2676,13 +3131,13 @@ func (x *RuncDumpArgs) Reset() {
 	}
 }
 
-func (x *RuncDumpArgs) String() string {
+func (x *UpdateJobResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuncDumpArgs) ProtoMessage() {}
+func (*UpdateJobResp) ProtoMessage() {}
 
-func (x *RuncDumpArgs) ProtoReflect() protoreflect.Message {
+func (x *UpdateJobResp) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2694,64 +3149,35 @@ func (x *RuncDumpArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuncDumpArgs.ProtoReflect.Descriptor instead.
-func (*RuncDumpArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateJobResp.ProtoReflect.Descriptor instead.
+func (*UpdateJobResp) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *RuncDumpArgs) GetRoot() string {
-	if x != nil {
-		return x.Root
-	}
-	return ""
-}
-
-func (x *RuncDumpArgs) GetCheckpointPath() string {
-	if x != nil {
-		return x.CheckpointPath
-	}
-	return ""
-}
-
-func (x *RuncDumpArgs) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
-	}
-	return ""
-}
-
-func (x *RuncDumpArgs) GetCriuOpts() *CriuOpts {
+func (x *UpdateJobResp) GetLimits() *ResourceLimits {
 	if x != nil {
-		return x.CriuOpts
+		return x.Limits
 	}
 	return nil
 }
 
-func (x *RuncDumpArgs) GetType() RuncDumpArgs_DumpType {
-	if x != nil {
-		return x.Type
-	}
-	return RuncDumpArgs_LOCAL
-}
-
-func (x *RuncDumpArgs) GetJobID() string {
-	if x != nil {
-		return x.JobID
-	}
-	return ""
-}
-
-type RuncDumpResp struct {
+type RestoreResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message      string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
-	CheckpointId string `protobuf:"bytes,2,opt,name=CheckpointId,proto3" json:"CheckpointId,omitempty"`
+	Message string           `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	NewPID  int32            `protobuf:"varint,2,opt,name=NewPID,proto3" json:"NewPID,omitempty"`
+	Members []*ProcessMember `protobuf:"bytes,3,rep,name=Members,proto3" json:"Members,omitempty"`
+	// where the streams of the restored process were redirected, unset for the
+	// ones that weren't
+	Stdin  *StdioTarget `protobuf:"bytes,4,opt,name=Stdin,proto3" json:"Stdin,omitempty"`
+	Stdout *StdioTarget `protobuf:"bytes,5,opt,name=Stdout,proto3" json:"Stdout,omitempty"`
+	Stderr *StdioTarget `protobuf:"bytes,6,opt,name=Stderr,proto3" json:"Stderr,omitempty"`
 }
 
-func (x *RuncDumpResp) Reset() {
-	*x = RuncDumpResp{}
+func (x *RestoreResp) Reset() {
+	*x = RestoreResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2759,13 +3185,13 @@ func (x *RuncDumpResp) Reset() {
 	}
 }
 
-func (x *RuncDumpResp) String() string {
+func (x *RestoreResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuncDumpResp) ProtoMessage() {}
+func (*RestoreResp) ProtoMessage() {}
 
-func (x *RuncDumpResp) ProtoReflect() protoreflect.Message {
+func (x *RestoreResp) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2777,49 +3203,75 @@ func (x *RuncDumpResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuncDumpResp.ProtoReflect.Descriptor instead.
-func (*RuncDumpResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreResp.ProtoReflect.Descriptor instead.
+func (*RestoreResp) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *RuncDumpResp) GetMessage() string {
+func (x *RestoreResp) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *RuncDumpResp) GetCheckpointId() string {
+func (x *RestoreResp) GetNewPID() int32 {
 	if x != nil {
-		return x.CheckpointId
+		return x.NewPID
 	}
-	return ""
+	return 0
 }
 
-type CriuOpts struct {
+func (x *RestoreResp) GetMembers() []*ProcessMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *RestoreResp) GetStdin() *StdioTarget {
+	if x != nil {
+		return x.Stdin
+	}
+	return nil
+}
+
+func (x *RestoreResp) GetStdout() *StdioTarget {
+	if x != nil {
+		return x.Stdout
+	}
+	return nil
+}
+
+func (x *RestoreResp) GetStderr() *StdioTarget {
+	if x != nil {
+		return x.Stderr
+	}
+	return nil
+}
+
+type StartTaskArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ImagesDirectory         string `protobuf:"bytes,1,opt,name=ImagesDirectory,proto3" json:"ImagesDirectory,omitempty"`
-	WorkDirectory           string `protobuf:"bytes,2,opt,name=WorkDirectory,proto3" json:"WorkDirectory,omitempty"`
-	ParentImage             string `protobuf:"bytes,3,opt,name=ParentImage,proto3" json:"ParentImage,omitempty"`
-	LeaveRunning            bool   `protobuf:"varint,4,opt,name=LeaveRunning,proto3" json:"LeaveRunning,omitempty"`
-	TcpEstablished          bool   `protobuf:"varint,5,opt,name=TcpEstablished,proto3" json:"TcpEstablished,omitempty"`
-	ExternalUnixConnections bool   `protobuf:"varint,6,opt,name=ExternalUnixConnections,proto3" json:"ExternalUnixConnections,omitempty"`
-	ShellJob                bool   `protobuf:"varint,7,opt,name=ShellJob,proto3" json:"ShellJob,omitempty"`
-	FileLocks               bool   `protobuf:"varint,8,opt,name=FileLocks,proto3" json:"FileLocks,omitempty"`
-	PreDump                 bool   `protobuf:"varint,9,opt,name=PreDump,proto3" json:"PreDump,omitempty"`
-	EmptyNs                 uint32 `protobuf:"varint,12,opt,name=EmptyNs,proto3" json:"EmptyNs,omitempty"`
-	AutoDedup               bool   `protobuf:"varint,13,opt,name=AutoDedup,proto3" json:"AutoDedup,omitempty"`
-	LazyPages               bool   `protobuf:"varint,14,opt,name=LazyPages,proto3" json:"LazyPages,omitempty"`
-	StatusFd                int32  `protobuf:"varint,15,opt,name=StatusFd,proto3" json:"StatusFd,omitempty"`
-	LsmProfile              string `protobuf:"bytes,16,opt,name=LsmProfile,proto3" json:"LsmProfile,omitempty"`
-	LsmMountContext         string `protobuf:"bytes,17,opt,name=LsmMountContext,proto3" json:"LsmMountContext,omitempty"`
+	Task          string `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"`
+	Id            string `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	WorkingDir    string `protobuf:"bytes,3,opt,name=WorkingDir,proto3" json:"WorkingDir,omitempty"`
+	LogOutputFile string `protobuf:"bytes,4,opt,name=LogOutputFile,proto3" json:"LogOutputFile,omitempty"`
+	UID           uint32 `protobuf:"varint,5,opt,name=UID,proto3" json:"UID,omitempty"`
+	GID           uint32 `protobuf:"varint,6,opt,name=GID,proto3" json:"GID,omitempty"`
+	// checkpoint the task periodically from the start
+	CheckpointPolicy *CheckpointPolicy `protobuf:"bytes,7,opt,name=CheckpointPolicy,proto3" json:"CheckpointPolicy,omitempty"`
+	Scope            JobScope          `protobuf:"varint,8,opt,name=Scope,proto3,enum=cedana.services.task.JobScope" json:"Scope,omitempty"`
+	// run the task in a pid namespace of its own, under a cedana init
+	PidNamespace bool `protobuf:"varint,9,opt,name=PidNamespace,proto3" json:"PidNamespace,omitempty"`
+	// limits of the job's cgroup
+	Limits *ResourceLimits `protobuf:"bytes,10,opt,name=Limits,proto3" json:"Limits,omitempty"`
 }
 
-func (x *CriuOpts) Reset() {
-	*x = CriuOpts{}
+func (x *StartTaskArgs) Reset() {
+	*x = StartTaskArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2827,13 +3279,13 @@ func (x *CriuOpts) Reset() {
 	}
 }
 
-func (x *CriuOpts) String() string {
+func (x *StartTaskArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CriuOpts) ProtoMessage() {}
+func (*StartTaskArgs) ProtoMessage() {}
 
-func (x *CriuOpts) ProtoReflect() protoreflect.Message {
+func (x *StartTaskArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2845,131 +3297,92 @@ func (x *CriuOpts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CriuOpts.ProtoReflect.Descriptor instead.
-func (*CriuOpts) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartTaskArgs.ProtoReflect.Descriptor instead.
+func (*StartTaskArgs) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *CriuOpts) GetImagesDirectory() string {
+func (x *StartTaskArgs) GetTask() string {
 	if x != nil {
-		return x.ImagesDirectory
+		return x.Task
 	}
 	return ""
 }
 
-func (x *CriuOpts) GetWorkDirectory() string {
+func (x *StartTaskArgs) GetId() string {
 	if x != nil {
-		return x.WorkDirectory
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CriuOpts) GetParentImage() string {
+func (x *StartTaskArgs) GetWorkingDir() string {
 	if x != nil {
-		return x.ParentImage
+		return x.WorkingDir
 	}
 	return ""
 }
 
-func (x *CriuOpts) GetLeaveRunning() bool {
-	if x != nil {
-		return x.LeaveRunning
-	}
-	return false
-}
-
-func (x *CriuOpts) GetTcpEstablished() bool {
-	if x != nil {
-		return x.TcpEstablished
-	}
-	return false
-}
-
-func (x *CriuOpts) GetExternalUnixConnections() bool {
-	if x != nil {
-		return x.ExternalUnixConnections
-	}
-	return false
-}
-
-func (x *CriuOpts) GetShellJob() bool {
-	if x != nil {
-		return x.ShellJob
-	}
-	return false
-}
-
-func (x *CriuOpts) GetFileLocks() bool {
-	if x != nil {
-		return x.FileLocks
-	}
-	return false
-}
-
-func (x *CriuOpts) GetPreDump() bool {
+func (x *StartTaskArgs) GetLogOutputFile() string {
 	if x != nil {
-		return x.PreDump
+		return x.LogOutputFile
 	}
-	return false
+	return ""
 }
 
-func (x *CriuOpts) GetEmptyNs() uint32 {
+func (x *StartTaskArgs) GetUID() uint32 {
 	if x != nil {
-		return x.EmptyNs
+		return x.UID
 	}
 	return 0
 }
 
-func (x *CriuOpts) GetAutoDedup() bool {
+func (x *StartTaskArgs) GetGID() uint32 {
 	if x != nil {
-		return x.AutoDedup
+		return x.GID
 	}
-	return false
+	return 0
 }
 
-func (x *CriuOpts) GetLazyPages() bool {
+func (x *StartTaskArgs) GetCheckpointPolicy() *CheckpointPolicy {
 	if x != nil {
-		return x.LazyPages
+		return x.CheckpointPolicy
 	}
-	return false
+	return nil
 }
 
-func (x *CriuOpts) GetStatusFd() int32 {
+func (x *StartTaskArgs) GetScope() JobScope {
 	if x != nil {
-		return x.StatusFd
+		return x.Scope
 	}
-	return 0
+	return JobScope_UNSPECIFIED
 }
 
-func (x *CriuOpts) GetLsmProfile() string {
+func (x *StartTaskArgs) GetPidNamespace() bool {
 	if x != nil {
-		return x.LsmProfile
+		return x.PidNamespace
 	}
-	return ""
+	return false
 }
 
-func (x *CriuOpts) GetLsmMountContext() string {
+func (x *StartTaskArgs) GetLimits() *ResourceLimits {
 	if x != nil {
-		return x.LsmMountContext
+		return x.Limits
 	}
-	return ""
+	return nil
 }
 
-type RuncRestoreArgs struct {
+type StartTaskResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContainerId  string                      `protobuf:"bytes,1,opt,name=ContainerId,proto3" json:"ContainerId,omitempty"`
-	ImagePath    string                      `protobuf:"bytes,2,opt,name=ImagePath,proto3" json:"ImagePath,omitempty"`
-	IsK3S        bool                        `protobuf:"varint,3,opt,name=IsK3s,proto3" json:"IsK3s,omitempty"`
-	Opts         *RuncOpts                   `protobuf:"bytes,4,opt,name=Opts,proto3" json:"Opts,omitempty"`
-	Type         RuncRestoreArgs_RestoreType `protobuf:"varint,5,opt,name=Type,proto3,enum=cedana.services.task.RuncRestoreArgs_RestoreType" json:"Type,omitempty"`
-	CheckpointId string                      `protobuf:"bytes,6,opt,name=CheckpointId,proto3" json:"CheckpointId,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	PID     int32  `protobuf:"varint,2,opt,name=PID,proto3" json:"PID,omitempty"`
 }
 
-func (x *RuncRestoreArgs) Reset() {
-	*x = RuncRestoreArgs{}
+func (x *StartTaskResp) Reset() {
+	*x = StartTaskResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_task_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2977,13 +3390,13 @@ func (x *RuncRestoreArgs) Reset() {
 	}
 }
 
-func (x *RuncRestoreArgs) String() string {
+func (x *StartTaskResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuncRestoreArgs) ProtoMessage() {}
+func (*StartTaskResp) ProtoMessage() {}
 
-func (x *RuncRestoreArgs) ProtoReflect() protoreflect.Message {
+func (x *StartTaskResp) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2995,92 +3408,122 @@ func (x *RuncRestoreArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuncRestoreArgs.ProtoReflect.Descriptor instead.
-func (*RuncRestoreArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartTaskResp.ProtoReflect.Descriptor instead.
+func (*StartTaskResp) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *RuncRestoreArgs) GetContainerId() string {
+func (x *StartTaskResp) GetMessage() string {
 	if x != nil {
-		return x.ContainerId
+		return x.Message
 	}
 	return ""
 }
 
-func (x *RuncRestoreArgs) GetImagePath() string {
+func (x *StartTaskResp) GetPID() int32 {
 	if x != nil {
-		return x.ImagePath
+		return x.PID
 	}
-	return ""
+	return 0
 }
 
-func (x *RuncRestoreArgs) GetIsK3S() bool {
-	if x != nil {
-		return x.IsK3S
+// Log Streaming args
+type LogStreamingArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp string `protobuf:"bytes,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Source    string `protobuf:"bytes,2,opt,name=Source,proto3" json:"Source,omitempty"`
+	Level     string `protobuf:"bytes,3,opt,name=Level,proto3" json:"Level,omitempty"`
+	Msg       string `protobuf:"bytes,4,opt,name=Msg,proto3" json:"Msg,omitempty"`
+}
+
+func (x *LogStreamingArgs) Reset() {
+	*x = LogStreamingArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *RuncRestoreArgs) GetOpts() *RuncOpts {
+func (x *LogStreamingArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogStreamingArgs) ProtoMessage() {}
+
+func (x *LogStreamingArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogStreamingArgs.ProtoReflect.Descriptor instead.
+func (*LogStreamingArgs) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LogStreamingArgs) GetTimestamp() string {
 	if x != nil {
-		return x.Opts
+		return x.Timestamp
 	}
-	return nil
+	return ""
 }
 
-func (x *RuncRestoreArgs) GetType() RuncRestoreArgs_RestoreType {
+func (x *LogStreamingArgs) GetSource() string {
 	if x != nil {
-		return x.Type
+		return x.Source
 	}
-	return RuncRestoreArgs_LOCAL
+	return ""
 }
 
-func (x *RuncRestoreArgs) GetCheckpointId() string {
+func (x *LogStreamingArgs) GetLevel() string {
 	if x != nil {
-		return x.CheckpointId
+		return x.Level
 	}
 	return ""
 }
 
-type RuncOpts struct {
+func (x *LogStreamingArgs) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type LogStreamingResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Root            string `protobuf:"bytes,1,opt,name=Root,proto3" json:"Root,omitempty"`
-	ContainerId     string `protobuf:"bytes,2,opt,name=ContainerId,proto3" json:"ContainerId,omitempty"`
-	Bundle          string `protobuf:"bytes,3,opt,name=Bundle,proto3" json:"Bundle,omitempty"`
-	SystemdCgroup   bool   `protobuf:"varint,4,opt,name=SystemdCgroup,proto3" json:"SystemdCgroup,omitempty"`
-	NoPivot         bool   `protobuf:"varint,5,opt,name=NoPivot,proto3" json:"NoPivot,omitempty"`
-	NoMountFallback bool   `protobuf:"varint,6,opt,name=NoMountFallback,proto3" json:"NoMountFallback,omitempty"`
-	NoNewKeyring    bool   `protobuf:"varint,7,opt,name=NoNewKeyring,proto3" json:"NoNewKeyring,omitempty"`
-	Rootless        string `protobuf:"bytes,8,opt,name=Rootless,proto3" json:"Rootless,omitempty"`
-	NoSubreaper     bool   `protobuf:"varint,9,opt,name=NoSubreaper,proto3" json:"NoSubreaper,omitempty"`
-	Keep            bool   `protobuf:"varint,10,opt,name=Keep,proto3" json:"Keep,omitempty"`
-	ConsoleSocket   string `protobuf:"bytes,11,opt,name=ConsoleSocket,proto3" json:"ConsoleSocket,omitempty"`
-	Detatch         bool   `protobuf:"varint,12,opt,name=Detatch,proto3" json:"Detatch,omitempty"`
-	PidFile         string `protobuf:"bytes,13,opt,name=PidFile,proto3" json:"PidFile,omitempty"`
-	PreserveFds     int32  `protobuf:"varint,14,opt,name=PreserveFds,proto3" json:"PreserveFds,omitempty"`
-	NetPid          int32  `protobuf:"varint,15,opt,name=NetPid,proto3" json:"NetPid,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
 }
 
-func (x *RuncOpts) Reset() {
-	*x = RuncOpts{}
+func (x *LogStreamingResp) Reset() {
+	*x = LogStreamingResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[39]
+		mi := &file_task_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RuncOpts) String() string {
+func (x *LogStreamingResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuncOpts) ProtoMessage() {}
+func (*LogStreamingResp) ProtoMessage() {}
 
-func (x *RuncOpts) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[39]
+func (x *LogStreamingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3091,141 +3534,184 @@ func (x *RuncOpts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuncOpts.ProtoReflect.Descriptor instead.
-func (*RuncOpts) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use LogStreamingResp.ProtoReflect.Descriptor instead.
+func (*LogStreamingResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *RuncOpts) GetRoot() string {
+func (x *LogStreamingResp) GetStatus() string {
 	if x != nil {
-		return x.Root
+		return x.Status
 	}
 	return ""
 }
 
-func (x *RuncOpts) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
-	}
-	return ""
+// A pid namespace of a job, whose init is the root of the job's process tree
+type PidNamespace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// host pid of the init of the namespace
+	InitPID int32 `protobuf:"varint,1,opt,name=InitPID,proto3" json:"InitPID,omitempty"`
+	// pids of the job's processes, as of its last start, dump or restore
+	Mappings []*PidMapping `protobuf:"bytes,2,rep,name=Mappings,proto3" json:"Mappings,omitempty"`
 }
 
-func (x *RuncOpts) GetBundle() string {
-	if x != nil {
-		return x.Bundle
+func (x *PidNamespace) Reset() {
+	*x = PidNamespace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *RuncOpts) GetSystemdCgroup() bool {
-	if x != nil {
-		return x.SystemdCgroup
-	}
-	return false
+func (x *PidNamespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RuncOpts) GetNoPivot() bool {
-	if x != nil {
-		return x.NoPivot
+func (*PidNamespace) ProtoMessage() {}
+
+func (x *PidNamespace) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *RuncOpts) GetNoMountFallback() bool {
-	if x != nil {
-		return x.NoMountFallback
-	}
-	return false
+// Deprecated: Use PidNamespace.ProtoReflect.Descriptor instead.
+func (*PidNamespace) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *RuncOpts) GetNoNewKeyring() bool {
+func (x *PidNamespace) GetInitPID() int32 {
 	if x != nil {
-		return x.NoNewKeyring
+		return x.InitPID
 	}
-	return false
+	return 0
 }
 
-func (x *RuncOpts) GetRootless() string {
+func (x *PidNamespace) GetMappings() []*PidMapping {
 	if x != nil {
-		return x.Rootless
+		return x.Mappings
 	}
-	return ""
+	return nil
 }
 
-func (x *RuncOpts) GetNoSubreaper() bool {
-	if x != nil {
-		return x.NoSubreaper
-	}
-	return false
+type PidMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HostPID int32 `protobuf:"varint,1,opt,name=HostPID,proto3" json:"HostPID,omitempty"`
+	NsPID   int32 `protobuf:"varint,2,opt,name=NsPID,proto3" json:"NsPID,omitempty"`
 }
 
-func (x *RuncOpts) GetKeep() bool {
-	if x != nil {
-		return x.Keep
+func (x *PidMapping) Reset() {
+	*x = PidMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *RuncOpts) GetConsoleSocket() string {
-	if x != nil {
-		return x.ConsoleSocket
-	}
-	return ""
+func (x *PidMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RuncOpts) GetDetatch() bool {
-	if x != nil {
-		return x.Detatch
+func (*PidMapping) ProtoMessage() {}
+
+func (x *PidMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *RuncOpts) GetPidFile() string {
-	if x != nil {
-		return x.PidFile
-	}
-	return ""
+// Deprecated: Use PidMapping.ProtoReflect.Descriptor instead.
+func (*PidMapping) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *RuncOpts) GetPreserveFds() int32 {
+func (x *PidMapping) GetHostPID() int32 {
 	if x != nil {
-		return x.PreserveFds
+		return x.HostPID
 	}
 	return 0
 }
 
-func (x *RuncOpts) GetNetPid() int32 {
+func (x *PidMapping) GetNsPID() int32 {
 	if x != nil {
-		return x.NetPid
+		return x.NsPID
 	}
 	return 0
 }
 
-type RuncRestoreResp struct {
+type ProcessState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	PID              int32                             `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
+	Task             string                            `protobuf:"bytes,2,opt,name=Task,proto3" json:"Task,omitempty"`
+	ContainerRuntime ProcessState_ContainerRuntimeOpts `protobuf:"varint,3,opt,name=ContainerRuntime,proto3,enum=cedana.services.task.ProcessState_ContainerRuntimeOpts" json:"ContainerRuntime,omitempty"`
+	ContainerId      string                            `protobuf:"bytes,4,opt,name=ContainerId,proto3" json:"ContainerId,omitempty"`
+	StartedAt        string                            `protobuf:"bytes,5,opt,name=StartedAt,proto3" json:"StartedAt,omitempty"`
+	ProcessInfo      *ProcessInfo                      `protobuf:"bytes,6,opt,name=ProcessInfo,proto3" json:"ProcessInfo,omitempty"`
+	CheckpointPath   string                            `protobuf:"bytes,7,opt,name=CheckpointPath,proto3" json:"CheckpointPath,omitempty"`
+	CheckpointState  CheckpointState                   `protobuf:"varint,8,opt,name=CheckpointState,proto3,enum=cedana.services.task.CheckpointState" json:"CheckpointState,omitempty"`
+	Flag             FlagEnum                          `protobuf:"varint,9,opt,name=Flag,proto3,enum=cedana.services.task.FlagEnum" json:"Flag,omitempty"`
+	RemoteState      []*RemoteState                    `protobuf:"bytes,10,rep,name=RemoteState,proto3" json:"RemoteState,omitempty"`
+	GPUCheckpointed  bool                              `protobuf:"varint,11,opt,name=GPUCheckpointed,proto3" json:"GPUCheckpointed,omitempty"`
+	CheckpointReason *CheckpointReason                 `protobuf:"bytes,12,opt,name=CheckpointReason,proto3" json:"CheckpointReason,omitempty"`
+	// every process of the job as of the last checkpoint or restore, PID first
+	Members []*ProcessMember `protobuf:"bytes,13,rep,name=Members,proto3" json:"Members,omitempty"`
+	Scope   JobScope         `protobuf:"varint,14,opt,name=Scope,proto3,enum=cedana.services.task.JobScope" json:"Scope,omitempty"`
+	// files copied into the checkpoint, put back before it's restored
+	CapturedFiles []*CapturedFile `protobuf:"bytes,15,rep,name=CapturedFiles,proto3" json:"CapturedFiles,omitempty"`
+	// CRIU inherit-fd key of the terminal of a shell job, tty[rdev:dev]
+	TtyKey string `protobuf:"bytes,16,opt,name=TtyKey,proto3" json:"TtyKey,omitempty"`
+	// set for jobs in a pid namespace of their own
+	PidNamespace *PidNamespace `protobuf:"bytes,17,opt,name=PidNamespace,proto3" json:"PidNamespace,omitempty"`
+	// cgroup v2 of the job, relative to the mount, and the limits it had
+	Cgroup string          `protobuf:"bytes,18,opt,name=Cgroup,proto3" json:"Cgroup,omitempty"`
+	Limits *ResourceLimits `protobuf:"bytes,19,opt,name=Limits,proto3" json:"Limits,omitempty"`
+	// roots of the other trees of a session job once restored, which CRIU puts in
+	// the session of the daemon instead of the job's
+	Trees []int32 `protobuf:"varint,20,rep,packed,name=Trees,proto3" json:"Trees,omitempty"`
+	// the daemon stopped the job for its dump, so it's continued once restored
+	Stopped bool `protobuf:"varint,21,opt,name=Stopped,proto3" json:"Stopped,omitempty"`
 }
 
-func (x *RuncRestoreResp) Reset() {
-	*x = RuncRestoreResp{}
+func (x *ProcessState) Reset() {
+	*x = ProcessState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_task_proto_msgTypes[40]
+		mi := &file_task_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RuncRestoreResp) String() string {
+func (x *ProcessState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuncRestoreResp) ProtoMessage() {}
+func (*ProcessState) ProtoMessage() {}
 
-func (x *RuncRestoreResp) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[40]
+func (x *ProcessState) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3236,514 +3722,3693 @@ func (x *RuncRestoreResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuncRestoreResp.ProtoReflect.Descriptor instead.
-func (*RuncRestoreResp) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ProcessState.ProtoReflect.Descriptor instead.
+func (*ProcessState) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *RuncRestoreResp) GetMessage() string {
+func (x *ProcessState) GetPID() int32 {
 	if x != nil {
-		return x.Message
+		return x.PID
+	}
+	return 0
+}
+
+func (x *ProcessState) GetTask() string {
+	if x != nil {
+		return x.Task
 	}
 	return ""
 }
 
-var File_task_proto protoreflect.FileDescriptor
+func (x *ProcessState) GetContainerRuntime() ProcessState_ContainerRuntimeOpts {
+	if x != nil {
+		return x.ContainerRuntime
+	}
+	return ProcessState_CONTAINERD
+}
 
-var file_task_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x63, 0x65,
-	0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61,
-	0x73, 0x6b, 0x22, 0x3c, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x6f,
-	0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x22, 0x4b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0xdb, 0x01,
-	0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x2a, 0x0a, 0x10, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x53, 0x61, 0x6e, 0x64, 0x62,
-	0x6f, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53,
-	0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x55, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x55, 0x69, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x0a,
-	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x0b, 0x41, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x31, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0b, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
-	0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x22, 0x0a, 0x08, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x22, 0xa4, 0x01, 0x0a, 0x08, 0x44, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x67, 0x73,
-	0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50,
-	0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x44, 0x69, 0x72, 0x12, 0x3b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x41, 0x72,
-	0x67, 0x73, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x21, 0x0a, 0x08, 0x44, 0x75, 0x6d, 0x70, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0a,
-	0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x01, 0x22, 0x64, 0x0a, 0x08, 0x44, 0x75,
-	0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x22, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x44,
-	0x22, 0xd8, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x72, 0x67, 0x73,
-	0x12, 0x41, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d,
-	0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x72, 0x67,
-	0x73, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x24, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x00, 0x12,
-	0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x01, 0x22, 0x3f, 0x0a, 0x0b, 0x52,
-	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x65, 0x77, 0x50, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4e, 0x65, 0x77, 0x50, 0x49, 0x44, 0x22, 0x9d, 0x01, 0x0a,
-	0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61,
-	0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44,
-	0x69, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46,
+func (x *ProcessState) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ProcessState) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ProcessState) GetProcessInfo() *ProcessInfo {
+	if x != nil {
+		return x.ProcessInfo
+	}
+	return nil
+}
+
+func (x *ProcessState) GetCheckpointPath() string {
+	if x != nil {
+		return x.CheckpointPath
+	}
+	return ""
+}
+
+func (x *ProcessState) GetCheckpointState() CheckpointState {
+	if x != nil {
+		return x.CheckpointState
+	}
+	return CheckpointState_CHECKPOINTED
+}
+
+func (x *ProcessState) GetFlag() FlagEnum {
+	if x != nil {
+		return x.Flag
+	}
+	return FlagEnum_JOB_STARTUP_FAILED
+}
+
+func (x *ProcessState) GetRemoteState() []*RemoteState {
+	if x != nil {
+		return x.RemoteState
+	}
+	return nil
+}
+
+func (x *ProcessState) GetGPUCheckpointed() bool {
+	if x != nil {
+		return x.GPUCheckpointed
+	}
+	return false
+}
+
+func (x *ProcessState) GetCheckpointReason() *CheckpointReason {
+	if x != nil {
+		return x.CheckpointReason
+	}
+	return nil
+}
+
+func (x *ProcessState) GetMembers() []*ProcessMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ProcessState) GetScope() JobScope {
+	if x != nil {
+		return x.Scope
+	}
+	return JobScope_UNSPECIFIED
+}
+
+func (x *ProcessState) GetCapturedFiles() []*CapturedFile {
+	if x != nil {
+		return x.CapturedFiles
+	}
+	return nil
+}
+
+func (x *ProcessState) GetTtyKey() string {
+	if x != nil {
+		return x.TtyKey
+	}
+	return ""
+}
+
+func (x *ProcessState) GetPidNamespace() *PidNamespace {
+	if x != nil {
+		return x.PidNamespace
+	}
+	return nil
+}
+
+func (x *ProcessState) GetCgroup() string {
+	if x != nil {
+		return x.Cgroup
+	}
+	return ""
+}
+
+func (x *ProcessState) GetLimits() *ResourceLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *ProcessState) GetTrees() []int32 {
+	if x != nil {
+		return x.Trees
+	}
+	return nil
+}
+
+func (x *ProcessState) GetStopped() bool {
+	if x != nil {
+		return x.Stopped
+	}
+	return false
+}
+
+type ProcessMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PID  int32 `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
+	PPID int32 `protobuf:"varint,2,opt,name=PPID,proto3" json:"PPID,omitempty"`
+	// session id
+	SID     int32  `protobuf:"varint,3,opt,name=SID,proto3" json:"SID,omitempty"`
+	Cmdline string `protobuf:"bytes,4,opt,name=Cmdline,proto3" json:"Cmdline,omitempty"`
+}
+
+func (x *ProcessMember) Reset() {
+	*x = ProcessMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessMember) ProtoMessage() {}
+
+func (x *ProcessMember) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessMember.ProtoReflect.Descriptor instead.
+func (*ProcessMember) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ProcessMember) GetPID() int32 {
+	if x != nil {
+		return x.PID
+	}
+	return 0
+}
+
+func (x *ProcessMember) GetPPID() int32 {
+	if x != nil {
+		return x.PPID
+	}
+	return 0
+}
+
+func (x *ProcessMember) GetSID() int32 {
+	if x != nil {
+		return x.SID
+	}
+	return 0
+}
+
+func (x *ProcessMember) GetCmdline() string {
+	if x != nil {
+		return x.Cmdline
+	}
+	return ""
+}
+
+type RemoteState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CheckpointID string `protobuf:"bytes,1,opt,name=CheckpointID,proto3" json:"CheckpointID,omitempty"`
+	UploadID     string `protobuf:"bytes,2,opt,name=UploadID,proto3" json:"UploadID,omitempty"`
+	Timestamp    int64  `protobuf:"varint,3,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Checksum     string `protobuf:"bytes,4,opt,name=Checksum,proto3" json:"Checksum,omitempty"`
+}
+
+func (x *RemoteState) Reset() {
+	*x = RemoteState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteState) ProtoMessage() {}
+
+func (x *RemoteState) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteState.ProtoReflect.Descriptor instead.
+func (*RemoteState) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RemoteState) GetCheckpointID() string {
+	if x != nil {
+		return x.CheckpointID
+	}
+	return ""
+}
+
+func (x *RemoteState) GetUploadID() string {
+	if x != nil {
+		return x.UploadID
+	}
+	return ""
+}
+
+func (x *RemoteState) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *RemoteState) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+type ClientInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Hostname        string `protobuf:"bytes,2,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	Platform        string `protobuf:"bytes,3,opt,name=Platform,proto3" json:"Platform,omitempty"`
+	OS              string `protobuf:"bytes,4,opt,name=OS,proto3" json:"OS,omitempty"`
+	Uptime          uint64 `protobuf:"varint,5,opt,name=Uptime,proto3" json:"Uptime,omitempty"`
+	RemainingMemory uint64 `protobuf:"varint,6,opt,name=RemainingMemory,proto3" json:"RemainingMemory,omitempty"`
+}
+
+func (x *ClientInfo) Reset() {
+	*x = ClientInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientInfo) ProtoMessage() {}
+
+func (x *ClientInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientInfo.ProtoReflect.Descriptor instead.
+func (*ClientInfo) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ClientInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetOS() string {
+	if x != nil {
+		return x.OS
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetUptime() uint64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *ClientInfo) GetRemainingMemory() uint64 {
+	if x != nil {
+		return x.RemainingMemory
+	}
+	return 0
+}
+
+type ProcessInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PID                     int32             `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
+	AttachedToHardwareAccel bool              `protobuf:"varint,2,opt,name=AttachedToHardwareAccel,proto3" json:"AttachedToHardwareAccel,omitempty"`
+	OpenFds                 []*OpenFilesStat  `protobuf:"bytes,3,rep,name=OpenFds,proto3" json:"OpenFds,omitempty"`
+	WorkingDir              string            `protobuf:"bytes,4,opt,name=WorkingDir,proto3" json:"WorkingDir,omitempty"`
+	OpenConnections         []*ConnectionStat `protobuf:"bytes,5,rep,name=OpenConnections,proto3" json:"OpenConnections,omitempty"`
+	MemoryPercent           float32           `protobuf:"fixed32,6,opt,name=MemoryPercent,proto3" json:"MemoryPercent,omitempty"`
+	IsRunning               bool              `protobuf:"varint,7,opt,name=IsRunning,proto3" json:"IsRunning,omitempty"`
+	Status                  string            `protobuf:"bytes,8,opt,name=Status,proto3" json:"Status,omitempty"`
+	Usage                   *ResourceUsage    `protobuf:"bytes,9,opt,name=Usage,proto3" json:"Usage,omitempty"`
+}
+
+func (x *ProcessInfo) Reset() {
+	*x = ProcessInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessInfo) ProtoMessage() {}
+
+func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessInfo.ProtoReflect.Descriptor instead.
+func (*ProcessInfo) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ProcessInfo) GetPID() int32 {
+	if x != nil {
+		return x.PID
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetAttachedToHardwareAccel() bool {
+	if x != nil {
+		return x.AttachedToHardwareAccel
+	}
+	return false
+}
+
+func (x *ProcessInfo) GetOpenFds() []*OpenFilesStat {
+	if x != nil {
+		return x.OpenFds
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetWorkingDir() string {
+	if x != nil {
+		return x.WorkingDir
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetOpenConnections() []*ConnectionStat {
+	if x != nil {
+		return x.OpenConnections
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetMemoryPercent() float32 {
+	if x != nil {
+		return x.MemoryPercent
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetIsRunning() bool {
+	if x != nil {
+		return x.IsRunning
+	}
+	return false
+}
+
+func (x *ProcessInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetUsage() *ResourceUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// What a process, or all the processes of a job summed up, use of the machine
+type ResourceUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resident and proportional set size, bytes
+	RSS uint64 `protobuf:"varint,1,opt,name=RSS,proto3" json:"RSS,omitempty"`
+	PSS uint64 `protobuf:"varint,2,opt,name=PSS,proto3" json:"PSS,omitempty"`
+	// resident memory that is anonymous, what a checkpoint has to hold, and the
+	// rest, backed by files or shared memory
+	AnonBytes uint64 `protobuf:"varint,3,opt,name=AnonBytes,proto3" json:"AnonBytes,omitempty"`
+	FileBytes uint64 `protobuf:"varint,4,opt,name=FileBytes,proto3" json:"FileBytes,omitempty"`
+	Threads   int32  `protobuf:"varint,5,opt,name=Threads,proto3" json:"Threads,omitempty"`
+	// cpu time in user and kernel mode, seconds
+	CPUUser   float64 `protobuf:"fixed64,6,opt,name=CPUUser,proto3" json:"CPUUser,omitempty"`
+	CPUSystem float64 `protobuf:"fixed64,7,opt,name=CPUSystem,proto3" json:"CPUSystem,omitempty"`
+	// bytes read from and written to storage
+	IOReadBytes  uint64 `protobuf:"varint,8,opt,name=IOReadBytes,proto3" json:"IOReadBytes,omitempty"`
+	IOWriteBytes uint64 `protobuf:"varint,9,opt,name=IOWriteBytes,proto3" json:"IOWriteBytes,omitempty"`
+	// memory mappings, one per vma
+	Mappings uint32 `protobuf:"varint,10,opt,name=Mappings,proto3" json:"Mappings,omitempty"`
+}
+
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceUsage) ProtoMessage() {}
+
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ResourceUsage) GetRSS() uint64 {
+	if x != nil {
+		return x.RSS
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetPSS() uint64 {
+	if x != nil {
+		return x.PSS
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetAnonBytes() uint64 {
+	if x != nil {
+		return x.AnonBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetFileBytes() uint64 {
+	if x != nil {
+		return x.FileBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetThreads() int32 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetCPUUser() float64 {
+	if x != nil {
+		return x.CPUUser
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetCPUSystem() float64 {
+	if x != nil {
+		return x.CPUSystem
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetIOReadBytes() uint64 {
+	if x != nil {
+		return x.IOReadBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetIOWriteBytes() uint64 {
+	if x != nil {
+		return x.IOWriteBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetMappings() uint32 {
+	if x != nil {
+		return x.Mappings
+	}
+	return 0
+}
+
+type JobMetricsSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unix nanoseconds
+	Timestamp int64          `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Usage     *ResourceUsage `protobuf:"bytes,2,opt,name=Usage,proto3" json:"Usage,omitempty"`
+}
+
+func (x *JobMetricsSample) Reset() {
+	*x = JobMetricsSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobMetricsSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobMetricsSample) ProtoMessage() {}
+
+func (x *JobMetricsSample) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobMetricsSample.ProtoReflect.Descriptor instead.
+func (*JobMetricsSample) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *JobMetricsSample) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *JobMetricsSample) GetUsage() *ResourceUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type GetJobMetricsArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID string `protobuf:"bytes,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	// only the samples taken since, in unix nanoseconds
+	Since int64 `protobuf:"varint,2,opt,name=Since,proto3" json:"Since,omitempty"`
+}
+
+func (x *GetJobMetricsArgs) Reset() {
+	*x = GetJobMetricsArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobMetricsArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobMetricsArgs) ProtoMessage() {}
+
+func (x *GetJobMetricsArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobMetricsArgs.ProtoReflect.Descriptor instead.
+func (*GetJobMetricsArgs) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetJobMetricsArgs) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *GetJobMetricsArgs) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+type GetJobMetricsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// oldest first
+	Samples []*JobMetricsSample `protobuf:"bytes,1,rep,name=Samples,proto3" json:"Samples,omitempty"`
+	// usage right now, unset if the job isn't running
+	Current *ResourceUsage `protobuf:"bytes,2,opt,name=Current,proto3" json:"Current,omitempty"`
+}
+
+func (x *GetJobMetricsResp) Reset() {
+	*x = GetJobMetricsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobMetricsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobMetricsResp) ProtoMessage() {}
+
+func (x *GetJobMetricsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobMetricsResp.ProtoReflect.Descriptor instead.
+func (*GetJobMetricsResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetJobMetricsResp) GetSamples() []*JobMetricsSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *GetJobMetricsResp) GetCurrent() *ResourceUsage {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+type OpenFilesStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path   string                   `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Fd     uint64                   `protobuf:"varint,2,opt,name=Fd,proto3" json:"Fd,omitempty"`
+	Mode   string                   `protobuf:"bytes,3,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	Stream OpenFilesStat_StreamType `protobuf:"varint,5,opt,name=Stream,proto3,enum=cedana.services.task.OpenFilesStat_StreamType" json:"Stream,omitempty"`
+}
+
+func (x *OpenFilesStat) Reset() {
+	*x = OpenFilesStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenFilesStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenFilesStat) ProtoMessage() {}
+
+func (x *OpenFilesStat) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenFilesStat.ProtoReflect.Descriptor instead.
+func (*OpenFilesStat) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *OpenFilesStat) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *OpenFilesStat) GetFd() uint64 {
+	if x != nil {
+		return x.Fd
+	}
+	return 0
+}
+
+func (x *OpenFilesStat) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *OpenFilesStat) GetStream() OpenFilesStat_StreamType {
+	if x != nil {
+		return x.Stream
+	}
+	return OpenFilesStat_STDIN
+}
+
+type ConnectionStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fd     uint32  `protobuf:"varint,1,opt,name=Fd,proto3" json:"Fd,omitempty"`
+	Family uint32  `protobuf:"varint,2,opt,name=Family,proto3" json:"Family,omitempty"`
+	Type   uint32  `protobuf:"varint,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	Laddr  *Addr   `protobuf:"bytes,4,opt,name=Laddr,proto3" json:"Laddr,omitempty"`
+	Raddr  *Addr   `protobuf:"bytes,5,opt,name=Raddr,proto3" json:"Raddr,omitempty"`
+	Status string  `protobuf:"bytes,6,opt,name=Status,proto3" json:"Status,omitempty"`
+	Uids   []int32 `protobuf:"varint,7,rep,packed,name=Uids,proto3" json:"Uids,omitempty"`
+	PID    int32   `protobuf:"varint,8,opt,name=PID,proto3" json:"PID,omitempty"`
+}
+
+func (x *ConnectionStat) Reset() {
+	*x = ConnectionStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionStat) ProtoMessage() {}
+
+func (x *ConnectionStat) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionStat.ProtoReflect.Descriptor instead.
+func (*ConnectionStat) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ConnectionStat) GetFd() uint32 {
+	if x != nil {
+		return x.Fd
+	}
+	return 0
+}
+
+func (x *ConnectionStat) GetFamily() uint32 {
+	if x != nil {
+		return x.Family
+	}
+	return 0
+}
+
+func (x *ConnectionStat) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ConnectionStat) GetLaddr() *Addr {
+	if x != nil {
+		return x.Laddr
+	}
+	return nil
+}
+
+func (x *ConnectionStat) GetRaddr() *Addr {
+	if x != nil {
+		return x.Raddr
+	}
+	return nil
+}
+
+func (x *ConnectionStat) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ConnectionStat) GetUids() []int32 {
+	if x != nil {
+		return x.Uids
+	}
+	return nil
+}
+
+func (x *ConnectionStat) GetPID() int32 {
+	if x != nil {
+		return x.PID
+	}
+	return 0
+}
+
+type Addr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IP   string `protobuf:"bytes,1,opt,name=IP,proto3" json:"IP,omitempty"`
+	Port uint32 `protobuf:"varint,2,opt,name=Port,proto3" json:"Port,omitempty"`
+}
+
+func (x *Addr) Reset() {
+	*x = Addr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addr) ProtoMessage() {}
+
+func (x *Addr) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addr.ProtoReflect.Descriptor instead.
+func (*Addr) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Addr) GetIP() string {
+	if x != nil {
+		return x.IP
+	}
+	return ""
+}
+
+func (x *Addr) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// a file copied into a checkpoint, and how it was open
+type CapturedFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	// name of the copy, under the files dir of the checkpoint
+	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Size int64  `protobuf:"varint,3,opt,name=Size,proto3" json:"Size,omitempty"`
+	Mode uint32 `protobuf:"varint,4,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	UID  uint32 `protobuf:"varint,5,opt,name=UID,proto3" json:"UID,omitempty"`
+	GID  uint32 `protobuf:"varint,6,opt,name=GID,proto3" json:"GID,omitempty"`
+	// furthest offset of the fds open on it
+	Offset int64 `protobuf:"varint,7,opt,name=Offset,proto3" json:"Offset,omitempty"`
+}
+
+func (x *CapturedFile) Reset() {
+	*x = CapturedFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapturedFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturedFile) ProtoMessage() {}
+
+func (x *CapturedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturedFile.ProtoReflect.Descriptor instead.
+func (*CapturedFile) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CapturedFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CapturedFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CapturedFile) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CapturedFile) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *CapturedFile) GetUID() uint32 {
+	if x != nil {
+		return x.UID
+	}
+	return 0
+}
+
+func (x *CapturedFile) GetGID() uint32 {
+	if x != nil {
+		return x.GID
+	}
+	return 0
+}
+
+func (x *CapturedFile) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ClientStateStreamingResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+}
+
+func (x *ClientStateStreamingResp) Reset() {
+	*x = ClientStateStreamingResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientStateStreamingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientStateStreamingResp) ProtoMessage() {}
+
+func (x *ClientStateStreamingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientStateStreamingResp.ProtoReflect.Descriptor instead.
+func (*ClientStateStreamingResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ClientStateStreamingResp) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type MetaStateStreamingArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event            *ProviderEvent    `protobuf:"bytes,1,opt,name=Event,proto3" json:"Event,omitempty"`
+	CheckpointReason *CheckpointReason `protobuf:"bytes,2,opt,name=CheckpointReason,proto3" json:"CheckpointReason,omitempty"`
+}
+
+func (x *MetaStateStreamingArgs) Reset() {
+	*x = MetaStateStreamingArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetaStateStreamingArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetaStateStreamingArgs) ProtoMessage() {}
+
+func (x *MetaStateStreamingArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetaStateStreamingArgs.ProtoReflect.Descriptor instead.
+func (*MetaStateStreamingArgs) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MetaStateStreamingArgs) GetEvent() *ProviderEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *MetaStateStreamingArgs) GetCheckpointReason() *CheckpointReason {
+	if x != nil {
+		return x.CheckpointReason
+	}
+	return nil
+}
+
+type CheckpointReason struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason CheckpointReason_CheckpointReasonEnum `protobuf:"varint,1,opt,name=Reason,proto3,enum=cedana.services.task.CheckpointReason_CheckpointReasonEnum" json:"Reason,omitempty"`
+}
+
+func (x *CheckpointReason) Reset() {
+	*x = CheckpointReason{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckpointReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckpointReason) ProtoMessage() {}
+
+func (x *CheckpointReason) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckpointReason.ProtoReflect.Descriptor instead.
+func (*CheckpointReason) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CheckpointReason) GetReason() CheckpointReason_CheckpointReasonEnum {
+	if x != nil {
+		return x.Reason
+	}
+	return CheckpointReason_INSTANCE_TERMINATION
+}
+
+type ProviderEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceID           string `protobuf:"bytes,1,opt,name=InstanceID,proto3" json:"InstanceID,omitempty"`
+	FaultCode            string `protobuf:"bytes,2,opt,name=FaultCode,proto3" json:"FaultCode,omitempty"`
+	MarkedForTermination bool   `protobuf:"varint,3,opt,name=MarkedForTermination,proto3" json:"MarkedForTermination,omitempty"`
+	TerminationTime      int64  `protobuf:"varint,4,opt,name=TerminationTime,proto3" json:"TerminationTime,omitempty"`
+}
+
+func (x *ProviderEvent) Reset() {
+	*x = ProviderEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderEvent) ProtoMessage() {}
+
+func (x *ProviderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderEvent.ProtoReflect.Descriptor instead.
+func (*ProviderEvent) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ProviderEvent) GetInstanceID() string {
+	if x != nil {
+		return x.InstanceID
+	}
+	return ""
+}
+
+func (x *ProviderEvent) GetFaultCode() string {
+	if x != nil {
+		return x.FaultCode
+	}
+	return ""
+}
+
+func (x *ProviderEvent) GetMarkedForTermination() bool {
+	if x != nil {
+		return x.MarkedForTermination
+	}
+	return false
+}
+
+func (x *ProviderEvent) GetTerminationTime() int64 {
+	if x != nil {
+		return x.TerminationTime
+	}
+	return 0
+}
+
+type MetaStateStreamingResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+}
+
+func (x *MetaStateStreamingResp) Reset() {
+	*x = MetaStateStreamingResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetaStateStreamingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetaStateStreamingResp) ProtoMessage() {}
+
+func (x *MetaStateStreamingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetaStateStreamingResp.ProtoReflect.Descriptor instead.
+func (*MetaStateStreamingResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MetaStateStreamingResp) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type PausePidArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BundlePath string `protobuf:"bytes,1,opt,name=BundlePath,proto3" json:"BundlePath,omitempty"`
+}
+
+func (x *PausePidArgs) Reset() {
+	*x = PausePidArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PausePidArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PausePidArgs) ProtoMessage() {}
+
+func (x *PausePidArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PausePidArgs.ProtoReflect.Descriptor instead.
+func (*PausePidArgs) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *PausePidArgs) GetBundlePath() string {
+	if x != nil {
+		return x.BundlePath
+	}
+	return ""
+}
+
+type PausePidResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PausePid int64 `protobuf:"varint,1,opt,name=PausePid,proto3" json:"PausePid,omitempty"`
+}
+
+func (x *PausePidResp) Reset() {
+	*x = PausePidResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PausePidResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PausePidResp) ProtoMessage() {}
+
+func (x *PausePidResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PausePidResp.ProtoReflect.Descriptor instead.
+func (*PausePidResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PausePidResp) GetPausePid() int64 {
+	if x != nil {
+		return x.PausePid
+	}
+	return 0
+}
+
+type CtrByNameArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerName string `protobuf:"bytes,1,opt,name=ContainerName,proto3" json:"ContainerName,omitempty"`
+	Root          string `protobuf:"bytes,2,opt,name=Root,proto3" json:"Root,omitempty"`
+}
+
+func (x *CtrByNameArgs) Reset() {
+	*x = CtrByNameArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CtrByNameArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CtrByNameArgs) ProtoMessage() {}
+
+func (x *CtrByNameArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CtrByNameArgs.ProtoReflect.Descriptor instead.
+func (*CtrByNameArgs) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CtrByNameArgs) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *CtrByNameArgs) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+type CtrByNameResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuncContainerName string `protobuf:"bytes,1,opt,name=RuncContainerName,proto3" json:"RuncContainerName,omitempty"`
+	RuncBundlePath    string `protobuf:"bytes,2,opt,name=RuncBundlePath,proto3" json:"RuncBundlePath,omitempty"`
+}
+
+func (x *CtrByNameResp) Reset() {
+	*x = CtrByNameResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CtrByNameResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CtrByNameResp) ProtoMessage() {}
+
+func (x *CtrByNameResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CtrByNameResp.ProtoReflect.Descriptor instead.
+func (*CtrByNameResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CtrByNameResp) GetRuncContainerName() string {
+	if x != nil {
+		return x.RuncContainerName
+	}
+	return ""
+}
+
+func (x *CtrByNameResp) GetRuncBundlePath() string {
+	if x != nil {
+		return x.RuncBundlePath
+	}
+	return ""
+}
+
+type RuncRoot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Root string `protobuf:"bytes,1,opt,name=Root,proto3" json:"Root,omitempty"`
+}
+
+func (x *RuncRoot) Reset() {
+	*x = RuncRoot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuncRoot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuncRoot) ProtoMessage() {}
+
+func (x *RuncRoot) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuncRoot.ProtoReflect.Descriptor instead.
+func (*RuncRoot) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RuncRoot) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+type RuncList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Containers []string `protobuf:"bytes,1,rep,name=Containers,proto3" json:"Containers,omitempty"`
+}
+
+func (x *RuncList) Reset() {
+	*x = RuncList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuncList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuncList) ProtoMessage() {}
+
+func (x *RuncList) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuncList.ProtoReflect.Descriptor instead.
+func (*RuncList) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RuncList) GetContainers() []string {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+type ContainerDumpArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerId string `protobuf:"bytes,1,opt,name=ContainerId,proto3" json:"ContainerId,omitempty"`
+	Ref         string `protobuf:"bytes,2,opt,name=Ref,proto3" json:"Ref,omitempty"`
+}
+
+func (x *ContainerDumpArgs) Reset() {
+	*x = ContainerDumpArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerDumpArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerDumpArgs) ProtoMessage() {}
+
+func (x *ContainerDumpArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerDumpArgs.ProtoReflect.Descriptor instead.
+func (*ContainerDumpArgs) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ContainerDumpArgs) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ContainerDumpArgs) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+type ContainerDumpResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CheckpointPath string `protobuf:"bytes,1,opt,name=CheckpointPath,proto3" json:"CheckpointPath,omitempty"`
+	Message        string `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+}
+
+func (x *ContainerDumpResp) Reset() {
+	*x = ContainerDumpResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerDumpResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerDumpResp) ProtoMessage() {}
+
+func (x *ContainerDumpResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerDumpResp.ProtoReflect.Descriptor instead.
+func (*ContainerDumpResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ContainerDumpResp) GetCheckpointPath() string {
+	if x != nil {
+		return x.CheckpointPath
+	}
+	return ""
+}
+
+func (x *ContainerDumpResp) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ContainerRestoreArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImgPath     string `protobuf:"bytes,1,opt,name=ImgPath,proto3" json:"ImgPath,omitempty"`
+	ContainerId string `protobuf:"bytes,2,opt,name=ContainerId,proto3" json:"ContainerId,omitempty"`
+}
+
+func (x *ContainerRestoreArgs) Reset() {
+	*x = ContainerRestoreArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerRestoreArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerRestoreArgs) ProtoMessage() {}
+
+func (x *ContainerRestoreArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerRestoreArgs.ProtoReflect.Descriptor instead.
+func (*ContainerRestoreArgs) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ContainerRestoreArgs) GetImgPath() string {
+	if x != nil {
+		return x.ImgPath
+	}
+	return ""
+}
+
+func (x *ContainerRestoreArgs) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+type ContainerRestoreResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+}
+
+func (x *ContainerRestoreResp) Reset() {
+	*x = ContainerRestoreResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerRestoreResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerRestoreResp) ProtoMessage() {}
+
+func (x *ContainerRestoreResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerRestoreResp.ProtoReflect.Descriptor instead.
+func (*ContainerRestoreResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ContainerRestoreResp) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RuncDumpArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Root           string                `protobuf:"bytes,1,opt,name=Root,proto3" json:"Root,omitempty"`
+	CheckpointPath string                `protobuf:"bytes,2,opt,name=CheckpointPath,proto3" json:"CheckpointPath,omitempty"`
+	ContainerId    string                `protobuf:"bytes,3,opt,name=ContainerId,proto3" json:"ContainerId,omitempty"`
+	CriuOpts       *CriuOpts             `protobuf:"bytes,4,opt,name=CriuOpts,proto3" json:"CriuOpts,omitempty"`
+	Type           RuncDumpArgs_DumpType `protobuf:"varint,5,opt,name=Type,proto3,enum=cedana.services.task.RuncDumpArgs_DumpType" json:"Type,omitempty"`
+	JobID          string                `protobuf:"bytes,6,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	// leave memory pages behind a page server, for a lazy restore to fault in
+	Lazy              bool   `protobuf:"varint,7,opt,name=Lazy,proto3" json:"Lazy,omitempty"`
+	PageServerAddress string `protobuf:"bytes,8,opt,name=PageServerAddress,proto3" json:"PageServerAddress,omitempty"`
+	PageServerPort    int32  `protobuf:"varint,9,opt,name=PageServerPort,proto3" json:"PageServerPort,omitempty"`
+}
+
+func (x *RuncDumpArgs) Reset() {
+	*x = RuncDumpArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuncDumpArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuncDumpArgs) ProtoMessage() {}
+
+func (x *RuncDumpArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuncDumpArgs.ProtoReflect.Descriptor instead.
+func (*RuncDumpArgs) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RuncDumpArgs) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *RuncDumpArgs) GetCheckpointPath() string {
+	if x != nil {
+		return x.CheckpointPath
+	}
+	return ""
+}
+
+func (x *RuncDumpArgs) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *RuncDumpArgs) GetCriuOpts() *CriuOpts {
+	if x != nil {
+		return x.CriuOpts
+	}
+	return nil
+}
+
+func (x *RuncDumpArgs) GetType() RuncDumpArgs_DumpType {
+	if x != nil {
+		return x.Type
+	}
+	return RuncDumpArgs_LOCAL
+}
+
+func (x *RuncDumpArgs) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *RuncDumpArgs) GetLazy() bool {
+	if x != nil {
+		return x.Lazy
+	}
+	return false
+}
+
+func (x *RuncDumpArgs) GetPageServerAddress() string {
+	if x != nil {
+		return x.PageServerAddress
+	}
+	return ""
+}
+
+func (x *RuncDumpArgs) GetPageServerPort() int32 {
+	if x != nil {
+		return x.PageServerPort
+	}
+	return 0
+}
+
+type RuncDumpResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message      string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	CheckpointId string `protobuf:"bytes,2,opt,name=CheckpointId,proto3" json:"CheckpointId,omitempty"`
+	// sha256 of the uploaded checkpoint, for restores elsewhere to check
+	Checksum string `protobuf:"bytes,3,opt,name=Checksum,proto3" json:"Checksum,omitempty"`
+}
+
+func (x *RuncDumpResp) Reset() {
+	*x = RuncDumpResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuncDumpResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuncDumpResp) ProtoMessage() {}
+
+func (x *RuncDumpResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuncDumpResp.ProtoReflect.Descriptor instead.
+func (*RuncDumpResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RuncDumpResp) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RuncDumpResp) GetCheckpointId() string {
+	if x != nil {
+		return x.CheckpointId
+	}
+	return ""
+}
+
+func (x *RuncDumpResp) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+// CRIU options of a process dump or restore, over cedana's defaults. The
+// profile, named in the client config, is applied first and the options set
+// here after it; unset ones keep what was there.
+type CriuProcessOpts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile    string `protobuf:"bytes,1,opt,name=Profile,proto3" json:"Profile,omitempty"`
+	GhostLimit uint32 `protobuf:"varint,2,opt,name=GhostLimit,proto3" json:"GhostLimit,omitempty"`
+	// external resources, e.g. mnt[/mnt]:data or unix[12345]
+	External     []string                `protobuf:"bytes,3,rep,name=External,proto3" json:"External,omitempty"`
+	Tcp          CriuProcessOpts_TcpMode `protobuf:"varint,4,opt,name=Tcp,proto3,enum=cedana.services.task.CriuProcessOpts_TcpMode" json:"Tcp,omitempty"`
+	FileLocks    *bool                   `protobuf:"varint,5,opt,name=FileLocks,proto3,oneof" json:"FileLocks,omitempty"`
+	LinkRemap    *bool                   `protobuf:"varint,6,opt,name=LinkRemap,proto3,oneof" json:"LinkRemap,omitempty"`
+	SkipInFlight *bool                   `protobuf:"varint,7,opt,name=SkipInFlight,proto3,oneof" json:"SkipInFlight,omitempty"`
+	// soft, full, strict, props or ignore
+	ManageCgroupsMode string `protobuf:"bytes,8,opt,name=ManageCgroupsMode,proto3" json:"ManageCgroupsMode,omitempty"`
+	LogLevel          *int32 `protobuf:"varint,9,opt,name=LogLevel,proto3,oneof" json:"LogLevel,omitempty"`
+	LeaveRunning      *bool  `protobuf:"varint,10,opt,name=LeaveRunning,proto3,oneof" json:"LeaveRunning,omitempty"`
+}
+
+func (x *CriuProcessOpts) Reset() {
+	*x = CriuProcessOpts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CriuProcessOpts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriuProcessOpts) ProtoMessage() {}
+
+func (x *CriuProcessOpts) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriuProcessOpts.ProtoReflect.Descriptor instead.
+func (*CriuProcessOpts) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CriuProcessOpts) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *CriuProcessOpts) GetGhostLimit() uint32 {
+	if x != nil {
+		return x.GhostLimit
+	}
+	return 0
+}
+
+func (x *CriuProcessOpts) GetExternal() []string {
+	if x != nil {
+		return x.External
+	}
+	return nil
+}
+
+func (x *CriuProcessOpts) GetTcp() CriuProcessOpts_TcpMode {
+	if x != nil {
+		return x.Tcp
+	}
+	return CriuProcessOpts_TCP_DEFAULT
+}
+
+func (x *CriuProcessOpts) GetFileLocks() bool {
+	if x != nil && x.FileLocks != nil {
+		return *x.FileLocks
+	}
+	return false
+}
+
+func (x *CriuProcessOpts) GetLinkRemap() bool {
+	if x != nil && x.LinkRemap != nil {
+		return *x.LinkRemap
+	}
+	return false
+}
+
+func (x *CriuProcessOpts) GetSkipInFlight() bool {
+	if x != nil && x.SkipInFlight != nil {
+		return *x.SkipInFlight
+	}
+	return false
+}
+
+func (x *CriuProcessOpts) GetManageCgroupsMode() string {
+	if x != nil {
+		return x.ManageCgroupsMode
+	}
+	return ""
+}
+
+func (x *CriuProcessOpts) GetLogLevel() int32 {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
+	}
+	return 0
+}
+
+func (x *CriuProcessOpts) GetLeaveRunning() bool {
+	if x != nil && x.LeaveRunning != nil {
+		return *x.LeaveRunning
+	}
+	return false
+}
+
+type CriuOpts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImagesDirectory         string `protobuf:"bytes,1,opt,name=ImagesDirectory,proto3" json:"ImagesDirectory,omitempty"`
+	WorkDirectory           string `protobuf:"bytes,2,opt,name=WorkDirectory,proto3" json:"WorkDirectory,omitempty"`
+	ParentImage             string `protobuf:"bytes,3,opt,name=ParentImage,proto3" json:"ParentImage,omitempty"`
+	LeaveRunning            bool   `protobuf:"varint,4,opt,name=LeaveRunning,proto3" json:"LeaveRunning,omitempty"`
+	TcpEstablished          bool   `protobuf:"varint,5,opt,name=TcpEstablished,proto3" json:"TcpEstablished,omitempty"`
+	ExternalUnixConnections bool   `protobuf:"varint,6,opt,name=ExternalUnixConnections,proto3" json:"ExternalUnixConnections,omitempty"`
+	ShellJob                bool   `protobuf:"varint,7,opt,name=ShellJob,proto3" json:"ShellJob,omitempty"`
+	FileLocks               bool   `protobuf:"varint,8,opt,name=FileLocks,proto3" json:"FileLocks,omitempty"`
+	PreDump                 bool   `protobuf:"varint,9,opt,name=PreDump,proto3" json:"PreDump,omitempty"`
+	EmptyNs                 uint32 `protobuf:"varint,12,opt,name=EmptyNs,proto3" json:"EmptyNs,omitempty"`
+	AutoDedup               bool   `protobuf:"varint,13,opt,name=AutoDedup,proto3" json:"AutoDedup,omitempty"`
+	LazyPages               bool   `protobuf:"varint,14,opt,name=LazyPages,proto3" json:"LazyPages,omitempty"`
+	StatusFd                int32  `protobuf:"varint,15,opt,name=StatusFd,proto3" json:"StatusFd,omitempty"`
+	LsmProfile              string `protobuf:"bytes,16,opt,name=LsmProfile,proto3" json:"LsmProfile,omitempty"`
+	LsmMountContext         string `protobuf:"bytes,17,opt,name=LsmMountContext,proto3" json:"LsmMountContext,omitempty"`
+}
+
+func (x *CriuOpts) Reset() {
+	*x = CriuOpts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CriuOpts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriuOpts) ProtoMessage() {}
+
+func (x *CriuOpts) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriuOpts.ProtoReflect.Descriptor instead.
+func (*CriuOpts) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CriuOpts) GetImagesDirectory() string {
+	if x != nil {
+		return x.ImagesDirectory
+	}
+	return ""
+}
+
+func (x *CriuOpts) GetWorkDirectory() string {
+	if x != nil {
+		return x.WorkDirectory
+	}
+	return ""
+}
+
+func (x *CriuOpts) GetParentImage() string {
+	if x != nil {
+		return x.ParentImage
+	}
+	return ""
+}
+
+func (x *CriuOpts) GetLeaveRunning() bool {
+	if x != nil {
+		return x.LeaveRunning
+	}
+	return false
+}
+
+func (x *CriuOpts) GetTcpEstablished() bool {
+	if x != nil {
+		return x.TcpEstablished
+	}
+	return false
+}
+
+func (x *CriuOpts) GetExternalUnixConnections() bool {
+	if x != nil {
+		return x.ExternalUnixConnections
+	}
+	return false
+}
+
+func (x *CriuOpts) GetShellJob() bool {
+	if x != nil {
+		return x.ShellJob
+	}
+	return false
+}
+
+func (x *CriuOpts) GetFileLocks() bool {
+	if x != nil {
+		return x.FileLocks
+	}
+	return false
+}
+
+func (x *CriuOpts) GetPreDump() bool {
+	if x != nil {
+		return x.PreDump
+	}
+	return false
+}
+
+func (x *CriuOpts) GetEmptyNs() uint32 {
+	if x != nil {
+		return x.EmptyNs
+	}
+	return 0
+}
+
+func (x *CriuOpts) GetAutoDedup() bool {
+	if x != nil {
+		return x.AutoDedup
+	}
+	return false
+}
+
+func (x *CriuOpts) GetLazyPages() bool {
+	if x != nil {
+		return x.LazyPages
+	}
+	return false
+}
+
+func (x *CriuOpts) GetStatusFd() int32 {
+	if x != nil {
+		return x.StatusFd
+	}
+	return 0
+}
+
+func (x *CriuOpts) GetLsmProfile() string {
+	if x != nil {
+		return x.LsmProfile
+	}
+	return ""
+}
+
+func (x *CriuOpts) GetLsmMountContext() string {
+	if x != nil {
+		return x.LsmMountContext
+	}
+	return ""
+}
+
+type RuncRestoreArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerId  string                      `protobuf:"bytes,1,opt,name=ContainerId,proto3" json:"ContainerId,omitempty"`
+	ImagePath    string                      `protobuf:"bytes,2,opt,name=ImagePath,proto3" json:"ImagePath,omitempty"`
+	IsK3S        bool                        `protobuf:"varint,3,opt,name=IsK3s,proto3" json:"IsK3s,omitempty"`
+	Opts         *RuncOpts                   `protobuf:"bytes,4,opt,name=Opts,proto3" json:"Opts,omitempty"`
+	Type         RuncRestoreArgs_RestoreType `protobuf:"varint,5,opt,name=Type,proto3,enum=cedana.services.task.RuncRestoreArgs_RestoreType" json:"Type,omitempty"`
+	CheckpointId string                      `protobuf:"bytes,6,opt,name=CheckpointId,proto3" json:"CheckpointId,omitempty"`
+	// restore immediately and fault memory in from the dump's page server
+	Lazy              bool   `protobuf:"varint,7,opt,name=Lazy,proto3" json:"Lazy,omitempty"`
+	PageServerAddress string `protobuf:"bytes,8,opt,name=PageServerAddress,proto3" json:"PageServerAddress,omitempty"`
+	PageServerPort    int32  `protobuf:"varint,9,opt,name=PageServerPort,proto3" json:"PageServerPort,omitempty"`
+	// sha256 the download of a remote checkpoint must have, see RestoreArgs
+	Checksum string `protobuf:"bytes,10,opt,name=Checksum,proto3" json:"Checksum,omitempty"`
+}
+
+func (x *RuncRestoreArgs) Reset() {
+	*x = RuncRestoreArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuncRestoreArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuncRestoreArgs) ProtoMessage() {}
+
+func (x *RuncRestoreArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuncRestoreArgs.ProtoReflect.Descriptor instead.
+func (*RuncRestoreArgs) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RuncRestoreArgs) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *RuncRestoreArgs) GetImagePath() string {
+	if x != nil {
+		return x.ImagePath
+	}
+	return ""
+}
+
+func (x *RuncRestoreArgs) GetIsK3S() bool {
+	if x != nil {
+		return x.IsK3S
+	}
+	return false
+}
+
+func (x *RuncRestoreArgs) GetOpts() *RuncOpts {
+	if x != nil {
+		return x.Opts
+	}
+	return nil
+}
+
+func (x *RuncRestoreArgs) GetType() RuncRestoreArgs_RestoreType {
+	if x != nil {
+		return x.Type
+	}
+	return RuncRestoreArgs_LOCAL
+}
+
+func (x *RuncRestoreArgs) GetCheckpointId() string {
+	if x != nil {
+		return x.CheckpointId
+	}
+	return ""
+}
+
+func (x *RuncRestoreArgs) GetLazy() bool {
+	if x != nil {
+		return x.Lazy
+	}
+	return false
+}
+
+func (x *RuncRestoreArgs) GetPageServerAddress() string {
+	if x != nil {
+		return x.PageServerAddress
+	}
+	return ""
+}
+
+func (x *RuncRestoreArgs) GetPageServerPort() int32 {
+	if x != nil {
+		return x.PageServerPort
+	}
+	return 0
+}
+
+func (x *RuncRestoreArgs) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+type RuncOpts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Root            string `protobuf:"bytes,1,opt,name=Root,proto3" json:"Root,omitempty"`
+	ContainerId     string `protobuf:"bytes,2,opt,name=ContainerId,proto3" json:"ContainerId,omitempty"`
+	Bundle          string `protobuf:"bytes,3,opt,name=Bundle,proto3" json:"Bundle,omitempty"`
+	SystemdCgroup   bool   `protobuf:"varint,4,opt,name=SystemdCgroup,proto3" json:"SystemdCgroup,omitempty"`
+	NoPivot         bool   `protobuf:"varint,5,opt,name=NoPivot,proto3" json:"NoPivot,omitempty"`
+	NoMountFallback bool   `protobuf:"varint,6,opt,name=NoMountFallback,proto3" json:"NoMountFallback,omitempty"`
+	NoNewKeyring    bool   `protobuf:"varint,7,opt,name=NoNewKeyring,proto3" json:"NoNewKeyring,omitempty"`
+	Rootless        string `protobuf:"bytes,8,opt,name=Rootless,proto3" json:"Rootless,omitempty"`
+	NoSubreaper     bool   `protobuf:"varint,9,opt,name=NoSubreaper,proto3" json:"NoSubreaper,omitempty"`
+	Keep            bool   `protobuf:"varint,10,opt,name=Keep,proto3" json:"Keep,omitempty"`
+	ConsoleSocket   string `protobuf:"bytes,11,opt,name=ConsoleSocket,proto3" json:"ConsoleSocket,omitempty"`
+	Detatch         bool   `protobuf:"varint,12,opt,name=Detatch,proto3" json:"Detatch,omitempty"`
+	PidFile         string `protobuf:"bytes,13,opt,name=PidFile,proto3" json:"PidFile,omitempty"`
+	PreserveFds     int32  `protobuf:"varint,14,opt,name=PreserveFds,proto3" json:"PreserveFds,omitempty"`
+	NetPid          int32  `protobuf:"varint,15,opt,name=NetPid,proto3" json:"NetPid,omitempty"`
+}
+
+func (x *RuncOpts) Reset() {
+	*x = RuncOpts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuncOpts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuncOpts) ProtoMessage() {}
+
+func (x *RuncOpts) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuncOpts.ProtoReflect.Descriptor instead.
+func (*RuncOpts) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RuncOpts) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *RuncOpts) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *RuncOpts) GetBundle() string {
+	if x != nil {
+		return x.Bundle
+	}
+	return ""
+}
+
+func (x *RuncOpts) GetSystemdCgroup() bool {
+	if x != nil {
+		return x.SystemdCgroup
+	}
+	return false
+}
+
+func (x *RuncOpts) GetNoPivot() bool {
+	if x != nil {
+		return x.NoPivot
+	}
+	return false
+}
+
+func (x *RuncOpts) GetNoMountFallback() bool {
+	if x != nil {
+		return x.NoMountFallback
+	}
+	return false
+}
+
+func (x *RuncOpts) GetNoNewKeyring() bool {
+	if x != nil {
+		return x.NoNewKeyring
+	}
+	return false
+}
+
+func (x *RuncOpts) GetRootless() string {
+	if x != nil {
+		return x.Rootless
+	}
+	return ""
+}
+
+func (x *RuncOpts) GetNoSubreaper() bool {
+	if x != nil {
+		return x.NoSubreaper
+	}
+	return false
+}
+
+func (x *RuncOpts) GetKeep() bool {
+	if x != nil {
+		return x.Keep
+	}
+	return false
+}
+
+func (x *RuncOpts) GetConsoleSocket() string {
+	if x != nil {
+		return x.ConsoleSocket
+	}
+	return ""
+}
+
+func (x *RuncOpts) GetDetatch() bool {
+	if x != nil {
+		return x.Detatch
+	}
+	return false
+}
+
+func (x *RuncOpts) GetPidFile() string {
+	if x != nil {
+		return x.PidFile
+	}
+	return ""
+}
+
+func (x *RuncOpts) GetPreserveFds() int32 {
+	if x != nil {
+		return x.PreserveFds
+	}
+	return 0
+}
+
+func (x *RuncOpts) GetNetPid() int32 {
+	if x != nil {
+		return x.NetPid
+	}
+	return 0
+}
+
+type RuncRestoreResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+}
+
+func (x *RuncRestoreResp) Reset() {
+	*x = RuncRestoreResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_task_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuncRestoreResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuncRestoreResp) ProtoMessage() {}
+
+func (x *RuncRestoreResp) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuncRestoreResp.ProtoReflect.Descriptor instead.
+func (*RuncRestoreResp) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RuncRestoreResp) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_task_proto protoreflect.FileDescriptor
+
+var file_task_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x63, 0x65,
+	0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x22, 0x7b, 0x0a, 0x0b, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x2c, 0x0a, 0x11, 0x50, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x50, 0x61, 0x67, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x44, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x44, 0x69, 0x72, 0x22,
+	0x61, 0x0a, 0x0b, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18,
+	0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x65,
+	0x77, 0x50, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4e, 0x65, 0x77, 0x50,
+	0x49, 0x44, 0x22, 0x2c, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f,
+	0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44,
+	0x22, 0x60, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x61,
+	0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x50, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f,
+	0x72, 0x74, 0x22, 0x46, 0x0a, 0x0e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0xb9, 0x01, 0x0a, 0x10, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x28, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x72, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x3b, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63, 0x65,
+	0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x44, 0x75, 0x6d, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x69,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x44, 0x69, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x4d, 0x61, 0x78, 0x4b, 0x65, 0x65, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x4d,
+	0x61, 0x78, 0x4b, 0x65, 0x65, 0x70, 0x22, 0x6f, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x33, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x42, 0x0a, 0x12,
+	0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x72,
+	0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f,
+	0x62, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44,
+	0x22, 0xa9, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x26,
+	0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xa3, 0x01, 0x0a,
+	0x12, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e,
+	0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x72, 0x65, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x46, 0x72, 0x65, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x46, 0x72, 0x65, 0x65, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x46, 0x72, 0x65, 0x65, 0x64, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x48, 0x6f, 0x73, 0x74, 0x41,
+	0x72, 0x67, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x26, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x41, 0x53,
+	0x53, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x22, 0x88, 0x01, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x37, 0x0a, 0x06, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x65, 0x64, 0x61,
+	0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x26, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x1f, 0x0a, 0x0b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x50, 0x49, 0x44, 0x22, 0xcc, 0x01, 0x0a, 0x07, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49,
+	0x44, 0x12, 0x3f, 0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x46, 0x69, 0x78, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x46, 0x69, 0x78, 0x22, 0x28, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x57,
+	0x41, 0x52, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x52,
+	0x10, 0x02, 0x22, 0x84, 0x01, 0x0a, 0x0b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x04, 0x50, 0x49, 0x44, 0x73, 0x12, 0x39, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e,
+	0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x0b, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12,
+	0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x50, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49, 0x44, 0x12,
+	0x26, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x0d, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x44, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4a,
+	0x6f, 0x62, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x4a, 0x6f, 0x62,
+	0x49, 0x44, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0a, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x69, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x44, 0x69, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x66, 0x0a, 0x0d,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a,
+	0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x3b, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e,
+	0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x22, 0x2c, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x22, 0x4f, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3b, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x22, 0x3c, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x22, 0x4b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0xdb,
+	0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x10, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x53, 0x61, 0x6e, 0x64,
+	0x62, 0x6f, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x55, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x55, 0x69, 0x64, 0x22, 0xa1, 0x01, 0x0a,
+	0x0a, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x0b, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0b, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x22, 0x0a, 0x08, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x22, 0xbe, 0x04, 0x0a, 0x08, 0x44, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x50, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x44, 0x69, 0x72, 0x12, 0x3b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x41,
+	0x72, 0x67, 0x73, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x44,
+	0x75, 0x6d, 0x70, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x11, 0x50, 0x72, 0x65, 0x44, 0x75, 0x6d, 0x70, 0x49, 0x74, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x44, 0x75, 0x6d,
+	0x70, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x50, 0x72, 0x65, 0x44, 0x75, 0x6d, 0x70, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x12, 0x3e, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x34, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52,
+	0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x41, 0x0a, 0x08, 0x43, 0x72, 0x69, 0x75, 0x4f, 0x70, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x72, 0x69, 0x75, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x08, 0x43, 0x72, 0x69, 0x75, 0x4f, 0x70,
+	0x74, 0x73, 0x22, 0x21, 0x0a, 0x08, 0x44, 0x75, 0x6d, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09,
+	0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d,
+	0x4f, 0x54, 0x45, 0x10, 0x01, 0x22, 0x80, 0x01, 0x0a, 0x08, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0xfe, 0x04, 0x0a, 0x0b, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x41, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x26, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x41, 0x0a,
+	0x08, 0x43, 0x72, 0x69, 0x75, 0x4f, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x72, 0x69, 0x75, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x08, 0x43, 0x72, 0x69, 0x75, 0x4f, 0x70, 0x74, 0x73,
+	0x12, 0x37, 0x0a, 0x05, 0x53, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74, 0x64, 0x69, 0x6f, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x52, 0x05, 0x53, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x39, 0x0a, 0x06, 0x53, 0x74, 0x64,
+	0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61,
+	0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x53, 0x74, 0x64, 0x69, 0x6f, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x53, 0x74,
+	0x64, 0x6f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74, 0x64, 0x69,
+	0x6f, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x50, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x50, 0x74,
+	0x79, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x69, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x50, 0x69, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x22, 0x24, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x01, 0x22, 0xa9, 0x01, 0x0a, 0x0b, 0x53, 0x74,
+	0x64, 0x69, 0x6f, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53,
+	0x74, 0x64, 0x69, 0x6f, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x22, 0x2c, 0x0a, 0x0a, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x46, 0x49, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x4f, 0x43,
+	0x4b, 0x45, 0x54, 0x10, 0x02, 0x22, 0x72, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x41,
+	0x72, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x6c, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x43, 0x6f, 0x6c, 0x73, 0x22, 0x3c, 0x0a, 0x0a, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x45, 0x78, 0x69, 0x74, 0x65, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x09, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52,
+	0x09, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
+	0x04, 0x43, 0x50, 0x55, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x04, 0x43,
+	0x50, 0x55, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x50, 0x69, 0x64, 0x73, 0x4d, 0x61,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x07, 0x50, 0x69, 0x64, 0x73, 0x4d,
+	0x61, 0x78, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4d, 0x61, 0x78, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x43, 0x50, 0x55, 0x73, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x50, 0x69, 0x64, 0x73, 0x4d, 0x61, 0x78, 0x22, 0x63, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x41, 0x72, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12,
+	0x3c, 0x0a, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x4d, 0x0a,
+	0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3c,
+	0x0a, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0xad, 0x02, 0x0a,
+	0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x65, 0x77, 0x50, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4e, 0x65, 0x77, 0x50, 0x49, 0x44, 0x12, 0x3d,
+	0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x37, 0x0a,
+	0x05, 0x53, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63,
+	0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74, 0x64, 0x69, 0x6f, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x05, 0x53, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x39, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74,
+	0x64, 0x69, 0x6f, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x53, 0x74, 0x64, 0x6f, 0x75,
+	0x74, 0x12, 0x39, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74, 0x64, 0x69, 0x6f, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x22, 0x89, 0x03, 0x0a,
+	0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44,
+	0x69, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46,
 	0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4c, 0x6f, 0x67, 0x4f, 0x75,
 	0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49, 0x44, 0x18,
 	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x55, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x47, 0x49,
-	0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x47, 0x49, 0x44, 0x22, 0x3b, 0x0a, 0x0d,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a,
-	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49, 0x44, 0x22, 0x70, 0x0a, 0x10, 0x4c, 0x6f, 0x67,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x2a, 0x0a, 0x10, 0x4c,
-	0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xec, 0x04, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61,
-	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x63,
-	0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e,
-	0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x70, 0x74,
-	0x73, 0x52, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e,
-	0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x4f, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x63, 0x65, 0x64, 0x61,
-	0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b,
-	0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x32, 0x0a, 0x04, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x47, 0x49, 0x44, 0x12, 0x52, 0x0a, 0x10,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x10,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x34, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
 	0x1e, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x45, 0x6e, 0x75, 0x6d, 0x52,
-	0x04, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x43, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x65, 0x64,
+	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52,
+	0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x69, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x50, 0x69,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x65, 0x64,
 	0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73,
-	0x6b, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x52,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x47, 0x50,
-	0x55, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0f, 0x47, 0x50, 0x55, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x65, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x0a,
-	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
-	0x52, 0x55, 0x4e, 0x43, 0x10, 0x01, 0x22, 0x6b, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x22, 0xa6, 0x01, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x4f, 0x53,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x4f, 0x53, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x70,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x55, 0x70, 0x74, 0x69,
-	0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x52, 0x65, 0x6d,
-	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x22, 0xe4, 0x02, 0x0a,
-	0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03,
-	0x50, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49, 0x44, 0x12, 0x38,
-	0x0a, 0x17, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x54, 0x6f, 0x48, 0x61, 0x72, 0x64,
-	0x77, 0x61, 0x72, 0x65, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x17, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x54, 0x6f, 0x48, 0x61, 0x72, 0x64, 0x77,
-	0x61, 0x72, 0x65, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x12, 0x3d, 0x0a, 0x07, 0x4f, 0x70, 0x65, 0x6e,
-	0x46, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x65, 0x64, 0x61,
-	0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x52, 0x07,
-	0x4f, 0x70, 0x65, 0x6e, 0x46, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x69,
-	0x6e, 0x67, 0x44, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x57, 0x6f, 0x72,
-	0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x12, 0x4e, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x52, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x49, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x49, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x46, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x46, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x46, 0x0a,
-	0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e,
-	0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x53, 0x74,
-	0x61, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x39, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x54, 0x44, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x0a,
-	0x0a, 0x06, 0x53, 0x54, 0x44, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54,
-	0x44, 0x45, 0x52, 0x52, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x03,
-	0x22, 0xee, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x46, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x02, 0x46, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x30, 0x0a, 0x05, 0x4c, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x05, 0x4c, 0x61, 0x64, 0x64,
-	0x72, 0x12, 0x30, 0x0a, 0x05, 0x52, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x05, 0x52, 0x61,
-	0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x55,
-	0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x55, 0x69, 0x64, 0x73, 0x12,
-	0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49,
-	0x44, 0x22, 0x2a, 0x0a, 0x04, 0x41, 0x64, 0x64, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x50, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x50, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x32, 0x0a,
-	0x18, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0xa7, 0x01, 0x0a, 0x16, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x05,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x65,
+	0x6b, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x52, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x3b, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x50, 0x49, 0x44, 0x22, 0x70, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x2a, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x66, 0x0a, 0x0c, 0x50, 0x69, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x6e, 0x69, 0x74, 0x50, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x49, 0x6e, 0x69, 0x74, 0x50, 0x49, 0x44, 0x12, 0x3c, 0x0a,
+	0x08, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x50, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3c, 0x0a, 0x0a, 0x50,
+	0x69, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x6f, 0x73,
+	0x74, 0x50, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x48, 0x6f, 0x73, 0x74,
+	0x50, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x73, 0x50, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x4e, 0x73, 0x50, 0x49, 0x44, 0x22, 0xe5, 0x08, 0x0a, 0x0c, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x54, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x63, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x63, 0x65, 0x64,
+	0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f,
+	0x70, 0x74, 0x73, 0x52, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x65, 0x64,
+	0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x4f, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x63, 0x65,
 	0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61,
-	0x73, 0x6b, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xbd, 0x01, 0x0a, 0x10,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x12, 0x53, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x3b, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06, 0x52,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x18, 0x0a,
-	0x14, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4a, 0x4f, 0x42, 0x5f, 0x54,
-	0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
-	0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54, 0x10, 0x02, 0x22, 0xab, 0x01, 0x0a, 0x0d,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a,
-	0x09, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x64, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x28, 0x0a, 0x0f, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x16, 0x4d, 0x65, 0x74,
-	0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2e, 0x0a, 0x0c, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x50, 0x69, 0x64, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x42,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x2a, 0x0a, 0x0c, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x50, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x50, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x50, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x0d, 0x43, 0x74, 0x72, 0x42, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x6f,
-	0x6f, 0x74, 0x22, 0x65, 0x0a, 0x0d, 0x43, 0x74, 0x72, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x75, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x52, 0x75, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x63, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x50,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x52, 0x75, 0x6e, 0x63, 0x42,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x1e, 0x0a, 0x08, 0x52, 0x75, 0x6e,
-	0x63, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x2a, 0x0a, 0x08, 0x52, 0x75, 0x6e,
-	0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x47, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x44, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03,
-	0x52, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x52, 0x65, 0x66, 0x22, 0x55,
-	0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x75, 0x6d, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x49, 0x6d, 0x67, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x49, 0x6d, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa2, 0x02, 0x0a, 0x0c,
-	0x52, 0x75, 0x6e, 0x63, 0x44, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x52, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x74,
-	0x12, 0x26, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61,
-	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x08, 0x43, 0x72,
-	0x69, 0x75, 0x4f, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63,
+	0x73, 0x6b, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x45, 0x6e, 0x75,
+	0x6d, 0x52, 0x04, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x43, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63,
 	0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74,
-	0x61, 0x73, 0x6b, 0x2e, 0x43, 0x72, 0x69, 0x75, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x08, 0x43, 0x72,
-	0x69, 0x75, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x63,
-	0x44, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x21, 0x0a,
-	0x08, 0x44, 0x75, 0x6d, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43,
-	0x41, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x01,
-	0x22, 0x4c, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x63, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x92,
-	0x04, 0x0a, 0x08, 0x43, 0x72, 0x69, 0x75, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x57, 0x6f,
-	0x72, 0x6b, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x50,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a,
-	0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x63, 0x70, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x54, 0x63, 0x70, 0x45, 0x73,
-	0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x17, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x12,
-	0x1c, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x50, 0x72, 0x65, 0x44, 0x75, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x50, 0x72, 0x65, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x4e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4e,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x65, 0x64, 0x75, 0x70, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x65, 0x64, 0x75, 0x70, 0x12,
-	0x1c, 0x0a, 0x09, 0x4c, 0x61, 0x7a, 0x79, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x4c, 0x61, 0x7a, 0x79, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x73, 0x6d,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4c,
-	0x73, 0x6d, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x4c, 0x73, 0x6d,
-	0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x11, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x4c, 0x73, 0x6d, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x22, 0xac, 0x02, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x73, 0x4b, 0x33, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x4b, 0x33, 0x73, 0x12, 0x32, 0x0a,
-	0x04, 0x4f, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x65,
+	0x61, 0x73, 0x6b, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x47, 0x50, 0x55, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x47, 0x50, 0x55, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x07, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x65,
 	0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61,
-	0x73, 0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x04, 0x4f, 0x70, 0x74,
-	0x73, 0x12, 0x45, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x31, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x24, 0x0a, 0x0b,
-	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c,
-	0x4f, 0x43, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45,
-	0x10, 0x01, 0x22, 0xcc, 0x03, 0x0a, 0x08, 0x52, 0x75, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52,
-	0x6f, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x24, 0x0a,
-	0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x64, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x64, 0x43, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x6f, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x4e, 0x6f, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x12, 0x28, 0x0a,
-	0x0f, 0x4e, 0x6f, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x4e, 0x6f, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x46,
-	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x4e, 0x6f, 0x4e, 0x65, 0x77,
-	0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x4e,
-	0x6f, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x52,
-	0x6f, 0x6f, 0x74, 0x6c, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52,
-	0x6f, 0x6f, 0x74, 0x6c, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4e, 0x6f, 0x53, 0x75, 0x62,
-	0x72, 0x65, 0x61, 0x70, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x4e, 0x6f,
-	0x53, 0x75, 0x62, 0x72, 0x65, 0x61, 0x70, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x65, 0x65,
-	0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4b, 0x65, 0x65, 0x70, 0x12, 0x24, 0x0a,
-	0x0d, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x53, 0x6f, 0x63,
-	0x6b, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x44, 0x65, 0x74, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a,
-	0x07, 0x50, 0x69, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x50, 0x69, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x46, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x50, 0x72,
-	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x46, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x65, 0x74,
-	0x50, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4e, 0x65, 0x74, 0x50, 0x69,
-	0x64, 0x22, 0x2b, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x86,
-	0x01, 0x0a, 0x08, 0x46, 0x6c, 0x61, 0x67, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x12, 0x4a,
-	0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x55, 0x50, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4a, 0x4f, 0x42, 0x5f, 0x4b, 0x49, 0x4c, 0x4c, 0x45,
-	0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x4f, 0x42, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10,
-	0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4a, 0x4f, 0x42, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
-	0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4a, 0x4f, 0x42, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
-	0x47, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x45, 0x54, 0x55, 0x50,
-	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x4f, 0x42,
-	0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x06, 0x2a, 0x5c, 0x0a, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
-	0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
-	0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10,
-	0x02, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x46, 0x41, 0x49,
-	0x4c, 0x45, 0x44, 0x10, 0x03, 0x32, 0xaa, 0x0a, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x1e, 0x2e,
-	0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x1e, 0x2e,
-	0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a,
-	0x07, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e,
+	0x73, 0x6b, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x07, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e,
 	0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
-	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x21, 0x2e, 0x63, 0x65,
+	0x4a, 0x6f, 0x62, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12,
+	0x48, 0x0a, 0x0d, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x0d, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x74, 0x79,
+	0x4b, 0x65, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x74, 0x79, 0x4b, 0x65,
+	0x79, 0x12, 0x46, 0x0a, 0x0c, 0x50, 0x69, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x50,
+	0x69, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0c, 0x50, 0x69, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x3c, 0x0a, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x54, 0x72, 0x65, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05,
+	0x54, 0x72, 0x65, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22,
+	0x30, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4e, 0x54, 0x41,
+	0x49, 0x4e, 0x45, 0x52, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x55, 0x4e, 0x43, 0x10,
+	0x01, 0x22, 0x61, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x50, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x50, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x50, 0x50, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x53, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6d,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6d, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0xa6,
+	0x01, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x4f, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x4f, 0x53, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a,
+	0x0f, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x22, 0x9f, 0x03, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x17, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x54, 0x6f, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x41,
+	0x63, 0x63, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x65, 0x64, 0x54, 0x6f, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x41, 0x63,
+	0x63, 0x65, 0x6c, 0x12, 0x3d, 0x0a, 0x07, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x52, 0x07, 0x4f, 0x70, 0x65, 0x6e, 0x46,
+	0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44,
+	0x69, 0x72, 0x12, 0x4e, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x65,
 	0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61,
-	0x73, 0x6b, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61,
-	0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x75, 0x6d, 0x70, 0x12,
-	0x27, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x44, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x27, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e,
-	0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
+	0x73, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x52, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x52, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x52,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39,
+	0x0a, 0x05, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x05, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa3, 0x02, 0x0a, 0x0d, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x52,
+	0x53, 0x53, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x52, 0x53, 0x53, 0x12, 0x10, 0x0a,
+	0x03, 0x50, 0x53, 0x53, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x50, 0x53, 0x53, 0x12,
+	0x1c, 0x0a, 0x09, 0x41, 0x6e, 0x6f, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x41, 0x6e, 0x6f, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x50, 0x55, 0x55, 0x73, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x43, 0x50, 0x55, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x43, 0x50, 0x55, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x43, 0x50, 0x55, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x20, 0x0a,
+	0x0b, 0x49, 0x4f, 0x52, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x49, 0x4f, 0x52, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x49, 0x4f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x49, 0x4f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x6b, 0x0a, 0x10, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x39, 0x0a, 0x05, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3f, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x94, 0x01,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x07, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4a, 0x6f, 0x62, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x07, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x22, 0xca, 0x01, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x46, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x46, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x46,
+	0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e,
+	0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x39, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x54, 0x44, 0x49, 0x4e, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x54, 0x44, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x54, 0x44, 0x45, 0x52, 0x52, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x03, 0x22, 0xee, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x46, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x02, 0x46, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x30, 0x0a, 0x05, 0x4c, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x05, 0x4c, 0x61, 0x64,
+	0x64, 0x72, 0x12, 0x30, 0x0a, 0x05, 0x52, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x05, 0x52,
+	0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x55, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x55, 0x69, 0x64, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50,
+	0x49, 0x44, 0x22, 0x2a, 0x0a, 0x04, 0x41, 0x64, 0x64, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x50,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x50, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x9a,
+	0x01, 0x0a, 0x0c, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4d,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x55, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x55, 0x49,
+	0x44, 0x12, 0x10, 0x0a, 0x03, 0x47, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x47, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x32, 0x0a, 0x18, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0xa7, 0x01, 0x0a, 0x16, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x05, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x65, 0x64, 0x61,
+	0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xbd, 0x01, 0x0a, 0x10, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x53,
+	0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b,
+	0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x14, 0x49,
+	0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x45, 0x52,
+	0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x45,
+	0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54, 0x10, 0x02, 0x22, 0xab, 0x01, 0x0a, 0x0d, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x46,
+	0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x46, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x46,
+	0x6f, 0x72, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a,
+	0x0f, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x16, 0x4d, 0x65, 0x74, 0x61, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2e, 0x0a, 0x0c, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x50, 0x69, 0x64, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x2a, 0x0a, 0x0c, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x50, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x50, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x50, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x0d, 0x43, 0x74, 0x72, 0x42, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x52, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x74,
+	0x22, 0x65, 0x0a, 0x0d, 0x43, 0x74, 0x72, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x75, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x52, 0x75,
+	0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x63, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x52, 0x75, 0x6e, 0x63, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x1e, 0x0a, 0x08, 0x52, 0x75, 0x6e, 0x63, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x2a, 0x0a, 0x08, 0x52, 0x75, 0x6e, 0x63, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x73, 0x22, 0x47, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x44, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x52, 0x65,
+	0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x52, 0x65, 0x66, 0x22, 0x55, 0x0a, 0x11,
 	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x6a, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x72, 0x67,
-	0x73, 0x1a, 0x2a, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52, 0x0a,
-	0x08, 0x52, 0x75, 0x6e, 0x63, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x22, 0x2e, 0x63, 0x65, 0x64, 0x61,
+	0x70, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x49,
+	0x6d, 0x67, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x49, 0x6d,
+	0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8c, 0x03, 0x0a, 0x0c, 0x52, 0x75,
+	0x6e, 0x63, 0x44, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f,
+	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x08, 0x43, 0x72, 0x69, 0x75,
+	0x4f, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x65, 0x64,
+	0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x43, 0x72, 0x69, 0x75, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x08, 0x43, 0x72, 0x69, 0x75,
+	0x4f, 0x70, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x63, 0x44, 0x75,
+	0x6d, 0x70, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4c,
+	0x61, 0x7a, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4c, 0x61, 0x7a, 0x79, 0x12,
+	0x2c, 0x0a, 0x11, 0x50, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x50, 0x61, 0x67, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x50, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x50, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x21, 0x0a, 0x08, 0x44, 0x75, 0x6d, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x01, 0x22, 0x68, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x63,
+	0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x75, 0x6d, 0x22, 0x9a, 0x04, 0x0a, 0x0f, 0x43, 0x72, 0x69, 0x75, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x47, 0x68, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x47, 0x68, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x3f, 0x0a, 0x03,
+	0x54, 0x63, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x63, 0x65, 0x64, 0x61,
 	0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b,
-	0x2e, 0x52, 0x75, 0x6e, 0x63, 0x44, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x22, 0x2e,
-	0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x63, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x5b, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x12, 0x25, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x2e, 0x43, 0x72, 0x69, 0x75, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4f, 0x70, 0x74, 0x73,
+	0x2e, 0x54, 0x63, 0x70, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x54, 0x63, 0x70, 0x12, 0x21, 0x0a,
+	0x09, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x21, 0x0a, 0x09, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x6d, 0x61, 0x70, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x09, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x6d, 0x61, 0x70,
+	0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x53, 0x6b, 0x69, 0x70, 0x49, 0x6e, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0c, 0x53, 0x6b, 0x69,
+	0x70, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x11,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x4d, 0x6f, 0x64,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x43,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x08,
+	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x04, 0x52, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x88, 0x01, 0x01, 0x22, 0x3e, 0x0a, 0x07, 0x54, 0x63, 0x70, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x0f, 0x0a, 0x0b, 0x54, 0x43, 0x50, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00,
+	0x12, 0x13, 0x0a, 0x0f, 0x54, 0x43, 0x50, 0x5f, 0x45, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x49, 0x53,
+	0x48, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x43, 0x50, 0x5f, 0x43, 0x4c, 0x4f,
+	0x53, 0x45, 0x10, 0x02, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x63,
+	0x6b, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x6d, 0x61, 0x70,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x53, 0x6b, 0x69, 0x70, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x22,
+	0x92, 0x04, 0x0a, 0x08, 0x43, 0x72, 0x69, 0x75, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x57,
+	0x6f, 0x72, 0x6b, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x63, 0x70, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x54, 0x63, 0x70, 0x45,
+	0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x17, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x4a, 0x6f, 0x62,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x4a, 0x6f, 0x62,
+	0x12, 0x1c, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x50, 0x72, 0x65, 0x44, 0x75, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x50, 0x72, 0x65, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x4e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x4e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x65, 0x64, 0x75, 0x70, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x65, 0x64, 0x75, 0x70,
+	0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x61, 0x7a, 0x79, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x4c, 0x61, 0x7a, 0x79, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x73,
+	0x6d, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x4c, 0x73, 0x6d, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x4c, 0x73,
+	0x6d, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x4c, 0x73, 0x6d, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x22, 0xb2, 0x03, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x63, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x73, 0x4b, 0x33,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x4b, 0x33, 0x73, 0x12, 0x32,
+	0x0a, 0x04, 0x4f, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63,
+	0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x04, 0x4f, 0x70,
+	0x74, 0x73, 0x12, 0x45, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x31, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x25, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61,
+	0x6f, 0x72, 0x65, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x4c, 0x61, 0x7a, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4c, 0x61, 0x7a,
+	0x79, 0x12, 0x2c, 0x0a, 0x11, 0x50, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x50, 0x61,
+	0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x26, 0x0a, 0x0e, 0x50, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x50, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x22, 0x24, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x01, 0x22, 0xcc, 0x03, 0x0a, 0x08, 0x52, 0x75,
+	0x6e, 0x63, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x64, 0x43,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x64, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x6f,
+	0x50, 0x69, 0x76, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x4e, 0x6f, 0x50,
+	0x69, 0x76, 0x6f, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x4e, 0x6f, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x46,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x4e,
+	0x6f, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x22,
+	0x0a, 0x0c, 0x4e, 0x6f, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x4e, 0x6f, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x74, 0x6c, 0x65, 0x73, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x6f, 0x6f, 0x74, 0x6c, 0x65, 0x73, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x4e, 0x6f, 0x53, 0x75, 0x62, 0x72, 0x65, 0x61, 0x70, 0x65, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x4e, 0x6f, 0x53, 0x75, 0x62, 0x72, 0x65, 0x61, 0x70, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x4b, 0x65, 0x65, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x4b, 0x65, 0x65, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x53,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x43, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x65, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65,
+	0x74, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x44, 0x65, 0x74,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x69, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x69, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x50, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x46, 0x64, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x50, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x46, 0x64, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x4e, 0x65, 0x74, 0x50, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x4e, 0x65, 0x74, 0x50, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x63,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x32, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12,
+	0x08, 0x0a, 0x04, 0x54, 0x52, 0x45, 0x45, 0x10, 0x02, 0x2a, 0x3d, 0x0a, 0x0b, 0x46, 0x69, 0x6c,
+	0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x5f, 0x46,
+	0x49, 0x4c, 0x45, 0x53, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x52, 0x49, 0x54, 0x54, 0x45,
+	0x4e, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x4c, 0x4c,
+	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x10, 0x02, 0x2a, 0x86, 0x01, 0x0a, 0x08, 0x46, 0x6c, 0x61,
+	0x67, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41,
+	0x52, 0x54, 0x55, 0x50, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a,
+	0x0a, 0x4a, 0x4f, 0x42, 0x5f, 0x4b, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x4a, 0x4f, 0x42, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4a,
+	0x4f, 0x42, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4a, 0x4f, 0x42, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x14, 0x0a,
+	0x10, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x45, 0x54, 0x55, 0x50, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x4f, 0x42, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10,
+	0x06, 0x2a, 0x5c, 0x0a, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49,
+	0x4e, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50,
+	0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x52,
+	0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x32,
+	0x8d, 0x13, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x46, 0x0a, 0x04, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x1e, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44,
+	0x75, 0x6d, 0x70, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x1e, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44,
+	0x75, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x27, 0x2e, 0x63, 0x65, 0x64, 0x61,
+	0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x75, 0x6d, 0x70, 0x41, 0x72,
+	0x67, 0x73, 0x1a, 0x27, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x10, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12,
+	0x2a, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x2a, 0x2e, 0x63, 0x65,
+	0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x08, 0x52, 0x75, 0x6e, 0x63, 0x44,
+	0x75, 0x6d, 0x70, 0x12, 0x22, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x63, 0x44,
+	0x75, 0x6d, 0x70, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x22, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52,
-	0x75, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55,
-	0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x23, 0x2e, 0x63, 0x65,
+	0x75, 0x6e, 0x63, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x0b, 0x52,
+	0x75, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x65, 0x64,
+	0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x72, 0x67,
+	0x73, 0x1a, 0x25, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x63, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x23, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x23, 0x2e, 0x63, 0x65, 0x64,
+	0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x62, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12,
+	0x26, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x1a, 0x26, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4c,
+	0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x67, 0x73, 0x28,
+	0x01, 0x30, 0x01, 0x12, 0x6e, 0x0a, 0x14, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x2e, 0x63, 0x65,
+	0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x1a, 0x22, 0x2e, 0x63, 0x65,
+	0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x28,
+	0x01, 0x30, 0x01, 0x12, 0x74, 0x0a, 0x12, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x2e, 0x63, 0x65, 0x64, 0x61,
+	0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x2c, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x28, 0x01, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x75, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12,
+	0x1e, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x63, 0x52, 0x6f, 0x6f, 0x74, 0x1a,
+	0x1e, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x62, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x2e, 0x63, 0x65, 0x64, 0x61,
+	0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x43, 0x74, 0x72, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x23,
+	0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x74, 0x72, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x50,
+	0x69, 0x64, 0x12, 0x22, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x50,
+	0x69, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x22, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x50, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x0e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x63,
+	0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x1e, 0x2e, 0x63,
+	0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a, 0x07,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x21, 0x2e, 0x63, 0x65, 0x64,
+	0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a,
+	0x10, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2a, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x2a, 0x2e,
+	0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x74, 0x61, 0x73, 0x6b, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x11, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x28, 0x01, 0x12, 0x73, 0x0a, 0x13, 0x53, 0x65, 0x74,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x2d, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x72, 0x67, 0x73, 0x1a,
+	0x2d, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64,
+	0x0a, 0x0e, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x12, 0x28, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x28, 0x2e, 0x63, 0x65, 0x64,
+	0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x09, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x48, 0x6f, 0x73,
+	0x74, 0x12, 0x23, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x48, 0x6f,
+	0x73, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x23, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a, 0x07, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x21, 0x2e, 0x63, 0x65, 0x64, 0x61,
+	0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x09,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x23, 0x2e, 0x63, 0x65, 0x64, 0x61,
+	0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x75, 0x6d, 0x70, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x23,
+	0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x75, 0x6d, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x26, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x26, 0x2e, 0x63, 0x65,
 	0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61,
-	0x73, 0x6b, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x72, 0x67, 0x73,
-	0x1a, 0x23, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4c, 0x6f, 0x67,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x1a, 0x26, 0x2e,
+	0x73, 0x6b, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x06, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x20, 0x2e,
 	0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x41, 0x72, 0x67, 0x73, 0x28, 0x01, 0x30, 0x01, 0x12, 0x6e, 0x0a, 0x14, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x12, 0x2e, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x1a, 0x22, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x74, 0x0a, 0x12, 0x4d, 0x65, 0x74,
-	0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12,
-	0x2c, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x2c, 0x2e,
+	0x74, 0x61, 0x73, 0x6b, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x41, 0x72, 0x67, 0x73, 0x1a,
+	0x20, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x28, 0x01, 0x30, 0x01, 0x12, 0x55, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a,
+	0x6f, 0x62, 0x12, 0x23, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4a, 0x6f, 0x62, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x23, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x27, 0x2e,
 	0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x28, 0x01, 0x30, 0x01, 0x12,
-	0x54, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x75, 0x6e,
-	0x63, 0x52, 0x6f, 0x6f, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x75, 0x6e,
-	0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x63,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x23, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x74, 0x72, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x41, 0x72, 0x67, 0x73, 0x1a, 0x23, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x43, 0x74, 0x72, 0x42,
-	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x50, 0x61, 0x75, 0x73, 0x65, 0x50, 0x69, 0x64, 0x12, 0x22, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e,
-	0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
-	0x50, 0x61, 0x75, 0x73, 0x65, 0x50, 0x69, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x22, 0x2e, 0x63,
-	0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74,
-	0x61, 0x73, 0x6b, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x50, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x50, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72,
-	0x67, 0x73, 0x1a, 0x1e, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2f, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x61, 0x73, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x27, 0x2e, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42,
+	0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x65,
+	0x64, 0x61, 0x6e, 0x61, 0x2f, 0x63, 0x65, 0x64, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3758,116 +7423,225 @@ func file_task_proto_rawDescGZIP() []byte {
 	return file_task_proto_rawDescData
 }
 
-var file_task_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
-var file_task_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_task_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
+var file_task_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
 var file_task_proto_goTypes = []interface{}{
-	(FlagEnum)(0),                              // 0: cedana.services.task.FlagEnum
-	(CheckpointState)(0),                       // 1: cedana.services.task.checkpointState
-	(DumpArgs_DumpType)(0),                     // 2: cedana.services.task.DumpArgs.DumpType
-	(RestoreArgs_RestoreType)(0),               // 3: cedana.services.task.RestoreArgs.RestoreType
-	(ProcessState_ContainerRuntimeOpts)(0),     // 4: cedana.services.task.ProcessState.ContainerRuntimeOpts
-	(OpenFilesStat_StreamType)(0),              // 5: cedana.services.task.OpenFilesStat.StreamType
-	(CheckpointReason_CheckpointReasonEnum)(0), // 6: cedana.services.task.CheckpointReason.CheckpointReasonEnum
-	(RuncDumpArgs_DumpType)(0),                 // 7: cedana.services.task.RuncDumpArgs.DumpType
-	(RuncRestoreArgs_RestoreType)(0),           // 8: cedana.services.task.RuncRestoreArgs.RestoreType
-	(*ListArgs)(nil),                           // 9: cedana.services.task.ListArgs
-	(*ListResp)(nil),                           // 10: cedana.services.task.ListResp
-	(*Container)(nil),                          // 11: cedana.services.task.Container
-	(*Annotation)(nil),                         // 12: cedana.services.task.Annotation
-	(*External)(nil),                           // 13: cedana.services.task.External
-	(*DumpArgs)(nil),                           // 14: cedana.services.task.DumpArgs
-	(*DumpResp)(nil),                           // 15: cedana.services.task.DumpResp
-	(*RestoreArgs)(nil),                        // 16: cedana.services.task.RestoreArgs
-	(*RestoreResp)(nil),                        // 17: cedana.services.task.RestoreResp
-	(*StartTaskArgs)(nil),                      // 18: cedana.services.task.StartTaskArgs
-	(*StartTaskResp)(nil),                      // 19: cedana.services.task.StartTaskResp
-	(*LogStreamingArgs)(nil),                   // 20: cedana.services.task.LogStreamingArgs
-	(*LogStreamingResp)(nil),                   // 21: cedana.services.task.LogStreamingResp
-	(*ProcessState)(nil),                       // 22: cedana.services.task.ProcessState
-	(*RemoteState)(nil),                        // 23: cedana.services.task.RemoteState
-	(*ClientInfo)(nil),                         // 24: cedana.services.task.ClientInfo
-	(*ProcessInfo)(nil),                        // 25: cedana.services.task.ProcessInfo
-	(*OpenFilesStat)(nil),                      // 26: cedana.services.task.OpenFilesStat
-	(*ConnectionStat)(nil),                     // 27: cedana.services.task.ConnectionStat
-	(*Addr)(nil),                               // 28: cedana.services.task.Addr
-	(*ClientStateStreamingResp)(nil),           // 29: cedana.services.task.ClientStateStreamingResp
-	(*MetaStateStreamingArgs)(nil),             // 30: cedana.services.task.MetaStateStreamingArgs
-	(*CheckpointReason)(nil),                   // 31: cedana.services.task.CheckpointReason
-	(*ProviderEvent)(nil),                      // 32: cedana.services.task.ProviderEvent
-	(*MetaStateStreamingResp)(nil),             // 33: cedana.services.task.MetaStateStreamingResp
-	(*PausePidArgs)(nil),                       // 34: cedana.services.task.PausePidArgs
-	(*PausePidResp)(nil),                       // 35: cedana.services.task.PausePidResp
-	(*CtrByNameArgs)(nil),                      // 36: cedana.services.task.CtrByNameArgs
-	(*CtrByNameResp)(nil),                      // 37: cedana.services.task.CtrByNameResp
-	(*RuncRoot)(nil),                           // 38: cedana.services.task.RuncRoot
-	(*RuncList)(nil),                           // 39: cedana.services.task.RuncList
-	(*ContainerDumpArgs)(nil),                  // 40: cedana.services.task.ContainerDumpArgs
-	(*ContainerDumpResp)(nil),                  // 41: cedana.services.task.ContainerDumpResp
-	(*ContainerRestoreArgs)(nil),               // 42: cedana.services.task.ContainerRestoreArgs
-	(*ContainerRestoreResp)(nil),               // 43: cedana.services.task.ContainerRestoreResp
-	(*RuncDumpArgs)(nil),                       // 44: cedana.services.task.RuncDumpArgs
-	(*RuncDumpResp)(nil),                       // 45: cedana.services.task.RuncDumpResp
-	(*CriuOpts)(nil),                           // 46: cedana.services.task.CriuOpts
-	(*RuncRestoreArgs)(nil),                    // 47: cedana.services.task.RuncRestoreArgs
-	(*RuncOpts)(nil),                           // 48: cedana.services.task.RuncOpts
-	(*RuncRestoreResp)(nil),                    // 49: cedana.services.task.RuncRestoreResp
-	nil,                                        // 50: cedana.services.task.Annotation.AnnotationsEntry
+	(JobScope)(0),                              // 0: cedana.services.task.JobScope
+	(FileCapture)(0),                           // 1: cedana.services.task.FileCapture
+	(FlagEnum)(0),                              // 2: cedana.services.task.FlagEnum
+	(CheckpointState)(0),                       // 3: cedana.services.task.checkpointState
+	(HostCheck_Status)(0),                      // 4: cedana.services.task.HostCheck.Status
+	(Finding_Level)(0),                         // 5: cedana.services.task.Finding.Level
+	(DumpArgs_DumpType)(0),                     // 6: cedana.services.task.DumpArgs.DumpType
+	(RestoreArgs_RestoreType)(0),               // 7: cedana.services.task.RestoreArgs.RestoreType
+	(StdioTarget_TargetType)(0),                // 8: cedana.services.task.StdioTarget.TargetType
+	(ProcessState_ContainerRuntimeOpts)(0),     // 9: cedana.services.task.ProcessState.ContainerRuntimeOpts
+	(OpenFilesStat_StreamType)(0),              // 10: cedana.services.task.OpenFilesStat.StreamType
+	(CheckpointReason_CheckpointReasonEnum)(0), // 11: cedana.services.task.CheckpointReason.CheckpointReasonEnum
+	(RuncDumpArgs_DumpType)(0),                 // 12: cedana.services.task.RuncDumpArgs.DumpType
+	(CriuProcessOpts_TcpMode)(0),               // 13: cedana.services.task.CriuProcessOpts.TcpMode
+	(RuncRestoreArgs_RestoreType)(0),           // 14: cedana.services.task.RuncRestoreArgs.RestoreType
+	(*MigrateArgs)(nil),                        // 15: cedana.services.task.MigrateArgs
+	(*MigrateResp)(nil),                        // 16: cedana.services.task.MigrateResp
+	(*PrepareMigrationArgs)(nil),               // 17: cedana.services.task.PrepareMigrationArgs
+	(*PrepareMigrationResp)(nil),               // 18: cedana.services.task.PrepareMigrationResp
+	(*MigrationChunk)(nil),                     // 19: cedana.services.task.MigrationChunk
+	(*CheckpointPolicy)(nil),                   // 20: cedana.services.task.CheckpointPolicy
+	(*SetCheckpointPolicyArgs)(nil),            // 21: cedana.services.task.SetCheckpointPolicyArgs
+	(*SetCheckpointPolicyResp)(nil),            // 22: cedana.services.task.SetCheckpointPolicyResp
+	(*GarbageCollectArgs)(nil),                 // 23: cedana.services.task.GarbageCollectArgs
+	(*CollectedCheckpoint)(nil),                // 24: cedana.services.task.CollectedCheckpoint
+	(*GarbageCollectResp)(nil),                 // 25: cedana.services.task.GarbageCollectResp
+	(*CheckHostArgs)(nil),                      // 26: cedana.services.task.CheckHostArgs
+	(*HostCheck)(nil),                          // 27: cedana.services.task.HostCheck
+	(*CheckHostResp)(nil),                      // 28: cedana.services.task.CheckHostResp
+	(*AnalyzeArgs)(nil),                        // 29: cedana.services.task.AnalyzeArgs
+	(*Finding)(nil),                            // 30: cedana.services.task.Finding
+	(*AnalyzeResp)(nil),                        // 31: cedana.services.task.AnalyzeResp
+	(*GroupMember)(nil),                        // 32: cedana.services.task.GroupMember
+	(*GroupDumpArgs)(nil),                      // 33: cedana.services.task.GroupDumpArgs
+	(*GroupDumpResp)(nil),                      // 34: cedana.services.task.GroupDumpResp
+	(*GroupRestoreArgs)(nil),                   // 35: cedana.services.task.GroupRestoreArgs
+	(*GroupRestoreResp)(nil),                   // 36: cedana.services.task.GroupRestoreResp
+	(*ListArgs)(nil),                           // 37: cedana.services.task.ListArgs
+	(*ListResp)(nil),                           // 38: cedana.services.task.ListResp
+	(*Container)(nil),                          // 39: cedana.services.task.Container
+	(*Annotation)(nil),                         // 40: cedana.services.task.Annotation
+	(*External)(nil),                           // 41: cedana.services.task.External
+	(*DumpArgs)(nil),                           // 42: cedana.services.task.DumpArgs
+	(*DumpResp)(nil),                           // 43: cedana.services.task.DumpResp
+	(*RestoreArgs)(nil),                        // 44: cedana.services.task.RestoreArgs
+	(*StdioTarget)(nil),                        // 45: cedana.services.task.StdioTarget
+	(*AttachArgs)(nil),                         // 46: cedana.services.task.AttachArgs
+	(*AttachResp)(nil),                         // 47: cedana.services.task.AttachResp
+	(*ResourceLimits)(nil),                     // 48: cedana.services.task.ResourceLimits
+	(*UpdateJobArgs)(nil),                      // 49: cedana.services.task.UpdateJobArgs
+	(*UpdateJobResp)(nil),                      // 50: cedana.services.task.UpdateJobResp
+	(*RestoreResp)(nil),                        // 51: cedana.services.task.RestoreResp
+	(*StartTaskArgs)(nil),                      // 52: cedana.services.task.StartTaskArgs
+	(*StartTaskResp)(nil),                      // 53: cedana.services.task.StartTaskResp
+	(*LogStreamingArgs)(nil),                   // 54: cedana.services.task.LogStreamingArgs
+	(*LogStreamingResp)(nil),                   // 55: cedana.services.task.LogStreamingResp
+	(*PidNamespace)(nil),                       // 56: cedana.services.task.PidNamespace
+	(*PidMapping)(nil),                         // 57: cedana.services.task.PidMapping
+	(*ProcessState)(nil),                       // 58: cedana.services.task.ProcessState
+	(*ProcessMember)(nil),                      // 59: cedana.services.task.ProcessMember
+	(*RemoteState)(nil),                        // 60: cedana.services.task.RemoteState
+	(*ClientInfo)(nil),                         // 61: cedana.services.task.ClientInfo
+	(*ProcessInfo)(nil),                        // 62: cedana.services.task.ProcessInfo
+	(*ResourceUsage)(nil),                      // 63: cedana.services.task.ResourceUsage
+	(*JobMetricsSample)(nil),                   // 64: cedana.services.task.JobMetricsSample
+	(*GetJobMetricsArgs)(nil),                  // 65: cedana.services.task.GetJobMetricsArgs
+	(*GetJobMetricsResp)(nil),                  // 66: cedana.services.task.GetJobMetricsResp
+	(*OpenFilesStat)(nil),                      // 67: cedana.services.task.OpenFilesStat
+	(*ConnectionStat)(nil),                     // 68: cedana.services.task.ConnectionStat
+	(*Addr)(nil),                               // 69: cedana.services.task.Addr
+	(*CapturedFile)(nil),                       // 70: cedana.services.task.CapturedFile
+	(*ClientStateStreamingResp)(nil),           // 71: cedana.services.task.ClientStateStreamingResp
+	(*MetaStateStreamingArgs)(nil),             // 72: cedana.services.task.MetaStateStreamingArgs
+	(*CheckpointReason)(nil),                   // 73: cedana.services.task.CheckpointReason
+	(*ProviderEvent)(nil),                      // 74: cedana.services.task.ProviderEvent
+	(*MetaStateStreamingResp)(nil),             // 75: cedana.services.task.MetaStateStreamingResp
+	(*PausePidArgs)(nil),                       // 76: cedana.services.task.PausePidArgs
+	(*PausePidResp)(nil),                       // 77: cedana.services.task.PausePidResp
+	(*CtrByNameArgs)(nil),                      // 78: cedana.services.task.CtrByNameArgs
+	(*CtrByNameResp)(nil),                      // 79: cedana.services.task.CtrByNameResp
+	(*RuncRoot)(nil),                           // 80: cedana.services.task.RuncRoot
+	(*RuncList)(nil),                           // 81: cedana.services.task.RuncList
+	(*ContainerDumpArgs)(nil),                  // 82: cedana.services.task.ContainerDumpArgs
+	(*ContainerDumpResp)(nil),                  // 83: cedana.services.task.ContainerDumpResp
+	(*ContainerRestoreArgs)(nil),               // 84: cedana.services.task.ContainerRestoreArgs
+	(*ContainerRestoreResp)(nil),               // 85: cedana.services.task.ContainerRestoreResp
+	(*RuncDumpArgs)(nil),                       // 86: cedana.services.task.RuncDumpArgs
+	(*RuncDumpResp)(nil),                       // 87: cedana.services.task.RuncDumpResp
+	(*CriuProcessOpts)(nil),                    // 88: cedana.services.task.CriuProcessOpts
+	(*CriuOpts)(nil),                           // 89: cedana.services.task.CriuOpts
+	(*RuncRestoreArgs)(nil),                    // 90: cedana.services.task.RuncRestoreArgs
+	(*RuncOpts)(nil),                           // 91: cedana.services.task.RuncOpts
+	(*RuncRestoreResp)(nil),                    // 92: cedana.services.task.RuncRestoreResp
+	nil,                                        // 93: cedana.services.task.Annotation.AnnotationsEntry
 }
 var file_task_proto_depIdxs = []int32{
-	11, // 0: cedana.services.task.ListResp.containers:type_name -> cedana.services.task.Container
-	50, // 1: cedana.services.task.Annotation.Annotations:type_name -> cedana.services.task.Annotation.AnnotationsEntry
-	2,  // 2: cedana.services.task.DumpArgs.Type:type_name -> cedana.services.task.DumpArgs.DumpType
-	3,  // 3: cedana.services.task.RestoreArgs.Type:type_name -> cedana.services.task.RestoreArgs.RestoreType
-	4,  // 4: cedana.services.task.ProcessState.ContainerRuntime:type_name -> cedana.services.task.ProcessState.ContainerRuntimeOpts
-	25, // 5: cedana.services.task.ProcessState.ProcessInfo:type_name -> cedana.services.task.ProcessInfo
-	1,  // 6: cedana.services.task.ProcessState.CheckpointState:type_name -> cedana.services.task.checkpointState
-	0,  // 7: cedana.services.task.ProcessState.Flag:type_name -> cedana.services.task.FlagEnum
-	23, // 8: cedana.services.task.ProcessState.RemoteState:type_name -> cedana.services.task.RemoteState
-	26, // 9: cedana.services.task.ProcessInfo.OpenFds:type_name -> cedana.services.task.OpenFilesStat
-	27, // 10: cedana.services.task.ProcessInfo.OpenConnections:type_name -> cedana.services.task.ConnectionStat
-	5,  // 11: cedana.services.task.OpenFilesStat.Stream:type_name -> cedana.services.task.OpenFilesStat.StreamType
-	28, // 12: cedana.services.task.ConnectionStat.Laddr:type_name -> cedana.services.task.Addr
-	28, // 13: cedana.services.task.ConnectionStat.Raddr:type_name -> cedana.services.task.Addr
-	32, // 14: cedana.services.task.MetaStateStreamingArgs.Event:type_name -> cedana.services.task.ProviderEvent
-	31, // 15: cedana.services.task.MetaStateStreamingArgs.CheckpointReason:type_name -> cedana.services.task.CheckpointReason
-	6,  // 16: cedana.services.task.CheckpointReason.Reason:type_name -> cedana.services.task.CheckpointReason.CheckpointReasonEnum
-	46, // 17: cedana.services.task.RuncDumpArgs.CriuOpts:type_name -> cedana.services.task.CriuOpts
-	7,  // 18: cedana.services.task.RuncDumpArgs.Type:type_name -> cedana.services.task.RuncDumpArgs.DumpType
-	48, // 19: cedana.services.task.RuncRestoreArgs.Opts:type_name -> cedana.services.task.RuncOpts
-	8,  // 20: cedana.services.task.RuncRestoreArgs.Type:type_name -> cedana.services.task.RuncRestoreArgs.RestoreType
-	14, // 21: cedana.services.task.TaskService.Dump:input_type -> cedana.services.task.DumpArgs
-	16, // 22: cedana.services.task.TaskService.Restore:input_type -> cedana.services.task.RestoreArgs
-	40, // 23: cedana.services.task.TaskService.ContainerDump:input_type -> cedana.services.task.ContainerDumpArgs
-	42, // 24: cedana.services.task.TaskService.ContainerRestore:input_type -> cedana.services.task.ContainerRestoreArgs
-	44, // 25: cedana.services.task.TaskService.RuncDump:input_type -> cedana.services.task.RuncDumpArgs
-	47, // 26: cedana.services.task.TaskService.RuncRestore:input_type -> cedana.services.task.RuncRestoreArgs
-	18, // 27: cedana.services.task.TaskService.StartTask:input_type -> cedana.services.task.StartTaskArgs
-	21, // 28: cedana.services.task.TaskService.LogStreaming:input_type -> cedana.services.task.LogStreamingResp
-	29, // 29: cedana.services.task.TaskService.ClientStateStreaming:input_type -> cedana.services.task.ClientStateStreamingResp
-	30, // 30: cedana.services.task.TaskService.MetaStateStreaming:input_type -> cedana.services.task.MetaStateStreamingArgs
-	38, // 31: cedana.services.task.TaskService.ListRuncContainers:input_type -> cedana.services.task.RuncRoot
-	36, // 32: cedana.services.task.TaskService.GetRuncContainerByName:input_type -> cedana.services.task.CtrByNameArgs
-	34, // 33: cedana.services.task.TaskService.GetPausePid:input_type -> cedana.services.task.PausePidArgs
-	9,  // 34: cedana.services.task.TaskService.ListContainers:input_type -> cedana.services.task.ListArgs
-	15, // 35: cedana.services.task.TaskService.Dump:output_type -> cedana.services.task.DumpResp
-	17, // 36: cedana.services.task.TaskService.Restore:output_type -> cedana.services.task.RestoreResp
-	41, // 37: cedana.services.task.TaskService.ContainerDump:output_type -> cedana.services.task.ContainerDumpResp
-	43, // 38: cedana.services.task.TaskService.ContainerRestore:output_type -> cedana.services.task.ContainerRestoreResp
-	45, // 39: cedana.services.task.TaskService.RuncDump:output_type -> cedana.services.task.RuncDumpResp
-	49, // 40: cedana.services.task.TaskService.RuncRestore:output_type -> cedana.services.task.RuncRestoreResp
-	19, // 41: cedana.services.task.TaskService.StartTask:output_type -> cedana.services.task.StartTaskResp
-	20, // 42: cedana.services.task.TaskService.LogStreaming:output_type -> cedana.services.task.LogStreamingArgs
-	22, // 43: cedana.services.task.TaskService.ClientStateStreaming:output_type -> cedana.services.task.ProcessState
-	33, // 44: cedana.services.task.TaskService.MetaStateStreaming:output_type -> cedana.services.task.MetaStateStreamingResp
-	39, // 45: cedana.services.task.TaskService.ListRuncContainers:output_type -> cedana.services.task.RuncList
-	37, // 46: cedana.services.task.TaskService.GetRuncContainerByName:output_type -> cedana.services.task.CtrByNameResp
-	35, // 47: cedana.services.task.TaskService.GetPausePid:output_type -> cedana.services.task.PausePidResp
-	10, // 48: cedana.services.task.TaskService.ListContainers:output_type -> cedana.services.task.ListResp
-	35, // [35:49] is the sub-list for method output_type
-	21, // [21:35] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	6,  // 0: cedana.services.task.CheckpointPolicy.Type:type_name -> cedana.services.task.DumpArgs.DumpType
+	20, // 1: cedana.services.task.SetCheckpointPolicyArgs.Policy:type_name -> cedana.services.task.CheckpointPolicy
+	24, // 2: cedana.services.task.GarbageCollectResp.Checkpoints:type_name -> cedana.services.task.CollectedCheckpoint
+	4,  // 3: cedana.services.task.HostCheck.Result:type_name -> cedana.services.task.HostCheck.Status
+	27, // 4: cedana.services.task.CheckHostResp.Checks:type_name -> cedana.services.task.HostCheck
+	4,  // 5: cedana.services.task.CheckHostResp.Result:type_name -> cedana.services.task.HostCheck.Status
+	5,  // 6: cedana.services.task.Finding.Severity:type_name -> cedana.services.task.Finding.Level
+	30, // 7: cedana.services.task.AnalyzeResp.Findings:type_name -> cedana.services.task.Finding
+	32, // 8: cedana.services.task.GroupDumpArgs.Containers:type_name -> cedana.services.task.GroupMember
+	32, // 9: cedana.services.task.GroupDumpResp.Members:type_name -> cedana.services.task.GroupMember
+	32, // 10: cedana.services.task.GroupRestoreResp.Members:type_name -> cedana.services.task.GroupMember
+	39, // 11: cedana.services.task.ListResp.containers:type_name -> cedana.services.task.Container
+	93, // 12: cedana.services.task.Annotation.Annotations:type_name -> cedana.services.task.Annotation.AnnotationsEntry
+	6,  // 13: cedana.services.task.DumpArgs.Type:type_name -> cedana.services.task.DumpArgs.DumpType
+	73, // 14: cedana.services.task.DumpArgs.Reason:type_name -> cedana.services.task.CheckpointReason
+	0,  // 15: cedana.services.task.DumpArgs.Scope:type_name -> cedana.services.task.JobScope
+	1,  // 16: cedana.services.task.DumpArgs.Files:type_name -> cedana.services.task.FileCapture
+	88, // 17: cedana.services.task.DumpArgs.CriuOpts:type_name -> cedana.services.task.CriuProcessOpts
+	7,  // 18: cedana.services.task.RestoreArgs.Type:type_name -> cedana.services.task.RestoreArgs.RestoreType
+	88, // 19: cedana.services.task.RestoreArgs.CriuOpts:type_name -> cedana.services.task.CriuProcessOpts
+	45, // 20: cedana.services.task.RestoreArgs.Stdin:type_name -> cedana.services.task.StdioTarget
+	45, // 21: cedana.services.task.RestoreArgs.Stdout:type_name -> cedana.services.task.StdioTarget
+	45, // 22: cedana.services.task.RestoreArgs.Stderr:type_name -> cedana.services.task.StdioTarget
+	48, // 23: cedana.services.task.RestoreArgs.Limits:type_name -> cedana.services.task.ResourceLimits
+	8,  // 24: cedana.services.task.StdioTarget.Type:type_name -> cedana.services.task.StdioTarget.TargetType
+	48, // 25: cedana.services.task.UpdateJobArgs.Limits:type_name -> cedana.services.task.ResourceLimits
+	48, // 26: cedana.services.task.UpdateJobResp.Limits:type_name -> cedana.services.task.ResourceLimits
+	59, // 27: cedana.services.task.RestoreResp.Members:type_name -> cedana.services.task.ProcessMember
+	45, // 28: cedana.services.task.RestoreResp.Stdin:type_name -> cedana.services.task.StdioTarget
+	45, // 29: cedana.services.task.RestoreResp.Stdout:type_name -> cedana.services.task.StdioTarget
+	45, // 30: cedana.services.task.RestoreResp.Stderr:type_name -> cedana.services.task.StdioTarget
+	20, // 31: cedana.services.task.StartTaskArgs.CheckpointPolicy:type_name -> cedana.services.task.CheckpointPolicy
+	0,  // 32: cedana.services.task.StartTaskArgs.Scope:type_name -> cedana.services.task.JobScope
+	48, // 33: cedana.services.task.StartTaskArgs.Limits:type_name -> cedana.services.task.ResourceLimits
+	57, // 34: cedana.services.task.PidNamespace.Mappings:type_name -> cedana.services.task.PidMapping
+	9,  // 35: cedana.services.task.ProcessState.ContainerRuntime:type_name -> cedana.services.task.ProcessState.ContainerRuntimeOpts
+	62, // 36: cedana.services.task.ProcessState.ProcessInfo:type_name -> cedana.services.task.ProcessInfo
+	3,  // 37: cedana.services.task.ProcessState.CheckpointState:type_name -> cedana.services.task.checkpointState
+	2,  // 38: cedana.services.task.ProcessState.Flag:type_name -> cedana.services.task.FlagEnum
+	60, // 39: cedana.services.task.ProcessState.RemoteState:type_name -> cedana.services.task.RemoteState
+	73, // 40: cedana.services.task.ProcessState.CheckpointReason:type_name -> cedana.services.task.CheckpointReason
+	59, // 41: cedana.services.task.ProcessState.Members:type_name -> cedana.services.task.ProcessMember
+	0,  // 42: cedana.services.task.ProcessState.Scope:type_name -> cedana.services.task.JobScope
+	70, // 43: cedana.services.task.ProcessState.CapturedFiles:type_name -> cedana.services.task.CapturedFile
+	56, // 44: cedana.services.task.ProcessState.PidNamespace:type_name -> cedana.services.task.PidNamespace
+	48, // 45: cedana.services.task.ProcessState.Limits:type_name -> cedana.services.task.ResourceLimits
+	67, // 46: cedana.services.task.ProcessInfo.OpenFds:type_name -> cedana.services.task.OpenFilesStat
+	68, // 47: cedana.services.task.ProcessInfo.OpenConnections:type_name -> cedana.services.task.ConnectionStat
+	63, // 48: cedana.services.task.ProcessInfo.Usage:type_name -> cedana.services.task.ResourceUsage
+	63, // 49: cedana.services.task.JobMetricsSample.Usage:type_name -> cedana.services.task.ResourceUsage
+	64, // 50: cedana.services.task.GetJobMetricsResp.Samples:type_name -> cedana.services.task.JobMetricsSample
+	63, // 51: cedana.services.task.GetJobMetricsResp.Current:type_name -> cedana.services.task.ResourceUsage
+	10, // 52: cedana.services.task.OpenFilesStat.Stream:type_name -> cedana.services.task.OpenFilesStat.StreamType
+	69, // 53: cedana.services.task.ConnectionStat.Laddr:type_name -> cedana.services.task.Addr
+	69, // 54: cedana.services.task.ConnectionStat.Raddr:type_name -> cedana.services.task.Addr
+	74, // 55: cedana.services.task.MetaStateStreamingArgs.Event:type_name -> cedana.services.task.ProviderEvent
+	73, // 56: cedana.services.task.MetaStateStreamingArgs.CheckpointReason:type_name -> cedana.services.task.CheckpointReason
+	11, // 57: cedana.services.task.CheckpointReason.Reason:type_name -> cedana.services.task.CheckpointReason.CheckpointReasonEnum
+	89, // 58: cedana.services.task.RuncDumpArgs.CriuOpts:type_name -> cedana.services.task.CriuOpts
+	12, // 59: cedana.services.task.RuncDumpArgs.Type:type_name -> cedana.services.task.RuncDumpArgs.DumpType
+	13, // 60: cedana.services.task.CriuProcessOpts.Tcp:type_name -> cedana.services.task.CriuProcessOpts.TcpMode
+	91, // 61: cedana.services.task.RuncRestoreArgs.Opts:type_name -> cedana.services.task.RuncOpts
+	14, // 62: cedana.services.task.RuncRestoreArgs.Type:type_name -> cedana.services.task.RuncRestoreArgs.RestoreType
+	42, // 63: cedana.services.task.TaskService.Dump:input_type -> cedana.services.task.DumpArgs
+	44, // 64: cedana.services.task.TaskService.Restore:input_type -> cedana.services.task.RestoreArgs
+	82, // 65: cedana.services.task.TaskService.ContainerDump:input_type -> cedana.services.task.ContainerDumpArgs
+	84, // 66: cedana.services.task.TaskService.ContainerRestore:input_type -> cedana.services.task.ContainerRestoreArgs
+	86, // 67: cedana.services.task.TaskService.RuncDump:input_type -> cedana.services.task.RuncDumpArgs
+	90, // 68: cedana.services.task.TaskService.RuncRestore:input_type -> cedana.services.task.RuncRestoreArgs
+	52, // 69: cedana.services.task.TaskService.StartTask:input_type -> cedana.services.task.StartTaskArgs
+	55, // 70: cedana.services.task.TaskService.LogStreaming:input_type -> cedana.services.task.LogStreamingResp
+	71, // 71: cedana.services.task.TaskService.ClientStateStreaming:input_type -> cedana.services.task.ClientStateStreamingResp
+	72, // 72: cedana.services.task.TaskService.MetaStateStreaming:input_type -> cedana.services.task.MetaStateStreamingArgs
+	80, // 73: cedana.services.task.TaskService.ListRuncContainers:input_type -> cedana.services.task.RuncRoot
+	78, // 74: cedana.services.task.TaskService.GetRuncContainerByName:input_type -> cedana.services.task.CtrByNameArgs
+	76, // 75: cedana.services.task.TaskService.GetPausePid:input_type -> cedana.services.task.PausePidArgs
+	37, // 76: cedana.services.task.TaskService.ListContainers:input_type -> cedana.services.task.ListArgs
+	15, // 77: cedana.services.task.TaskService.Migrate:input_type -> cedana.services.task.MigrateArgs
+	17, // 78: cedana.services.task.TaskService.PrepareMigration:input_type -> cedana.services.task.PrepareMigrationArgs
+	19, // 79: cedana.services.task.TaskService.CompleteMigration:input_type -> cedana.services.task.MigrationChunk
+	21, // 80: cedana.services.task.TaskService.SetCheckpointPolicy:input_type -> cedana.services.task.SetCheckpointPolicyArgs
+	23, // 81: cedana.services.task.TaskService.GarbageCollect:input_type -> cedana.services.task.GarbageCollectArgs
+	26, // 82: cedana.services.task.TaskService.CheckHost:input_type -> cedana.services.task.CheckHostArgs
+	29, // 83: cedana.services.task.TaskService.Analyze:input_type -> cedana.services.task.AnalyzeArgs
+	33, // 84: cedana.services.task.TaskService.GroupDump:input_type -> cedana.services.task.GroupDumpArgs
+	35, // 85: cedana.services.task.TaskService.GroupRestore:input_type -> cedana.services.task.GroupRestoreArgs
+	46, // 86: cedana.services.task.TaskService.Attach:input_type -> cedana.services.task.AttachArgs
+	49, // 87: cedana.services.task.TaskService.UpdateJob:input_type -> cedana.services.task.UpdateJobArgs
+	65, // 88: cedana.services.task.TaskService.GetJobMetrics:input_type -> cedana.services.task.GetJobMetricsArgs
+	43, // 89: cedana.services.task.TaskService.Dump:output_type -> cedana.services.task.DumpResp
+	51, // 90: cedana.services.task.TaskService.Restore:output_type -> cedana.services.task.RestoreResp
+	83, // 91: cedana.services.task.TaskService.ContainerDump:output_type -> cedana.services.task.ContainerDumpResp
+	85, // 92: cedana.services.task.TaskService.ContainerRestore:output_type -> cedana.services.task.ContainerRestoreResp
+	87, // 93: cedana.services.task.TaskService.RuncDump:output_type -> cedana.services.task.RuncDumpResp
+	92, // 94: cedana.services.task.TaskService.RuncRestore:output_type -> cedana.services.task.RuncRestoreResp
+	53, // 95: cedana.services.task.TaskService.StartTask:output_type -> cedana.services.task.StartTaskResp
+	54, // 96: cedana.services.task.TaskService.LogStreaming:output_type -> cedana.services.task.LogStreamingArgs
+	58, // 97: cedana.services.task.TaskService.ClientStateStreaming:output_type -> cedana.services.task.ProcessState
+	75, // 98: cedana.services.task.TaskService.MetaStateStreaming:output_type -> cedana.services.task.MetaStateStreamingResp
+	81, // 99: cedana.services.task.TaskService.ListRuncContainers:output_type -> cedana.services.task.RuncList
+	79, // 100: cedana.services.task.TaskService.GetRuncContainerByName:output_type -> cedana.services.task.CtrByNameResp
+	77, // 101: cedana.services.task.TaskService.GetPausePid:output_type -> cedana.services.task.PausePidResp
+	38, // 102: cedana.services.task.TaskService.ListContainers:output_type -> cedana.services.task.ListResp
+	16, // 103: cedana.services.task.TaskService.Migrate:output_type -> cedana.services.task.MigrateResp
+	18, // 104: cedana.services.task.TaskService.PrepareMigration:output_type -> cedana.services.task.PrepareMigrationResp
+	51, // 105: cedana.services.task.TaskService.CompleteMigration:output_type -> cedana.services.task.RestoreResp
+	22, // 106: cedana.services.task.TaskService.SetCheckpointPolicy:output_type -> cedana.services.task.SetCheckpointPolicyResp
+	25, // 107: cedana.services.task.TaskService.GarbageCollect:output_type -> cedana.services.task.GarbageCollectResp
+	28, // 108: cedana.services.task.TaskService.CheckHost:output_type -> cedana.services.task.CheckHostResp
+	31, // 109: cedana.services.task.TaskService.Analyze:output_type -> cedana.services.task.AnalyzeResp
+	34, // 110: cedana.services.task.TaskService.GroupDump:output_type -> cedana.services.task.GroupDumpResp
+	36, // 111: cedana.services.task.TaskService.GroupRestore:output_type -> cedana.services.task.GroupRestoreResp
+	47, // 112: cedana.services.task.TaskService.Attach:output_type -> cedana.services.task.AttachResp
+	50, // 113: cedana.services.task.TaskService.UpdateJob:output_type -> cedana.services.task.UpdateJobResp
+	66, // 114: cedana.services.task.TaskService.GetJobMetrics:output_type -> cedana.services.task.GetJobMetricsResp
+	89, // [89:115] is the sub-list for method output_type
+	63, // [63:89] is the sub-list for method input_type
+	63, // [63:63] is the sub-list for extension type_name
+	63, // [63:63] is the sub-list for extension extendee
+	0,  // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_task_proto_init() }
@@ -3877,6 +7651,270 @@ func file_task_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_task_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrateArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrateResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrepareMigrationArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrepareMigrationResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrationChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckpointPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCheckpointPolicyArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCheckpointPolicyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GarbageCollectArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectedCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GarbageCollectResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckHostArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckHostResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyzeArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Finding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyzeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupDumpArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupDumpResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRestoreArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRestoreResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListArgs); i {
 			case 0:
 				return &v.state
@@ -3888,7 +7926,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListResp); i {
 			case 0:
 				return &v.state
@@ -3900,7 +7938,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Container); i {
 			case 0:
 				return &v.state
@@ -3912,7 +7950,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Annotation); i {
 			case 0:
 				return &v.state
@@ -3924,7 +7962,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*External); i {
 			case 0:
 				return &v.state
@@ -3936,7 +7974,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DumpArgs); i {
 			case 0:
 				return &v.state
@@ -3948,7 +7986,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DumpResp); i {
 			case 0:
 				return &v.state
@@ -3960,7 +7998,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RestoreArgs); i {
 			case 0:
 				return &v.state
@@ -3972,7 +8010,79 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StdioTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateJobArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateJobResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RestoreResp); i {
 			case 0:
 				return &v.state
@@ -3984,7 +8094,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StartTaskArgs); i {
 			case 0:
 				return &v.state
@@ -3996,7 +8106,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StartTaskResp); i {
 			case 0:
 				return &v.state
@@ -4008,7 +8118,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogStreamingArgs); i {
 			case 0:
 				return &v.state
@@ -4020,7 +8130,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogStreamingResp); i {
 			case 0:
 				return &v.state
@@ -4032,7 +8142,31 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PidNamespace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PidMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProcessState); i {
 			case 0:
 				return &v.state
@@ -4044,7 +8178,19 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoteState); i {
 			case 0:
 				return &v.state
@@ -4056,7 +8202,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClientInfo); i {
 			case 0:
 				return &v.state
@@ -4068,7 +8214,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProcessInfo); i {
 			case 0:
 				return &v.state
@@ -4080,7 +8226,55 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobMetricsSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobMetricsArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobMetricsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OpenFilesStat); i {
 			case 0:
 				return &v.state
@@ -4092,7 +8286,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConnectionStat); i {
 			case 0:
 				return &v.state
@@ -4104,7 +8298,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Addr); i {
 			case 0:
 				return &v.state
@@ -4116,7 +8310,19 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapturedFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClientStateStreamingResp); i {
 			case 0:
 				return &v.state
@@ -4128,7 +8334,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetaStateStreamingArgs); i {
 			case 0:
 				return &v.state
@@ -4140,7 +8346,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckpointReason); i {
 			case 0:
 				return &v.state
@@ -4152,7 +8358,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProviderEvent); i {
 			case 0:
 				return &v.state
@@ -4164,7 +8370,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetaStateStreamingResp); i {
 			case 0:
 				return &v.state
@@ -4176,7 +8382,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PausePidArgs); i {
 			case 0:
 				return &v.state
@@ -4188,7 +8394,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PausePidResp); i {
 			case 0:
 				return &v.state
@@ -4200,7 +8406,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CtrByNameArgs); i {
 			case 0:
 				return &v.state
@@ -4212,7 +8418,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CtrByNameResp); i {
 			case 0:
 				return &v.state
@@ -4224,7 +8430,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RuncRoot); i {
 			case 0:
 				return &v.state
@@ -4236,7 +8442,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RuncList); i {
 			case 0:
 				return &v.state
@@ -4248,7 +8454,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ContainerDumpArgs); i {
 			case 0:
 				return &v.state
@@ -4260,7 +8466,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ContainerDumpResp); i {
 			case 0:
 				return &v.state
@@ -4272,7 +8478,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ContainerRestoreArgs); i {
 			case 0:
 				return &v.state
@@ -4284,7 +8490,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ContainerRestoreResp); i {
 			case 0:
 				return &v.state
@@ -4296,7 +8502,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RuncDumpArgs); i {
 			case 0:
 				return &v.state
@@ -4308,7 +8514,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RuncDumpResp); i {
 			case 0:
 				return &v.state
@@ -4320,7 +8526,19 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CriuProcessOpts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_task_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CriuOpts); i {
 			case 0:
 				return &v.state
@@ -4332,7 +8550,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RuncRestoreArgs); i {
 			case 0:
 				return &v.state
@@ -4344,7 +8562,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RuncOpts); i {
 			case 0:
 				return &v.state
@@ -4356,7 +8574,7 @@ func file_task_proto_init() {
 				return nil
 			}
 		}
-		file_task_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_task_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RuncRestoreResp); i {
 			case 0:
 				return &v.state
@@ -4369,13 +8587,15 @@ func file_task_proto_init() {
 			}
 		}
 	}
+	file_task_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_task_proto_msgTypes[73].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_task_proto_rawDesc,
-			NumEnums:      9,
-			NumMessages:   42,
+			NumEnums:      15,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
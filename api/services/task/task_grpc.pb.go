@@ -36,6 +36,18 @@ type TaskServiceClient interface {
 	GetRuncContainerByName(ctx context.Context, in *CtrByNameArgs, opts ...grpc.CallOption) (*CtrByNameResp, error)
 	GetPausePid(ctx context.Context, in *PausePidArgs, opts ...grpc.CallOption) (*PausePidResp, error)
 	ListContainers(ctx context.Context, in *ListArgs, opts ...grpc.CallOption) (*ListResp, error)
+	Migrate(ctx context.Context, in *MigrateArgs, opts ...grpc.CallOption) (*MigrateResp, error)
+	PrepareMigration(ctx context.Context, in *PrepareMigrationArgs, opts ...grpc.CallOption) (*PrepareMigrationResp, error)
+	CompleteMigration(ctx context.Context, opts ...grpc.CallOption) (TaskService_CompleteMigrationClient, error)
+	SetCheckpointPolicy(ctx context.Context, in *SetCheckpointPolicyArgs, opts ...grpc.CallOption) (*SetCheckpointPolicyResp, error)
+	GarbageCollect(ctx context.Context, in *GarbageCollectArgs, opts ...grpc.CallOption) (*GarbageCollectResp, error)
+	CheckHost(ctx context.Context, in *CheckHostArgs, opts ...grpc.CallOption) (*CheckHostResp, error)
+	Analyze(ctx context.Context, in *AnalyzeArgs, opts ...grpc.CallOption) (*AnalyzeResp, error)
+	GroupDump(ctx context.Context, in *GroupDumpArgs, opts ...grpc.CallOption) (*GroupDumpResp, error)
+	GroupRestore(ctx context.Context, in *GroupRestoreArgs, opts ...grpc.CallOption) (*GroupRestoreResp, error)
+	Attach(ctx context.Context, opts ...grpc.CallOption) (TaskService_AttachClient, error)
+	UpdateJob(ctx context.Context, in *UpdateJobArgs, opts ...grpc.CallOption) (*UpdateJobResp, error)
+	GetJobMetrics(ctx context.Context, in *GetJobMetricsArgs, opts ...grpc.CallOption) (*GetJobMetricsResp, error)
 }
 
 type taskServiceClient struct {
@@ -238,6 +250,161 @@ func (c *taskServiceClient) ListContainers(ctx context.Context, in *ListArgs, op
 	return out, nil
 }
 
+func (c *taskServiceClient) Migrate(ctx context.Context, in *MigrateArgs, opts ...grpc.CallOption) (*MigrateResp, error) {
+	out := new(MigrateResp)
+	err := c.cc.Invoke(ctx, "/cedana.services.task.TaskService/Migrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) PrepareMigration(ctx context.Context, in *PrepareMigrationArgs, opts ...grpc.CallOption) (*PrepareMigrationResp, error) {
+	out := new(PrepareMigrationResp)
+	err := c.cc.Invoke(ctx, "/cedana.services.task.TaskService/PrepareMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CompleteMigration(ctx context.Context, opts ...grpc.CallOption) (TaskService_CompleteMigrationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TaskService_ServiceDesc.Streams[3], "/cedana.services.task.TaskService/CompleteMigration", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taskServiceCompleteMigrationClient{stream}
+	return x, nil
+}
+
+type TaskService_CompleteMigrationClient interface {
+	Send(*MigrationChunk) error
+	CloseAndRecv() (*RestoreResp, error)
+	grpc.ClientStream
+}
+
+type taskServiceCompleteMigrationClient struct {
+	grpc.ClientStream
+}
+
+func (x *taskServiceCompleteMigrationClient) Send(m *MigrationChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *taskServiceCompleteMigrationClient) CloseAndRecv() (*RestoreResp, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RestoreResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *taskServiceClient) SetCheckpointPolicy(ctx context.Context, in *SetCheckpointPolicyArgs, opts ...grpc.CallOption) (*SetCheckpointPolicyResp, error) {
+	out := new(SetCheckpointPolicyResp)
+	err := c.cc.Invoke(ctx, "/cedana.services.task.TaskService/SetCheckpointPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GarbageCollect(ctx context.Context, in *GarbageCollectArgs, opts ...grpc.CallOption) (*GarbageCollectResp, error) {
+	out := new(GarbageCollectResp)
+	err := c.cc.Invoke(ctx, "/cedana.services.task.TaskService/GarbageCollect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CheckHost(ctx context.Context, in *CheckHostArgs, opts ...grpc.CallOption) (*CheckHostResp, error) {
+	out := new(CheckHostResp)
+	err := c.cc.Invoke(ctx, "/cedana.services.task.TaskService/CheckHost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) Analyze(ctx context.Context, in *AnalyzeArgs, opts ...grpc.CallOption) (*AnalyzeResp, error) {
+	out := new(AnalyzeResp)
+	err := c.cc.Invoke(ctx, "/cedana.services.task.TaskService/Analyze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GroupDump(ctx context.Context, in *GroupDumpArgs, opts ...grpc.CallOption) (*GroupDumpResp, error) {
+	out := new(GroupDumpResp)
+	err := c.cc.Invoke(ctx, "/cedana.services.task.TaskService/GroupDump", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GroupRestore(ctx context.Context, in *GroupRestoreArgs, opts ...grpc.CallOption) (*GroupRestoreResp, error) {
+	out := new(GroupRestoreResp)
+	err := c.cc.Invoke(ctx, "/cedana.services.task.TaskService/GroupRestore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) Attach(ctx context.Context, opts ...grpc.CallOption) (TaskService_AttachClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TaskService_ServiceDesc.Streams[4], "/cedana.services.task.TaskService/Attach", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taskServiceAttachClient{stream}
+	return x, nil
+}
+
+type TaskService_AttachClient interface {
+	Send(*AttachArgs) error
+	Recv() (*AttachResp, error)
+	grpc.ClientStream
+}
+
+type taskServiceAttachClient struct {
+	grpc.ClientStream
+}
+
+func (x *taskServiceAttachClient) Send(m *AttachArgs) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *taskServiceAttachClient) Recv() (*AttachResp, error) {
+	m := new(AttachResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *taskServiceClient) UpdateJob(ctx context.Context, in *UpdateJobArgs, opts ...grpc.CallOption) (*UpdateJobResp, error) {
+	out := new(UpdateJobResp)
+	err := c.cc.Invoke(ctx, "/cedana.services.task.TaskService/UpdateJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetJobMetrics(ctx context.Context, in *GetJobMetricsArgs, opts ...grpc.CallOption) (*GetJobMetricsResp, error) {
+	out := new(GetJobMetricsResp)
+	err := c.cc.Invoke(ctx, "/cedana.services.task.TaskService/GetJobMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility
@@ -256,6 +423,18 @@ type TaskServiceServer interface {
 	GetRuncContainerByName(context.Context, *CtrByNameArgs) (*CtrByNameResp, error)
 	GetPausePid(context.Context, *PausePidArgs) (*PausePidResp, error)
 	ListContainers(context.Context, *ListArgs) (*ListResp, error)
+	Migrate(context.Context, *MigrateArgs) (*MigrateResp, error)
+	PrepareMigration(context.Context, *PrepareMigrationArgs) (*PrepareMigrationResp, error)
+	CompleteMigration(TaskService_CompleteMigrationServer) error
+	SetCheckpointPolicy(context.Context, *SetCheckpointPolicyArgs) (*SetCheckpointPolicyResp, error)
+	GarbageCollect(context.Context, *GarbageCollectArgs) (*GarbageCollectResp, error)
+	CheckHost(context.Context, *CheckHostArgs) (*CheckHostResp, error)
+	Analyze(context.Context, *AnalyzeArgs) (*AnalyzeResp, error)
+	GroupDump(context.Context, *GroupDumpArgs) (*GroupDumpResp, error)
+	GroupRestore(context.Context, *GroupRestoreArgs) (*GroupRestoreResp, error)
+	Attach(TaskService_AttachServer) error
+	UpdateJob(context.Context, *UpdateJobArgs) (*UpdateJobResp, error)
+	GetJobMetrics(context.Context, *GetJobMetricsArgs) (*GetJobMetricsResp, error)
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
@@ -305,6 +484,42 @@ func (UnimplementedTaskServiceServer) GetPausePid(context.Context, *PausePidArgs
 func (UnimplementedTaskServiceServer) ListContainers(context.Context, *ListArgs) (*ListResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListContainers not implemented")
 }
+func (UnimplementedTaskServiceServer) Migrate(context.Context, *MigrateArgs) (*MigrateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Migrate not implemented")
+}
+func (UnimplementedTaskServiceServer) PrepareMigration(context.Context, *PrepareMigrationArgs) (*PrepareMigrationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareMigration not implemented")
+}
+func (UnimplementedTaskServiceServer) CompleteMigration(TaskService_CompleteMigrationServer) error {
+	return status.Errorf(codes.Unimplemented, "method CompleteMigration not implemented")
+}
+func (UnimplementedTaskServiceServer) SetCheckpointPolicy(context.Context, *SetCheckpointPolicyArgs) (*SetCheckpointPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCheckpointPolicy not implemented")
+}
+func (UnimplementedTaskServiceServer) GarbageCollect(context.Context, *GarbageCollectArgs) (*GarbageCollectResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GarbageCollect not implemented")
+}
+func (UnimplementedTaskServiceServer) CheckHost(context.Context, *CheckHostArgs) (*CheckHostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckHost not implemented")
+}
+func (UnimplementedTaskServiceServer) Analyze(context.Context, *AnalyzeArgs) (*AnalyzeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Analyze not implemented")
+}
+func (UnimplementedTaskServiceServer) GroupDump(context.Context, *GroupDumpArgs) (*GroupDumpResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupDump not implemented")
+}
+func (UnimplementedTaskServiceServer) GroupRestore(context.Context, *GroupRestoreArgs) (*GroupRestoreResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupRestore not implemented")
+}
+func (UnimplementedTaskServiceServer) Attach(TaskService_AttachServer) error {
+	return status.Errorf(codes.Unimplemented, "method Attach not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateJob(context.Context, *UpdateJobArgs) (*UpdateJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJob not implemented")
+}
+func (UnimplementedTaskServiceServer) GetJobMetrics(context.Context, *GetJobMetricsArgs) (*GetJobMetricsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobMetrics not implemented")
+}
 func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 
 // UnsafeTaskServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -594,6 +809,238 @@ func _TaskService_ListContainers_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).Migrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cedana.services.task.TaskService/Migrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).Migrate(ctx, req.(*MigrateArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_PrepareMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareMigrationArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).PrepareMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cedana.services.task.TaskService/PrepareMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).PrepareMigration(ctx, req.(*PrepareMigrationArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CompleteMigration_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TaskServiceServer).CompleteMigration(&taskServiceCompleteMigrationServer{stream})
+}
+
+type TaskService_CompleteMigrationServer interface {
+	SendAndClose(*RestoreResp) error
+	Recv() (*MigrationChunk, error)
+	grpc.ServerStream
+}
+
+type taskServiceCompleteMigrationServer struct {
+	grpc.ServerStream
+}
+
+func (x *taskServiceCompleteMigrationServer) SendAndClose(m *RestoreResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *taskServiceCompleteMigrationServer) Recv() (*MigrationChunk, error) {
+	m := new(MigrationChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TaskService_SetCheckpointPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCheckpointPolicyArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).SetCheckpointPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cedana.services.task.TaskService/SetCheckpointPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).SetCheckpointPolicy(ctx, req.(*SetCheckpointPolicyArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GarbageCollect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GarbageCollectArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GarbageCollect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cedana.services.task.TaskService/GarbageCollect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GarbageCollect(ctx, req.(*GarbageCollectArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CheckHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckHostArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CheckHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cedana.services.task.TaskService/CheckHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CheckHost(ctx, req.(*CheckHostArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_Analyze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).Analyze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cedana.services.task.TaskService/Analyze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).Analyze(ctx, req.(*AnalyzeArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GroupDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupDumpArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GroupDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cedana.services.task.TaskService/GroupDump",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GroupDump(ctx, req.(*GroupDumpArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GroupRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRestoreArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GroupRestore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cedana.services.task.TaskService/GroupRestore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GroupRestore(ctx, req.(*GroupRestoreArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_Attach_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TaskServiceServer).Attach(&taskServiceAttachServer{stream})
+}
+
+type TaskService_AttachServer interface {
+	Send(*AttachResp) error
+	Recv() (*AttachArgs, error)
+	grpc.ServerStream
+}
+
+type taskServiceAttachServer struct {
+	grpc.ServerStream
+}
+
+func (x *taskServiceAttachServer) Send(m *AttachResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *taskServiceAttachServer) Recv() (*AttachArgs, error) {
+	m := new(AttachArgs)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TaskService_UpdateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cedana.services.task.TaskService/UpdateJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateJob(ctx, req.(*UpdateJobArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetJobMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobMetricsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetJobMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cedana.services.task.TaskService/GetJobMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetJobMetrics(ctx, req.(*GetJobMetricsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -645,6 +1092,46 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListContainers",
 			Handler:    _TaskService_ListContainers_Handler,
 		},
+		{
+			MethodName: "Migrate",
+			Handler:    _TaskService_Migrate_Handler,
+		},
+		{
+			MethodName: "PrepareMigration",
+			Handler:    _TaskService_PrepareMigration_Handler,
+		},
+		{
+			MethodName: "SetCheckpointPolicy",
+			Handler:    _TaskService_SetCheckpointPolicy_Handler,
+		},
+		{
+			MethodName: "GarbageCollect",
+			Handler:    _TaskService_GarbageCollect_Handler,
+		},
+		{
+			MethodName: "CheckHost",
+			Handler:    _TaskService_CheckHost_Handler,
+		},
+		{
+			MethodName: "Analyze",
+			Handler:    _TaskService_Analyze_Handler,
+		},
+		{
+			MethodName: "GroupDump",
+			Handler:    _TaskService_GroupDump_Handler,
+		},
+		{
+			MethodName: "GroupRestore",
+			Handler:    _TaskService_GroupRestore_Handler,
+		},
+		{
+			MethodName: "UpdateJob",
+			Handler:    _TaskService_UpdateJob_Handler,
+		},
+		{
+			MethodName: "GetJobMetrics",
+			Handler:    _TaskService_GetJobMetrics_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -665,6 +1152,17 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "CompleteMigration",
+			Handler:       _TaskService_CompleteMigration_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Attach",
+			Handler:       _TaskService_Attach_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "task.proto",
 }
@@ -0,0 +1,179 @@
+package api
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cedana/cedana/api/services/task"
+	"google.golang.org/protobuf/proto"
+)
+
+// stdio are the targets of the standard streams of a restored process. The ones
+// left unset get the defaults, and once the restore is prepared only the ones
+// actually redirected are left set.
+type stdio struct {
+	Stdin, Stdout, Stderr *task.StdioTarget
+}
+
+func (s *stdio) validate() error {
+	for _, target := range []*task.StdioTarget{s.Stdin, s.Stdout, s.Stderr} {
+		if target != nil && target.Path == "" {
+			return fmt.Errorf("stdio targets need a path")
+		}
+	}
+	if s.Stdin != nil && s.Stdin.Append {
+		return fmt.Errorf("stdin can't be appended to")
+	}
+	return nil
+}
+
+// defaultOutput is the log file restored output goes to unless asked otherwise,
+// named after the restore
+func defaultOutput(name string) *task.StdioTarget {
+	return &task.StdioTarget{
+		Type: task.StdioTarget_FILE,
+		Path: fmt.Sprintf("/var/log/cedana-output-%d-%s.log", time.Now().Unix(), name),
+	}
+}
+
+// openStdioTarget opens a target for the restored process to inherit, for reading
+// if it's stdin
+func openStdioTarget(target *task.StdioTarget, input bool) (*os.File, error) {
+	if target.Path == "" {
+		return nil, fmt.Errorf("stdio target has no path")
+	}
+
+	switch target.Type {
+	case task.StdioTarget_FILE:
+		if input {
+			return os.Open(target.Path)
+		}
+		flags := os.O_CREATE | os.O_WRONLY
+		if target.Append {
+			flags |= os.O_APPEND
+		} else {
+			flags |= os.O_TRUNC
+		}
+		return os.OpenFile(target.Path, flags, 0o644)
+
+	case task.StdioTarget_FIFO:
+		fi, err := os.Stat(target.Path)
+		if err != nil {
+			return nil, err
+		}
+		if fi.Mode()&os.ModeNamedPipe == 0 {
+			return nil, fmt.Errorf("%s is not a fifo", target.Path)
+		}
+		// opened non-blocking so a fifo nobody reads fails now instead of hanging
+		flags := syscall.O_WRONLY
+		if input {
+			flags = syscall.O_RDONLY
+		}
+		fd, err := syscall.Open(target.Path, flags|syscall.O_NONBLOCK|syscall.O_CLOEXEC, 0)
+		if err == syscall.ENXIO {
+			return nil, fmt.Errorf("fifo %s has no reader", target.Path)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := syscall.SetNonblock(fd, false); err != nil {
+			syscall.Close(fd)
+			return nil, err
+		}
+		return os.NewFile(uintptr(fd), target.Path), nil
+
+	case task.StdioTarget_SOCKET:
+		conn, err := net.Dial("unix", target.Path)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		return conn.(*net.UnixConn).File()
+
+	default:
+		return nil, fmt.Errorf("unknown stdio target type %v", target.Type)
+	}
+}
+
+// inheritFiles maps the files of the checkpointed process's standard streams, by
+// their CRIU inherit-fd key, to the files opened for their targets. Streams the
+// checkpoint has no file for are dropped from streams, or refused if the caller
+// asked for them.
+func inheritFiles(state *task.ProcessState, streams *stdio, name string) (map[string]*os.File, error) {
+	keys := map[task.OpenFilesStat_StreamType]string{}
+	for _, f := range state.ProcessInfo.OpenFds {
+		if f.Stream == task.OpenFilesStat_NONE || f.Fd != uint64(f.Stream) {
+			continue
+		}
+		keys[f.Stream] = strings.TrimPrefix(f.Path, "/")
+	}
+
+	explicitOutput := streams.Stdout != nil || streams.Stderr != nil
+	if streams.Stdout == nil && streams.Stderr == nil {
+		output := defaultOutput(name)
+		streams.Stdout, streams.Stderr = output, output
+	}
+
+	files := map[string]*os.File{}
+	targets := map[string]*task.StdioTarget{}
+	// a target given to several streams is opened once
+	opened := map[*task.StdioTarget]*os.File{}
+	for _, stream := range []struct {
+		kind   task.OpenFilesStat_StreamType
+		target **task.StdioTarget
+	}{
+		{task.OpenFilesStat_STDIN, &streams.Stdin},
+		{task.OpenFilesStat_STDOUT, &streams.Stdout},
+		{task.OpenFilesStat_STDERR, &streams.Stderr},
+	} {
+		target := *stream.target
+		if target == nil {
+			continue
+		}
+		key, ok := keys[stream.kind]
+		if !ok {
+			if stream.kind == task.OpenFilesStat_STDIN || explicitOutput {
+				closeFiles(files)
+				return nil, fmt.Errorf("%s of the checkpointed process is not a file, it can't be redirected", stream.kind)
+			}
+			*stream.target = nil
+			continue
+		}
+		// streams sharing a file can only share a target
+		if t, ok := targets[key]; ok {
+			if !proto.Equal(t, target) {
+				closeFiles(files)
+				return nil, fmt.Errorf("%s of the checkpointed process shares its file with another stream, it can't go elsewhere", stream.kind)
+			}
+			continue
+		}
+		targets[key] = target
+
+		var file *os.File
+		for t, f := range opened {
+			if proto.Equal(t, target) {
+				file = f
+			}
+		}
+		if file == nil {
+			var err error
+			if file, err = openStdioTarget(target, stream.kind == task.OpenFilesStat_STDIN); err != nil {
+				closeFiles(files)
+				return nil, fmt.Errorf("could not open %s target %s: %w", stream.kind, target.Path, err)
+			}
+			opened[target] = file
+		}
+		files[key] = file
+	}
+	return files, nil
+}
+
+func closeFiles(files map[string]*os.File) {
+	for _, f := range files {
+		f.Close()
+	}
+}
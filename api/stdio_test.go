@@ -0,0 +1,51 @@
+package api
+
+import (
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/cedana/cedana/api/services/task"
+)
+
+func TestInheritFiles(t *testing.T) {
+	dir := t.TempDir()
+	state := &task.ProcessState{ProcessInfo: &task.ProcessInfo{OpenFds: []*task.OpenFilesStat{
+		{Fd: 1, Path: "/var/log/job.log", Stream: task.OpenFilesStat_STDOUT},
+		{Fd: 2, Path: "/var/log/job.log", Stream: task.OpenFilesStat_STDERR},
+		{Fd: 5, Path: "/data/db", Stream: task.OpenFilesStat_NONE},
+	}}}
+
+	out := &task.StdioTarget{Type: task.StdioTarget_FILE, Path: filepath.Join(dir, "out"), Append: true}
+	streams := &stdio{Stdout: out, Stderr: out}
+	files, err := inheritFiles(state, streams, "restore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFiles(files)
+	if len(files) != 1 || files["var/log/job.log"] == nil {
+		t.Errorf("expected the shared log to be inherited once, got %v", files)
+	}
+	if streams.Stdout != out || streams.Stderr != out {
+		t.Errorf("expected both streams reported, got %v", streams)
+	}
+
+	// stdout and stderr are the same file, they can't be split
+	err2 := &task.StdioTarget{Type: task.StdioTarget_FILE, Path: filepath.Join(dir, "err")}
+	if _, err := inheritFiles(state, &stdio{Stdout: out, Stderr: err2}, "restore"); err == nil {
+		t.Error("expected a shared file to refuse different targets")
+	}
+
+	// stdin wasn't a file
+	if _, err := inheritFiles(state, &stdio{Stdin: out}, "restore"); err == nil {
+		t.Error("expected stdin without a file to be refused")
+	}
+
+	fifo := filepath.Join(dir, "fifo")
+	if err := syscall.Mkfifo(fifo, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := openStdioTarget(&task.StdioTarget{Type: task.StdioTarget_FIFO, Path: fifo}, false); err == nil {
+		t.Error("expected a fifo without a reader to be refused")
+	}
+}
@@ -0,0 +1,261 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/cedana/cedana/utils"
+	"github.com/checkpoint-restore/go-criu/v6/rpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// CRIU dumps a process along with all of its descendants. Session jobs can have
+// more than one tree though, when processes daemonized out of the job's tree, so
+// those get dumped into a subdirectory each, from the root they were reparented
+// at. The whole session is stopped for the dumps so they all capture the same
+// moment, and continued once every tree is restored.
+//
+// CRIU restores a tree whose session leader is elsewhere into the session of the
+// criu restoring it, and nothing can move a process into an existing session,
+// so restored trees end up in the daemon's session. The job keeps their roots
+// to find them again.
+const treesDir = "trees"
+
+// jobTrees returns the roots of the process trees making up the job of pid, pid
+// first, along with every member of the job. trees are the roots the job's other
+// trees were restored at, see ProcessState.Trees.
+func jobTrees(pid int32, scope task.JobScope, trees []int32) ([]int32, []*task.ProcessMember, error) {
+	roots := []int32{pid}
+
+	if scope == task.JobScope_SESSION {
+		stat, err := utils.ReadProcStat(pid)
+		if err != nil {
+			return nil, nil, fmt.Errorf("process %d not found: %w", pid, err)
+		}
+		if stat.SID != pid {
+			return nil, nil, fmt.Errorf("process %d does not lead its session (%d), session jobs are dumped from their leader", pid, stat.SID)
+		}
+
+		session, err := utils.SessionProcesses(stat.SID)
+		if err != nil {
+			return nil, nil, err
+		}
+		inSession := map[int32]bool{}
+		for _, p := range session {
+			inSession[p] = true
+		}
+
+		var others []int32
+		for _, p := range session[1:] {
+			stat, err := utils.ReadProcStat(p)
+			if err != nil {
+				continue
+			}
+			if !inSession[stat.PPID] {
+				others = append(others, p)
+			}
+		}
+		for _, root := range trees {
+			if !inSession[root] && syscall.Kill(int(root), 0) == nil {
+				others = append(others, root)
+			}
+		}
+		sort.Slice(others, func(i, j int) bool { return others[i] < others[j] })
+		roots = append(roots, others...)
+	}
+
+	members, err := treeMembers(roots)
+	if err != nil {
+		return nil, nil, err
+	}
+	return roots, members, nil
+}
+
+// treeMembers lists the processes of the trees under roots, parents first
+func treeMembers(roots []int32) ([]*task.ProcessMember, error) {
+	var members []*task.ProcessMember
+	for _, root := range roots {
+		tree, err := utils.ProcessTree(root)
+		if err != nil {
+			return nil, err
+		}
+		for _, pid := range tree {
+			stat, err := utils.ReadProcStat(pid)
+			if err != nil {
+				// exited while we walked the tree
+				continue
+			}
+			members = append(members, &task.ProcessMember{
+				PID:     pid,
+				PPID:    stat.PPID,
+				SID:     stat.SID,
+				Cmdline: cmdline(pid),
+			})
+		}
+	}
+	return members, nil
+}
+
+func cmdline(pid int32) string {
+	data, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(int(pid)), "cmdline"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(strings.ReplaceAll(string(data), "\x00", " "))
+}
+
+// signalMembers sends sig to every member, ignoring the ones gone since
+func signalMembers(members []*task.ProcessMember, sig syscall.Signal) error {
+	for _, member := range members {
+		if err := syscall.Kill(int(member.PID), sig); err != nil && err != syscall.ESRCH {
+			return fmt.Errorf("could not signal process %d: %w", member.PID, err)
+		}
+	}
+	return nil
+}
+
+// dumpTrees dumps the trees of a session job other than the one of its leader,
+// each into its own subdirectory of dumpdir. The session must be stopped already.
+// The trees are left running, for the caller to kill once the leader's tree is
+// dumped too.
+func (c *Client) dumpTrees(ctx context.Context, dumpdir string, roots []int32, opts *rpc.CriuOpts) error {
+	_, treesSpan := c.tracer.Start(ctx, "dump-trees")
+	defer treesSpan.End()
+
+	for _, root := range roots {
+		dir := filepath.Join(dumpdir, treesDir, strconv.Itoa(int(root)))
+		if err := os.MkdirAll(dir, 0o777); err != nil {
+			return err
+		}
+
+		img, err := os.Open(dir)
+		if err != nil {
+			return err
+		}
+
+		treeOpts := proto.Clone(opts).(*rpc.CriuOpts)
+		treeOpts.ImagesDirFd = proto.Int32(int32(img.Fd()))
+		treeOpts.Pid = proto.Int32(root)
+		// the session leader is in another tree
+		treeOpts.ShellJob = proto.Bool(true)
+		treeOpts.LeaveRunning = proto.Bool(true)
+		treeOpts.ParentImg = nil
+		treeOpts.TrackMem = nil
+
+		c.logger.Info().Msgf("dumping tree of pid %d, outside of the leader's tree", root)
+		_, err = c.CRIU.Dump(treeOpts, &Notify{Logger: c.logger})
+		img.Close()
+		if err != nil {
+			treesSpan.RecordError(err)
+			return fmt.Errorf("could not dump tree of pid %d: %w", root, err)
+		}
+	}
+
+	return nil
+}
+
+// restoreTrees restores the other trees of a session job dumped by dumpTrees, if
+// any, returning their roots. The trees come back stopped, as they were dumped,
+// in the daemon's session.
+func (c *Client) restoreTrees(ctx context.Context, dir string, opts *rpc.CriuOpts) ([]int32, error) {
+	entries, err := os.ReadDir(filepath.Join(dir, treesDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, treesSpan := c.tracer.Start(ctx, "restore-trees")
+	defer treesSpan.End()
+
+	var roots []int32
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		img, err := os.Open(filepath.Join(dir, treesDir, entry.Name()))
+		if err != nil {
+			return roots, err
+		}
+
+		treeOpts := proto.Clone(opts).(*rpc.CriuOpts)
+		treeOpts.ImagesDirFd = proto.Int32(int32(img.Fd()))
+		treeOpts.ShellJob = proto.Bool(true)
+		// inherited fds are only passed to the leader's tree
+		treeOpts.InheritFd = nil
+
+		resp, err := c.CRIU.Restore(treeOpts, &Notify{Logger: c.logger}, nil)
+		img.Close()
+		if err != nil {
+			treesSpan.RecordError(err)
+			return roots, fmt.Errorf("could not restore tree of pid %s: %w", entry.Name(), err)
+		}
+		roots = append(roots, resp.GetRestore().GetPid())
+	}
+
+	return roots, nil
+}
+
+// killTree kills root and its descendants, children first
+func killTree(root int32) error {
+	tree, err := utils.ProcessTree(root)
+	if err != nil {
+		return err
+	}
+	for i := len(tree) - 1; i >= 0; i-- {
+		if err := syscall.Kill(int(tree[i]), syscall.SIGKILL); err != nil && err != syscall.ESRCH {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoredJob lists the members of a restored job, and moves the job over to
+// its new pid if it has an ID
+func (s *service) restoredJob(jobID string, pid int32, trees []int32) []*task.ProcessMember {
+	members, err := treeMembers(append([]int32{pid}, trees...))
+	if err != nil {
+		s.logger.Warn().Msgf("could not list restored processes of pid %d: %v", pid, err)
+	}
+	if jobID == "" {
+		return members
+	}
+
+	state, err := s.client.db.GetStateFromID(jobID)
+	if err != nil {
+		s.logger.Warn().Msgf("could not find job %s to update with restored pid %d: %v", jobID, pid, err)
+		return members
+	}
+	state.PID = pid
+	state.Members = members
+	state.Trees = trees
+	state.PidNamespace = pidNamespace(pid)
+	state.Cgroup = s.client.cgroupOf(pid)
+	state.Limits = cgroupLimits(state.Cgroup)
+	state.Flag = task.FlagEnum_JOB_RUNNING
+	state.CheckpointState = task.CheckpointState_RESTORED
+	if err := s.client.db.ReplaceProcessState(jobID, state); err != nil {
+		s.logger.Warn().Msgf("could not update job %s with restored pid %d: %v", jobID, pid, err)
+	}
+	return members
+}
+
+// JobMembers lists the processes of a job, from /proc while it runs, or as they
+// were at its last checkpoint or restore otherwise
+func JobMembers(state *task.ProcessState) []*task.ProcessMember {
+	if state.PID != 0 && syscall.Kill(int(state.PID), 0) == nil {
+		if _, members, err := jobTrees(state.PID, state.Scope, state.Trees); err == nil {
+			return members
+		}
+	}
+	return state.Members
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var analyzeJSON bool
+
+var analyzeCmd = &cobra.Command{
+	Use:   "analyze <pid>",
+	Short: "Analyze a process tree for resources CRIU can't checkpoint, or needs special options for",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cli, err := NewCLI()
+		if err != nil {
+			return err
+		}
+
+		pid, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid pid %s", args[0])
+		}
+
+		resp, err := cli.cts.Analyze(&task.AnalyzeArgs{PID: int32(pid)})
+		if err != nil {
+			st, ok := status.FromError(err)
+			if ok {
+				cli.logger.Error().Msgf("Analyze failed: %v: %v", st.Code(), st.Message())
+			} else {
+				cli.logger.Error().Msgf("Analyze failed: %v", err)
+			}
+			cli.cts.Close()
+			return err
+		}
+
+		cli.cts.Close()
+
+		if analyzeJSON {
+			out, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(out))
+			return nil
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"PID", "Severity", "Resource", "Detail", "Fix"})
+		for _, f := range resp.Findings {
+			table.Append([]string{strconv.Itoa(int(f.PID)), f.Severity.String(), f.Resource, f.Detail, f.Fix})
+		}
+		table.Render()
+
+		if resp.Checkpointable {
+			fmt.Printf("process tree of %d (%d processes) looks checkpointable\n", pid, len(resp.PIDs))
+		} else {
+			fmt.Printf("process tree of %d (%d processes) has resources CRIU can't checkpoint\n", pid, len(resp.PIDs))
+		}
+		return nil
+	},
+}
+
+func init() {
+	analyzeCmd.Flags().BoolVar(&analyzeJSON, "json", false, "print the findings as json")
+	rootCmd.AddCommand(analyzeCmd)
+}
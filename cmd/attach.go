@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/containerd/console"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pid of the process to attach to, for restores without a job
+var attachPid int32
+
+// ctrl-] detaches, like telnet
+const detachKey = 0x1d
+
+var attachCmd = &cobra.Command{
+	Use:   "attach [job]",
+	Short: "Attach the terminal to a job restored with --pty, ctrl-] detaches",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		attachArgs := &task.AttachArgs{PID: attachPid}
+		if len(args) > 0 {
+			attachArgs.JobID = args[0]
+		}
+		if attachArgs.JobID == "" && attachArgs.PID == 0 {
+			return fmt.Errorf("no job or pid to attach to")
+		}
+
+		current, err := console.ConsoleFromFile(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("attach needs a terminal: %w", err)
+		}
+
+		cli, err := NewCLI()
+		if err != nil {
+			return err
+		}
+		defer cli.cts.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		stream, err := cli.cts.Attach(ctx)
+		if err != nil {
+			return err
+		}
+		if size, err := current.Size(); err == nil {
+			attachArgs.Rows, attachArgs.Cols = uint32(size.Height), uint32(size.Width)
+		}
+		if err := stream.Send(attachArgs); err != nil {
+			return err
+		}
+
+		if err := current.SetRaw(); err != nil {
+			return err
+		}
+		defer current.Reset()
+
+		// a single goroutine sends, input and resizes take turns
+		input := make(chan []byte)
+		go func() {
+			buf := make([]byte, 1024)
+			for {
+				n, err := os.Stdin.Read(buf)
+				if err != nil {
+					cancel()
+					return
+				}
+				for i := 0; i < n; i++ {
+					if buf[i] == detachKey {
+						if i > 0 {
+							input <- append([]byte(nil), buf[:i]...)
+						}
+						cancel()
+						return
+					}
+				}
+				input <- append([]byte(nil), buf[:n]...)
+			}
+		}()
+
+		winch := make(chan os.Signal, 1)
+		signal.Notify(winch, syscall.SIGWINCH)
+		defer signal.Stop(winch)
+		go func() {
+			for {
+				var msg *task.AttachArgs
+				select {
+				case data := <-input:
+					msg = &task.AttachArgs{Input: data}
+				case <-winch:
+					size, err := current.Size()
+					if err != nil {
+						continue
+					}
+					msg = &task.AttachArgs{Rows: uint32(size.Height), Cols: uint32(size.Width)}
+				case <-ctx.Done():
+					return
+				}
+				if err := stream.Send(msg); err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			resp, err := stream.Recv()
+			if status.Code(err) == codes.Canceled {
+				fmt.Print("\r\ndetached\r\n")
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			os.Stdout.Write(resp.Output)
+			if resp.Exited {
+				fmt.Print("\r\nprocess exited\r\n")
+				return nil
+			}
+		}
+	},
+}
+
+// attachTarget are the arguments of cedana attach for a restored process
+func attachTarget(jobID string, pid int32) string {
+	if jobID != "" {
+		return jobID
+	}
+	return fmt.Sprintf("--pid %d", pid)
+}
+
+func init() {
+	attachCmd.Flags().Int32Var(&attachPid, "pid", 0, "pid of the process to attach to, for restores without a job")
+	rootCmd.AddCommand(attachCmd)
+}
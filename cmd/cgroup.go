@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/cedana/cedana/api"
+	"github.com/spf13/cobra"
+)
+
+// started by the daemon in front of a job, until the job is in its cgroup
+var cgroupWaitCmd = &cobra.Command{
+	Use:    api.CgroupWaitCommand + " fd -- command [args...]",
+	Short:  "Run a command once the daemon has moved it into its cgroup",
+	Hidden: true,
+	Args:   cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fd, err := strconv.Atoi(args[0])
+		if err != nil {
+			return err
+		}
+		return api.CgroupWait(fd, args[1:])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(cgroupWaitCmd)
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/cedana/cedana/api"
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var checkJSON bool
+
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check the host is ready to checkpoint and restore: privileges, CRIU and its features, cgroups, the GPU controller and the config",
+	Long:  "Check the host is ready to checkpoint and restore. The checks run in the daemon, or in the CLI when the daemon isn't running. Exits non-zero if any check fails.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cli, err := NewCLI()
+		if err != nil {
+			return err
+		}
+
+		resp, err := cli.cts.CheckHost(&task.CheckHostArgs{})
+		cli.cts.Close()
+		if status.Code(err) == codes.Unavailable {
+			// no daemon, check from here instead
+			client, err := api.InstantiateClient()
+			if err != nil {
+				return err
+			}
+			resp = client.CheckHost(context.Background())
+			resp.Checks = append(resp.Checks, &task.HostCheck{
+				Name:    "daemon",
+				Result:  task.HostCheck_WARN,
+				Message: fmt.Sprintf("not reachable on port %d, checked from the CLI instead", daemonPort),
+			})
+			if resp.Result < task.HostCheck_WARN {
+				resp.Result = task.HostCheck_WARN
+			}
+		} else if err != nil {
+			cli.logger.Error().Msgf("Check failed: %v", err)
+			return err
+		}
+
+		if checkJSON {
+			out, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(out))
+		} else {
+			table := tablewriter.NewWriter(os.Stdout)
+			table.SetHeader([]string{"Check", "Result", "Message"})
+			table.SetAutoWrapText(false)
+			for _, check := range resp.Checks {
+				table.Append([]string{check.Name, check.Result.String(), check.Message})
+			}
+			table.Render()
+		}
+
+		// for automation, as errors returned from commands don't set the exit code
+		if resp.Result == task.HostCheck_FAIL {
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+func init() {
+	checkCmd.Flags().BoolVar(&checkJSON, "json", false, "print the checks as json")
+	rootCmd.AddCommand(checkCmd)
+}
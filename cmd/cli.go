@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cedana/cedana/api"
 	"github.com/cedana/cedana/api/services"
@@ -16,6 +18,7 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	bolt "go.etcd.io/bbolt"
 )
@@ -38,11 +41,66 @@ var netPid int32
 var isK3s bool
 var tcpEstablished bool
 
+// iterative pre-dump before the final dump
+var preDumpIterations int32
+var preDumpThreshold uint64
+
+// compression of the checkpoint archive, defaults to the client config
+var compression string
+var compressionLevel int32
+
+// processes checkpointed along with a job: tree or session
+var scope string
+
+// open files copied into the checkpoint: written or all
+var captureFiles string
+
+// criu options of a process dump or restore, over the named profile
+var criuProfile string
+var criuGhostLimit uint32
+var criuExternal []string
+var criuTcp string
+var criuFileLocks bool
+var criuLinkRemap bool
+var criuSkipInFlight bool
+var criuCgroupsMode string
+
+// targets of the standard streams of a restored process
+var stdinTarget string
+var stdoutTarget string
+var stderrTarget string
+
+// restore shell jobs onto a pty of the daemon's, to attach to
+var restorePty bool
+
+// run or restore the job in a pid namespace of its own
+var pidNamespace bool
+
+// put back the captured files of checkpoints not signed by a trusted key
+var restoreFiles bool
+
+// cgroup limits of a job
+var limitMemory string
+var limitCPUs float64
+var limitPids int64
+
 // working directory for execTask
 var wd string
 var execAsRoot bool
 var execWithEnv string
 
+// scheduled checkpoints of a job
+var checkpointInterval int64
+var checkpointCron string
+var checkpointRemote bool
+var checkpointDir string
+var checkpointKeep int32
+var clearPolicy bool
+
+// garbage collection of checkpoints past retention
+var gcDryRun bool
+var gcJob string
+
 type CLI struct {
 	cfg    *utils.Config
 	cts    *services.ServiceClient
@@ -55,7 +113,7 @@ func NewCLI() (*CLI, error) {
 		return nil, err
 	}
 
-	cts, _ := services.NewClient("localhost:8080")
+	cts, _ := services.NewClient(fmt.Sprintf("localhost:%d", daemonPort))
 
 	logger := utils.GetLogger()
 
@@ -108,10 +166,23 @@ var dumpProcessCmd = &cobra.Command{
 
 		// always self serve when invoked from CLI
 		cpuDumpArgs := task.DumpArgs{
-			PID:   int32(pid),
-			Dir:   dir,
-			JobID: id,
-			Type:  task.DumpArgs_LOCAL,
+			PID:               int32(pid),
+			Dir:               dir,
+			JobID:             id,
+			Type:              task.DumpArgs_LOCAL,
+			PreDumpIterations: preDumpIterations,
+			PreDumpThreshold:  preDumpThreshold,
+			Compression:       compression,
+			CompressionLevel:  compressionLevel,
+		}
+		if cpuDumpArgs.Scope, err = jobScope(); err != nil {
+			return err
+		}
+		if cpuDumpArgs.Files, err = fileCapture(); err != nil {
+			return err
+		}
+		if cpuDumpArgs.CriuOpts, err = criuOpts(cmd); err != nil {
+			return err
 		}
 
 		resp, err := cli.cts.CheckpointTask(&cpuDumpArgs)
@@ -146,6 +217,18 @@ var restoreProcessCmd = &cobra.Command{
 			CheckpointId:   "Not Implemented",
 			CheckpointPath: args[0],
 		}
+		if restoreArgs.CriuOpts, err = criuOpts(cmd); err != nil {
+			return err
+		}
+		if err := setStdio(&restoreArgs); err != nil {
+			return err
+		}
+		restoreArgs.Pty = restorePty
+		restoreArgs.PidNamespace = pidNamespace
+		restoreArgs.RestoreFiles = restoreFiles
+		if restoreArgs.Limits, err = resourceLimits(cmd); err != nil {
+			return err
+		}
 
 		resp, err := cli.cts.RestoreTask(&restoreArgs)
 		if err != nil {
@@ -158,6 +241,10 @@ var restoreProcessCmd = &cobra.Command{
 		}
 
 		cli.logger.Info().Msgf("Response: %v", resp.Message)
+		printStdio(cli.logger, resp)
+		if restorePty {
+			cli.logger.Info().Msgf("attach to it with: cedana attach %s", attachTarget(restoreArgs.JobID, resp.NewPID))
+		}
 
 		cli.cts.Close()
 
@@ -215,10 +302,24 @@ var dumpJobCmd = &cobra.Command{
 		}
 
 		dumpArgs := task.DumpArgs{
-			PID:   pid,
-			JobID: id,
-			Dir:   dir,
-			Type:  taskType,
+			PID:               pid,
+			JobID:             id,
+			Dir:               dir,
+			Type:              taskType,
+			PreDumpIterations: preDumpIterations,
+			PreDumpThreshold:  preDumpThreshold,
+			Compression:       compression,
+			CompressionLevel:  compressionLevel,
+		}
+		// jobs keep the scope they were started with unless one is given
+		if dumpArgs.Scope, err = jobScope(); err != nil {
+			return err
+		}
+		if dumpArgs.Files, err = fileCapture(); err != nil {
+			return err
+		}
+		if dumpArgs.CriuOpts, err = criuOpts(cmd); err != nil {
+			return err
 		}
 
 		resp, err := cli.cts.CheckpointTask(&dumpArgs)
@@ -274,6 +375,7 @@ var restoreJobCmd = &cobra.Command{
 				CheckpointPath: "",
 				Type:           task.RestoreArgs_REMOTE,
 				JobID:          args[0],
+				Checksum:       remoteState[len(remoteState)-1].Checksum,
 			}
 		} else {
 			paths, err := db.GetLatestLocalCheckpoints(args[0])
@@ -292,8 +394,21 @@ var restoreJobCmd = &cobra.Command{
 				CheckpointId:   "",
 				CheckpointPath: checkpointPath,
 				Type:           task.RestoreArgs_LOCAL,
+				JobID:          args[0],
 			}
 		}
+		if restoreArgs.CriuOpts, err = criuOpts(cmd); err != nil {
+			return err
+		}
+		if err := setStdio(&restoreArgs); err != nil {
+			return err
+		}
+		restoreArgs.Pty = restorePty
+		restoreArgs.PidNamespace = pidNamespace
+		restoreArgs.RestoreFiles = restoreFiles
+		if restoreArgs.Limits, err = resourceLimits(cmd); err != nil {
+			return err
+		}
 		// pass path to restore task
 		resp, err := cli.cts.RestoreTask(&restoreArgs)
 		if err != nil {
@@ -305,6 +420,53 @@ var restoreJobCmd = &cobra.Command{
 			}
 		}
 
+		cli.logger.Info().Msgf("Response: %v", resp.Message)
+		printStdio(cli.logger, resp)
+		if restorePty {
+			cli.logger.Info().Msgf("attach to it with: cedana attach %s", attachTarget(restoreArgs.JobID, resp.NewPID))
+		}
+
+		cli.cts.Close()
+
+		return nil
+	},
+}
+
+// -----------------------
+// Live migration between cedana daemons
+// -----------------------
+
+var migrateTarget string
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Live migrate a job to another cedana daemon, sending its memory straight to a page server on the target",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cli, err := NewCLI()
+		if err != nil {
+			return err
+		}
+
+		migrateArgs := &task.MigrateArgs{
+			JobID:             args[0],
+			Target:            migrateTarget,
+			PageServerAddress: pageServerAddress,
+			Dir:               dir,
+		}
+
+		resp, err := cli.cts.Migrate(migrateArgs)
+		if err != nil {
+			st, ok := status.FromError(err)
+			if ok {
+				cli.logger.Error().Msgf("Migrate task failed: %v: %v", st.Code(), st.Message())
+			} else {
+				cli.logger.Error().Msgf("Migrate task failed: %v", err)
+			}
+			cli.cts.Close()
+			return err
+		}
+
 		cli.logger.Info().Msgf("Response: %v", resp.Message)
 
 		cli.cts.Close()
@@ -433,11 +595,22 @@ var execTaskCmd = &cobra.Command{
 		}
 
 		taskArgs := &task.StartTaskArgs{
-			Task:       taskToExec,
-			Id:         args[1],
-			WorkingDir: wd,
-			UID:        uid,
-			GID:        gid,
+			Task:         taskToExec,
+			Id:           args[1],
+			WorkingDir:   wd,
+			UID:          uid,
+			GID:          gid,
+			PidNamespace: pidNamespace,
+		}
+		if taskArgs.Scope, err = jobScope(); err != nil {
+			return err
+		}
+		if taskArgs.Limits, err = resourceLimits(cmd); err != nil {
+			return err
+		}
+
+		if checkpointInterval > 0 || checkpointCron != "" {
+			taskArgs.CheckpointPolicy = checkpointPolicy()
 		}
 
 		resp, err := cli.cts.StartTask(taskArgs)
@@ -456,6 +629,202 @@ var execTaskCmd = &cobra.Command{
 	},
 }
 
+// -----------------------
+// Scheduled checkpoints of a job
+// -----------------------
+
+var policyCmd = &cobra.Command{
+	Use:   "policy",
+	Short: "Set or clear the checkpoint policy of a job, to checkpoint it on an interval or a cron schedule",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cli, err := NewCLI()
+		if err != nil {
+			return err
+		}
+
+		policyArgs := &task.SetCheckpointPolicyArgs{
+			JobID: args[0],
+		}
+		if !clearPolicy {
+			if checkpointInterval == 0 && checkpointCron == "" {
+				return fmt.Errorf("either --interval, --cron or --clear is required")
+			}
+			policyArgs.Policy = checkpointPolicy()
+		}
+
+		resp, err := cli.cts.SetCheckpointPolicy(policyArgs)
+		if err != nil {
+			st, ok := status.FromError(err)
+			if ok {
+				cli.logger.Error().Msgf("Set checkpoint policy failed: %v: %v", st.Code(), st.Message())
+			} else {
+				cli.logger.Error().Msgf("Set checkpoint policy failed: %v", err)
+			}
+			cli.cts.Close()
+			return err
+		}
+
+		cli.logger.Info().Msgf("Response: %v", resp.Message)
+
+		cli.cts.Close()
+
+		return nil
+	},
+}
+
+func jobScope() (task.JobScope, error) {
+	switch scope {
+	case "":
+		return task.JobScope_UNSPECIFIED, nil
+	case "tree":
+		return task.JobScope_TREE, nil
+	case "session":
+		return task.JobScope_SESSION, nil
+	default:
+		return task.JobScope_UNSPECIFIED, fmt.Errorf("unknown scope %q, expected tree or session", scope)
+	}
+}
+
+func fileCapture() (task.FileCapture, error) {
+	switch captureFiles {
+	case "":
+		return task.FileCapture_NO_FILES, nil
+	case "written":
+		return task.FileCapture_WRITTEN_FILES, nil
+	case "all":
+		return task.FileCapture_ALL_FILES, nil
+	default:
+		return task.FileCapture_NO_FILES, fmt.Errorf("unknown file capture %q, expected written or all", captureFiles)
+	}
+}
+
+// stdioTarget parses a stream target of the restore flags, a path optionally
+// prefixed with its kind: file: (the default), append:, fifo: or unix:
+func stdioTarget(spec string) (*task.StdioTarget, error) {
+	if spec == "" {
+		return nil, nil
+	}
+	target := &task.StdioTarget{Path: spec}
+	if kind, path, ok := strings.Cut(spec, ":"); ok {
+		target.Path = path
+		switch kind {
+		case "file":
+		case "append":
+			target.Append = true
+		case "fifo":
+			target.Type = task.StdioTarget_FIFO
+		case "unix":
+			target.Type = task.StdioTarget_SOCKET
+		default:
+			return nil, fmt.Errorf("unknown stdio target %q, expected file:, append:, fifo: or unix:", kind)
+		}
+	}
+	return target, nil
+}
+
+// setStdio sets the stream targets of the restore flags on args
+func setStdio(args *task.RestoreArgs) error {
+	var err error
+	if args.Stdin, err = stdioTarget(stdinTarget); err != nil {
+		return err
+	}
+	if args.Stdout, err = stdioTarget(stdoutTarget); err != nil {
+		return err
+	}
+	args.Stderr, err = stdioTarget(stderrTarget)
+	return err
+}
+
+// printStdio logs where the streams of a restored process went
+func printStdio(logger zerolog.Logger, resp *task.RestoreResp) {
+	for i, target := range []*task.StdioTarget{resp.Stdin, resp.Stdout, resp.Stderr} {
+		if target != nil {
+			logger.Info().Msgf("%s: %s %s", []string{"stdin", "stdout", "stderr"}[i], strings.ToLower(target.Type.String()), target.Path)
+		}
+	}
+}
+
+// criuOpts are the criu options given on the command line, nil if none were
+func criuOpts(cmd *cobra.Command) (*task.CriuProcessOpts, error) {
+	opts := &task.CriuProcessOpts{
+		Profile:           criuProfile,
+		GhostLimit:        criuGhostLimit,
+		External:          criuExternal,
+		ManageCgroupsMode: criuCgroupsMode,
+	}
+	switch criuTcp {
+	case "":
+	case "established":
+		opts.Tcp = task.CriuProcessOpts_TCP_ESTABLISHED
+	case "close":
+		opts.Tcp = task.CriuProcessOpts_TCP_CLOSE
+	default:
+		return nil, fmt.Errorf("unknown tcp mode %q, expected established or close", criuTcp)
+	}
+	// only the flags given override the profile
+	if cmd.Flags().Changed("file-locks") {
+		opts.FileLocks = &criuFileLocks
+	}
+	if cmd.Flags().Changed("link-remap") {
+		opts.LinkRemap = &criuLinkRemap
+	}
+	if cmd.Flags().Changed("skip-in-flight") {
+		opts.SkipInFlight = &criuSkipInFlight
+	}
+
+	if proto.Equal(opts, &task.CriuProcessOpts{}) {
+		return nil, nil
+	}
+	return opts, nil
+}
+
+// resourceLimits are the cgroup limits given on the command line, nil if none were
+func resourceLimits(cmd *cobra.Command) (*task.ResourceLimits, error) {
+	var limits *task.ResourceLimits
+	if cmd.Flags().Changed("memory") {
+		memory, err := utils.ParseBytes(limitMemory)
+		if err != nil {
+			return nil, err
+		}
+		limits = &task.ResourceLimits{MemoryMax: &memory}
+	}
+	if cmd.Flags().Changed("cpus") {
+		if limits == nil {
+			limits = &task.ResourceLimits{}
+		}
+		limits.CPUs = &limitCPUs
+	}
+	if cmd.Flags().Changed("pids") {
+		if limits == nil {
+			limits = &task.ResourceLimits{}
+		}
+		limits.PidsMax = &limitPids
+	}
+	return limits, nil
+}
+
+// addLimitFlags adds the cgroup limit flags to c
+func addLimitFlags(c *cobra.Command) {
+	c.Flags().StringVar(&limitMemory, "memory", "", "memory limit of the job, in bytes or with a K, M, G or T suffix, 0 for none")
+	c.Flags().Float64Var(&limitCPUs, "cpus", 0, "cpu limit of the job, in cpus worth of time (e.g. 1.5), 0 for none")
+	c.Flags().Int64Var(&limitPids, "pids", 0, "limit on the number of processes of the job, 0 for none")
+}
+
+func checkpointPolicy() *task.CheckpointPolicy {
+	policy := &task.CheckpointPolicy{
+		IntervalSeconds: checkpointInterval,
+		Cron:            checkpointCron,
+		Dir:             checkpointDir,
+		MaxKeep:         checkpointKeep,
+		Type:            task.DumpArgs_LOCAL,
+	}
+	if checkpointRemote {
+		policy.Type = task.DumpArgs_REMOTE
+	}
+	return policy
+}
+
 var psCmd = &cobra.Command{
 	Use:   "ps",
 	Short: "List managed processes",
@@ -474,7 +843,8 @@ var psCmd = &cobra.Command{
 
 		defer conn.Close()
 
-		// job ID, PID, isRunning, CheckpointPath, Remote checkpoint ID
+		// job ID, PID, isRunning, CheckpointPath, Remote checkpoint ID, Command,
+		// followed by a row for every other process of the job
 		var data [][]string
 		err = conn.View(func(tx *bolt.Tx) error {
 			root := tx.Bucket([]byte("default"))
@@ -503,7 +873,18 @@ var psCmd = &cobra.Command{
 						status = state.ProcessInfo.Status
 					}
 
-					data = append(data, []string{jobId, string(k), status, state.CheckpointPath, remoteCheckpointID})
+					var command string
+					var others [][]string
+					for _, member := range api.JobMembers(&state) {
+						if member.PID == state.PID {
+							command = member.Cmdline
+							continue
+						}
+						others = append(others, []string{"", fmt.Sprintf("└ %d", member.PID), "", "", "", member.Cmdline})
+					}
+
+					data = append(data, []string{jobId, string(k), status, state.CheckpointPath, remoteCheckpointID, command})
+					data = append(data, others...)
 					return nil
 				})
 			})
@@ -514,7 +895,7 @@ var psCmd = &cobra.Command{
 		}
 
 		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"Job ID", "PID", "Status", "Local Checkpoint Path", "Remote Checkpoint ID"})
+		table.SetHeader([]string{"Job ID", "PID", "Status", "Local Checkpoint Path", "Remote Checkpoint ID", "Command"})
 
 		for _, v := range data {
 			table.Append(v)
@@ -525,6 +906,55 @@ var psCmd = &cobra.Command{
 	},
 }
 
+// -----------------------
+// Garbage collection of checkpoints past retention
+// -----------------------
+
+var gcCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Delete the checkpoints past the retention policy of the config, and the deduplicated chunks no checkpoint uses anymore",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cli, err := NewCLI()
+		if err != nil {
+			return err
+		}
+
+		resp, err := cli.cts.GarbageCollect(&task.GarbageCollectArgs{
+			DryRun: gcDryRun,
+			JobID:  gcJob,
+		})
+		if err != nil {
+			st, ok := status.FromError(err)
+			if ok {
+				cli.logger.Error().Msgf("Garbage collection failed: %v: %v", st.Code(), st.Message())
+			} else {
+				cli.logger.Error().Msgf("Garbage collection failed: %v", err)
+			}
+			cli.cts.Close()
+			return err
+		}
+
+		cli.cts.Close()
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Job ID", "Taken", "Local Checkpoint Path", "Remote Checkpoint ID", "Size"})
+		for _, c := range resp.Checkpoints {
+			taken := time.Unix(c.Timestamp, 0).Format(time.RFC3339)
+			table.Append([]string{c.JobID, taken, c.CheckpointPath, c.CheckpointID, strconv.FormatInt(c.Size, 10)})
+		}
+		table.Render()
+
+		verb := "Deleted"
+		if gcDryRun {
+			verb = "Would delete"
+		}
+		fmt.Printf("%s %d checkpoints and %d chunks, %d bytes\n", verb, len(resp.Checkpoints), resp.FreedChunks, resp.FreedBytes)
+
+		return nil
+	},
+}
+
 func initContainerdCommands() {
 	containerdDumpCmd.Flags().StringVarP(&ref, "image", "i", "", "image checkpoint path")
 	containerdDumpCmd.MarkFlagRequired("image")
@@ -549,17 +979,73 @@ func init() {
 	dumpCmd.AddCommand(dumpJobCmd)
 	dumpJobCmd.Flags().StringVarP(&dir, "dir", "d", "", "directory to dump to")
 
+	for _, c := range []*cobra.Command{dumpProcessCmd, dumpJobCmd} {
+		c.Flags().Int32Var(&preDumpIterations, "pre-dump", 0, "number of iterative pre-dump passes to run before the final dump")
+		c.Flags().Uint64Var(&preDumpThreshold, "pre-dump-threshold", 0, "stop pre-dumping once a pass writes fewer than this many pages")
+		c.Flags().StringVar(&compression, "compression", "", "compression of the checkpoint: none, gzip, lz4 or zstd (defaults to the client config)")
+		c.Flags().Int32Var(&compressionLevel, "compression-level", 0, "compression level, 0 for the default of the algorithm")
+		c.Flags().StringVar(&scope, "scope", "", "processes to checkpoint: tree (the process and its descendants) or session (every process of its session)")
+		c.Flags().StringVar(&captureFiles, "capture-files", "", "copy open files into the checkpoint, to restore them on hosts without them: written (files open for writing) or all")
+	}
+
 	restoreCmd.AddCommand(restoreProcessCmd)
 	restoreCmd.AddCommand(restoreJobCmd)
 
+	for _, c := range []*cobra.Command{restoreProcessCmd, restoreJobCmd} {
+		c.Flags().StringVar(&stdinTarget, "stdin", "", "redirect stdin of the restored process from [file:|fifo:|unix:]path")
+		c.Flags().StringVar(&stdoutTarget, "stdout", "", "redirect stdout of the restored process to [file:|append:|fifo:|unix:]path, a log file in /var/log by default")
+		c.Flags().StringVar(&stderrTarget, "stderr", "", "redirect stderr of the restored process to [file:|append:|fifo:|unix:]path")
+		c.Flags().BoolVar(&restorePty, "pty", false, "restore a shell job onto a new pty, to attach to with cedana attach")
+		c.Flags().BoolVar(&pidNamespace, "pid-namespace", false, "restore into a new pid namespace, so the process keeps its pids whatever the host has in use")
+		c.Flags().BoolVar(&restoreFiles, "restore-files", false, "put back the files captured into a checkpoint not signed by a trusted key, overwriting them on the host")
+		addLimitFlags(c)
+	}
+
+	for _, c := range []*cobra.Command{dumpProcessCmd, dumpJobCmd, restoreProcessCmd, restoreJobCmd} {
+		c.Flags().StringVar(&criuProfile, "criu-profile", "", "criu option profile from the client config, the other criu flags override it")
+		c.Flags().Uint32Var(&criuGhostLimit, "ghost-limit", 0, "largest deleted file criu keeps in the checkpoint, in bytes")
+		c.Flags().StringArrayVar(&criuExternal, "external", nil, "external resource for criu, e.g. mnt[/mnt]:data (repeatable)")
+		c.Flags().StringVar(&criuTcp, "tcp", "", "established tcp connections: established (checkpoint them) or close")
+		c.Flags().BoolVar(&criuFileLocks, "file-locks", false, "checkpoint file locks")
+		c.Flags().BoolVar(&criuLinkRemap, "link-remap", false, "allow criu to link-remap deleted files")
+		c.Flags().BoolVar(&criuSkipInFlight, "skip-in-flight", false, "skip tcp connections not yet accepted")
+		c.Flags().StringVar(&criuCgroupsMode, "manage-cgroups-mode", "", "cgroups mode of criu: soft, full, strict, props or ignore")
+	}
+
 	execTaskCmd.Flags().StringVarP(&wd, "working-dir", "w", "", "working directory")
 	execTaskCmd.Flags().BoolVarP(&execAsRoot, "root", "r", false, "run as root")
 	execTaskCmd.Flags().StringVarP(&execWithEnv, "env", "e", "", "file w/ environment variables")
+	execTaskCmd.Flags().StringVar(&scope, "scope", "", "processes checkpointed with the job: tree (the task and its descendants) or session (every process of its session, daemons included)")
+	execTaskCmd.Flags().BoolVar(&pidNamespace, "pid-namespace", false, "run the task in a pid namespace of its own, so its restores never clash on pids")
+	addLimitFlags(execTaskCmd)
+	execTaskCmd.Flags().Int64Var(&checkpointInterval, "checkpoint-interval", 0, "checkpoint the job every this many seconds")
+	execTaskCmd.Flags().StringVar(&checkpointCron, "checkpoint-cron", "", "checkpoint the job on a cron schedule, e.g. \"0 * * * *\"")
+	execTaskCmd.Flags().BoolVar(&checkpointRemote, "checkpoint-remote", false, "upload the scheduled checkpoints")
+	execTaskCmd.Flags().StringVar(&checkpointDir, "checkpoint-dir", "", "directory of the scheduled checkpoints, defaults to the dump storage dir")
+	execTaskCmd.Flags().Int32Var(&checkpointKeep, "checkpoint-keep", 0, "number of scheduled checkpoints to keep, 0 keeps them all")
+
+	policyCmd.Flags().Int64Var(&checkpointInterval, "interval", 0, "checkpoint the job every this many seconds")
+	policyCmd.Flags().StringVar(&checkpointCron, "cron", "", "checkpoint the job on a cron schedule, e.g. \"0 * * * *\"")
+	policyCmd.Flags().BoolVar(&checkpointRemote, "remote", false, "upload the scheduled checkpoints")
+	policyCmd.Flags().StringVarP(&checkpointDir, "dir", "d", "", "directory of the scheduled checkpoints, defaults to the dump storage dir")
+	policyCmd.Flags().Int32Var(&checkpointKeep, "keep", 0, "number of scheduled checkpoints to keep, 0 keeps them all")
+	policyCmd.Flags().BoolVar(&clearPolicy, "clear", false, "stop the scheduled checkpoints of the job")
 
 	rootCmd.AddCommand(dumpCmd)
 	rootCmd.AddCommand(restoreCmd)
 	rootCmd.AddCommand(execTaskCmd)
 	rootCmd.AddCommand(psCmd)
+	rootCmd.AddCommand(policyCmd)
+
+	gcCmd.Flags().BoolVar(&gcDryRun, "dry-run", false, "only list what would be deleted")
+	gcCmd.Flags().StringVar(&gcJob, "job", "", "only collect the checkpoints of this job")
+	rootCmd.AddCommand(gcCmd)
+
+	migrateCmd.Flags().StringVarP(&migrateTarget, "to", "t", "", "address of the target cedana daemon, host:port")
+	migrateCmd.MarkFlagRequired("to")
+	migrateCmd.Flags().StringVar(&pageServerAddress, "page-server-address", "", "address the target's page server is reachable on, defaults to the target host")
+	migrateCmd.Flags().StringVarP(&dir, "dir", "d", "", "local directory to dump to before sending the checkpoint over")
+	rootCmd.AddCommand(migrateCmd)
 	rootCmd.AddCommand(runcRoot)
 	initRuncCommands()
 
@@ -59,7 +59,7 @@ var startDaemonCmd = &cobra.Command{
 func startgRPCServer(isK8s bool) {
 	logger := utils.GetLogger()
 
-	if _, err := api.StartGRPCServer(isK8s); err != nil {
+	if _, err := api.StartGRPCServer(isK8s, daemonPort); err != nil {
 		logger.Error().Err(err).Msg("Failed to start gRPC server")
 	}
 
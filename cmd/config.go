@@ -41,8 +41,24 @@ var generateCmd = &cobra.Command{
 	},
 }
 
+var keygenCmd = &cobra.Command{
+	Use:   "keygen <path>",
+	Short: "generate a checkpoint signing key at path, and its public key at path.pub",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		keyID, err := utils.GenerateSigningKey(args[0])
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("generated signing key %s, add %s.pub to the trust store of the daemons restoring its checkpoints\n", keyID, args[0])
+		return nil
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(configCmd)
 	configCmd.AddCommand(showCmd)
 	configCmd.AddCommand(generateCmd)
+	configCmd.AddCommand(keygenCmd)
 }
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+)
+
+// members of a group checkpoint
+var groupJobs []string
+var groupContainers []string
+var groupLeaveRunning bool
+
+var dumpGroupCmd = &cobra.Command{
+	Use:   "group",
+	Short: "Checkpoint jobs [--job] and runc containers [--container] together, freezing all of them before the first dump",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cli, err := NewCLI()
+		if err != nil {
+			return err
+		}
+
+		groupArgs := &task.GroupDumpArgs{
+			JobIDs:       groupJobs,
+			Dir:          dir,
+			LeaveRunning: groupLeaveRunning,
+		}
+		for _, id := range groupContainers {
+			groupArgs.Containers = append(groupArgs.Containers, &task.GroupMember{ContainerID: id, Root: root})
+		}
+
+		resp, err := cli.cts.GroupDump(groupArgs)
+		if err != nil {
+			st, ok := status.FromError(err)
+			if ok {
+				cli.logger.Error().Msgf("Group checkpoint failed: %v: %v", st.Code(), st.Message())
+			} else {
+				cli.logger.Error().Msgf("Group checkpoint failed: %v", err)
+			}
+			cli.cts.Close()
+			return err
+		}
+
+		cli.cts.Close()
+
+		cli.logger.Info().Msgf("Checkpointed group %s", resp.GroupID)
+		printGroup(resp.Members)
+		return nil
+	},
+}
+
+var restoreGroupCmd = &cobra.Command{
+	Use:   "group <group-id>",
+	Short: "Restore the jobs and containers of a group checkpoint together, or none of them",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cli, err := NewCLI()
+		if err != nil {
+			return err
+		}
+
+		resp, err := cli.cts.GroupRestore(&task.GroupRestoreArgs{GroupID: args[0]})
+		if err != nil {
+			st, ok := status.FromError(err)
+			if ok {
+				cli.logger.Error().Msgf("Group restore failed: %v: %v", st.Code(), st.Message())
+			} else {
+				cli.logger.Error().Msgf("Group restore failed: %v", err)
+			}
+			cli.cts.Close()
+			return err
+		}
+
+		cli.cts.Close()
+
+		printGroup(resp.Members)
+		return nil
+	},
+}
+
+func printGroup(members []*task.GroupMember) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Job ID", "Container ID", "PID", "Checkpoint Path"})
+	for _, m := range members {
+		table.Append([]string{m.JobID, m.ContainerID, strconv.Itoa(int(m.PID)), m.CheckpointPath})
+	}
+	table.Render()
+}
+
+func init() {
+	dumpGroupCmd.Flags().StringArrayVar(&groupJobs, "job", nil, "id of a job in the group, can be repeated")
+	dumpGroupCmd.Flags().StringArrayVar(&groupContainers, "container", nil, "id of a runc container in the group, can be repeated")
+	dumpGroupCmd.Flags().StringVarP(&root, "root", "r", "/var/run/runc", "runc root directory of the containers")
+	dumpGroupCmd.Flags().StringVarP(&dir, "dir", "d", "", "directory to dump to, defaults to the dump storage dir")
+	dumpGroupCmd.Flags().BoolVar(&groupLeaveRunning, "leave-running", false, "resume the group after the checkpoint, rather than killing it")
+	dumpCmd.AddCommand(dumpGroupCmd)
+
+	restoreCmd.AddCommand(restoreGroupCmd)
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// how far back to list the samples of a job, all of them if 0
+var metricsSince time.Duration
+
+var metricsCmd = &cobra.Command{
+	Use:   "metrics <job>",
+	Short: "Show the resource usage of a job over time, as sampled by the daemon",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cli, err := NewCLI()
+		if err != nil {
+			return err
+		}
+		defer cli.cts.Close()
+
+		metricsArgs := &task.GetJobMetricsArgs{JobID: args[0]}
+		if metricsSince > 0 {
+			metricsArgs.Since = time.Now().Add(-metricsSince).UnixNano()
+		}
+		resp, err := cli.cts.GetJobMetrics(metricsArgs)
+		if err != nil {
+			return err
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Time", "RSS", "PSS", "Anon", "File", "Threads", "CPU User (s)", "CPU Sys (s)", "IO Read", "IO Write", "Mappings"})
+		for _, sample := range resp.Samples {
+			table.Append(usageRow(time.Unix(0, sample.Timestamp).Format(time.RFC3339), sample.Usage))
+		}
+		if resp.Current != nil {
+			table.Append(usageRow("now", resp.Current))
+		}
+		table.Render()
+		return nil
+	},
+}
+
+func usageRow(when string, u *task.ResourceUsage) []string {
+	if u == nil {
+		u = &task.ResourceUsage{}
+	}
+	return []string{
+		when,
+		strconv.FormatUint(u.RSS, 10),
+		strconv.FormatUint(u.PSS, 10),
+		strconv.FormatUint(u.AnonBytes, 10),
+		strconv.FormatUint(u.FileBytes, 10),
+		strconv.Itoa(int(u.Threads)),
+		fmt.Sprintf("%.2f", u.CPUUser),
+		fmt.Sprintf("%.2f", u.CPUSystem),
+		strconv.FormatUint(u.IOReadBytes, 10),
+		strconv.FormatUint(u.IOWriteBytes, 10),
+		strconv.Itoa(int(u.Mappings)),
+	}
+}
+
+func init() {
+	metricsCmd.Flags().DurationVar(&metricsSince, "since", 0, "only show the samples of this long ago onwards, e.g. 1h")
+	rootCmd.AddCommand(metricsCmd)
+}
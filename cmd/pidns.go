@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/cedana/cedana/api"
+	"github.com/spf13/cobra"
+)
+
+// started by the daemon as pid 1 of the pid namespace of a job
+var pidnsInitCmd = &cobra.Command{
+	Use:    api.PidnsInitCommand + " -- command [args...]",
+	Short:  "Run a command as the init of its own pid namespace, reaping orphans",
+	Hidden: true,
+	Args:   cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		code, err := api.PidnsInit(args)
+		if err != nil {
+			return err
+		}
+		os.Exit(code)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(pidnsInitCmd)
+}
@@ -5,6 +5,9 @@ import (
 )
 
 var (
+	// port the daemon listens on, and the cli talks to
+	daemonPort int
+
 	// Used for flags.
 	rootCmd = &cobra.Command{
 		Use:   "cedana",
@@ -29,4 +32,5 @@ func Execute() error {
 
 func init() {
 	cobra.OnInitialize()
+	rootCmd.PersistentFlags().IntVar(&daemonPort, "port", 8080, "port of the cedana daemon")
 }
@@ -9,6 +9,15 @@ import (
 var containerName string
 var checkpointId string
 
+// incremental runc checkpoints
+var runcPreDump bool
+var autoDedup bool
+
+// lazy-pages migration of runc containers
+var lazy bool
+var pageServerAddress string
+var pageServerPort int32
+
 var runcRoot = &cobra.Command{
 	Use:   "runc",
 	Short: "Runc related commands such as ps, get runc id by container name (k8s), etc.",
@@ -63,6 +72,8 @@ var runcDumpCmd = &cobra.Command{
 			WorkDirectory:   workPath,
 			LeaveRunning:    true,
 			TcpEstablished:  tcpEstablished,
+			PreDump:         runcPreDump,
+			AutoDedup:       autoDedup,
 		}
 
 		dumpArgs := task.RuncDumpArgs{
@@ -71,7 +82,10 @@ var runcDumpCmd = &cobra.Command{
 			ContainerId:    containerId,
 			CriuOpts:       criuOpts,
 			//TODO BS: hard coded for now
-			Type: task.RuncDumpArgs_REMOTE,
+			Type:              task.RuncDumpArgs_REMOTE,
+			Lazy:              lazy,
+			PageServerAddress: pageServerAddress,
+			PageServerPort:    pageServerPort,
 		}
 
 		resp, err := cli.cts.CheckpointRunc(&dumpArgs)
@@ -111,12 +125,15 @@ var runcRestoreCmd = &cobra.Command{
 		}
 
 		restoreArgs := &task.RuncRestoreArgs{
-			ImagePath:    dir,
-			ContainerId:  containerId,
-			IsK3S:        isK3s,
-			Opts:         opts,
-			Type:         task.RuncRestoreArgs_LOCAL,
-			CheckpointId: checkpointId,
+			ImagePath:         dir,
+			ContainerId:       containerId,
+			IsK3S:             isK3s,
+			Opts:              opts,
+			Type:              task.RuncRestoreArgs_LOCAL,
+			CheckpointId:      checkpointId,
+			Lazy:              lazy,
+			PageServerAddress: pageServerAddress,
+			PageServerPort:    pageServerPort,
 		}
 
 		resp, err := cli.cts.RuncRestore(restoreArgs)
@@ -149,6 +166,9 @@ func initRuncCommands() {
 	runcRestoreCmd.Flags().BoolVarP(&detach, "detach", "e", false, "run runc container in detached mode")
 	runcRestoreCmd.Flags().BoolVar(&isK3s, "isK3s", false, "pass whether or not we are checkpointing a container in a k3s agent")
 	runcRestoreCmd.Flags().Int32VarP(&netPid, "netPid", "n", 0, "provide the network pid to restore to in k3s")
+	runcRestoreCmd.Flags().BoolVar(&lazy, "lazy", false, "restore immediately, faulting memory in from the page server of a lazy dump")
+	runcRestoreCmd.Flags().StringVar(&pageServerAddress, "page-server-address", "", "address of the page server of the lazy dump")
+	runcRestoreCmd.Flags().Int32Var(&pageServerPort, "page-server-port", 0, "port of the page server of the lazy dump")
 
 	restoreCmd.AddCommand(runcRestoreCmd)
 
@@ -157,6 +177,11 @@ func initRuncCommands() {
 	runcDumpCmd.Flags().StringVarP(&containerId, "id", "i", "", "container id")
 	runcDumpCmd.MarkFlagRequired("id")
 	runcDumpCmd.Flags().BoolVarP(&tcpEstablished, "tcp-established", "t", false, "tcp established")
+	runcDumpCmd.Flags().BoolVar(&runcPreDump, "pre-dump", false, "take an iterative pre-dump, the next dump to the same dir builds on it")
+	runcDumpCmd.Flags().BoolVar(&autoDedup, "auto-dedup", false, "deduplicate pages already present in parent images")
+	runcDumpCmd.Flags().BoolVar(&lazy, "lazy", false, "leave memory pages behind a page server, for a lazy restore to fault in")
+	runcDumpCmd.Flags().StringVar(&pageServerAddress, "page-server-address", "0.0.0.0", "address for the page server to listen on")
+	runcDumpCmd.Flags().Int32Var(&pageServerPort, "page-server-port", 0, "port for the page server to listen on")
 
 	dumpCmd.AddCommand(runcDumpCmd)
 
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cedana/cedana/api/services/task"
+	"github.com/spf13/cobra"
+)
+
+var updateCmd = &cobra.Command{
+	Use:   "update <job>",
+	Short: "Change the cgroup limits of a running job",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		limits, err := resourceLimits(cmd)
+		if err != nil {
+			return err
+		}
+		if limits == nil {
+			return fmt.Errorf("no limits to update, expected --memory, --cpus or --pids")
+		}
+
+		cli, err := NewCLI()
+		if err != nil {
+			return err
+		}
+		defer cli.cts.Close()
+
+		resp, err := cli.cts.UpdateJob(&task.UpdateJobArgs{JobID: args[0], Limits: limits})
+		if err != nil {
+			return err
+		}
+
+		// unset limits are not there at all
+		memory, cpus, pids := "max", "max", "max"
+		if l := resp.Limits; l != nil {
+			if l.MemoryMax != nil {
+				memory = strconv.FormatInt(*l.MemoryMax, 10)
+			}
+			if l.CPUs != nil {
+				cpus = strconv.FormatFloat(*l.CPUs, 'g', -1, 64)
+			}
+			if l.PidsMax != nil {
+				pids = strconv.FormatInt(*l.PidsMax, 10)
+			}
+		}
+		fmt.Printf("job %s limits, memory: %s, cpus: %s, pids: %s\n", args[0], memory, cpus, pids)
+		return nil
+	},
+}
+
+func init() {
+	addLimitFlags(updateCmd)
+	rootCmd.AddCommand(updateCmd)
+}
@@ -499,6 +499,12 @@ type CriuOpts struct {
 	External                []string           // ignore external namespaces
 	MntnsCompatMode         bool
 	TcpClose                bool
+	PageServer              CriuPageServerInfo // allow to dump to criu page server
+}
+
+type CriuPageServerInfo struct {
+	Address string // IP address of CRIU page server
+	Port    int32  // port number of CRIU page server
 }
 
 type loadedState struct {
@@ -1390,6 +1396,14 @@ func (c *RuncContainer) RuncCheckpoint(criuOpts *CriuOpts, pid int, runcRoot str
 		rpcOpts.TrackMem = proto.Bool(true)
 	}
 
+	// append optional criu opts, e.g., page-server and port
+	if criuOpts.PageServer.Address != "" && criuOpts.PageServer.Port != 0 {
+		rpcOpts.Ps = &criurpc.CriuPageServerInfo{
+			Address: proto.String(criuOpts.PageServer.Address),
+			Port:    proto.Int32(criuOpts.PageServer.Port),
+		}
+	}
+
 	// append optional manage cgroups mode
 	if criuOpts.ManageCgroupsMode != 0 {
 		mode := criuOpts.ManageCgroupsMode
@@ -1440,6 +1454,8 @@ func (c *RuncContainer) RuncCheckpoint(criuOpts *CriuOpts, pid int, runcRoot str
 				// For criu 3.15+, use notifications (see case "status-ready"
 				// in criuNotifications). Otherwise, rely on criu status fd.
 				rpcOpts.StatusFd = proto.Int32(int32(fd))
+				// closed along with the request from here on
+				criuOpts.StatusFd = -1
 			}
 		}
 	}
@@ -1503,6 +1519,15 @@ func (c *RuncContainer) RuncCheckpoint(criuOpts *CriuOpts, pid int, runcRoot str
 func (c *RuncContainer) criuSwrk(process *Process, req *criurpc.CriuReq, opts *CriuOpts, extraFiles []*os.File) error {
 	logger := utils.GetLogger()
 
+	// the status fd of the request is closed once criu answers, or here if it
+	// never gets to
+	defer func() {
+		if req.Opts != nil && req.Opts.StatusFd != nil {
+			_ = unix.Close(int(*req.Opts.StatusFd))
+			req.Opts.StatusFd = nil
+		}
+	}()
+
 	fds, err := unix.Socketpair(unix.AF_LOCAL, unix.SOCK_SEQPACKET|unix.SOCK_CLOEXEC, 0)
 	if err != nil {
 		return err
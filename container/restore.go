@@ -34,6 +34,7 @@ type RuncOpts struct {
 	PreserveFds     int
 	Pid             int
 	NetPid          int
+	LazyPages       bool // fault memory in through a lazy-pages daemon
 }
 
 func Restore(imgPath string, containerID string) error {
@@ -171,6 +172,7 @@ func RuncRestore(imgPath string, containerId string, opts RuncOpts) error {
 		External:        externalMounts,
 		MntnsCompatMode: false,
 		TcpClose:        true,
+		LazyPages:       opts.LazyPages,
 	}
 
 	runcOpts := &RuncOpts{
@@ -0,0 +1,278 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Process jobs get a cgroup v2 of their own under the parent cgroup from the
+// config, named after the job, with the memory, cpu and pids controllers on.
+
+// CgroupMount is where the unified cgroup hierarchy is mounted
+const CgroupMount = "/sys/fs/cgroup"
+
+// DefaultCgroupParent holds the cgroups of the jobs when the cgroup config
+// doesn't say
+const DefaultCgroupParent = "cedana"
+
+// period of the cpu.max quotas, in microseconds
+const cgroupCPUPeriod = 100000
+
+// the kernel's smallest cpu.max quota, in microseconds
+const cgroupMinCPUQuota = 1000
+
+var cgroupControllers = []string{"cpu", "memory", "pids"}
+
+// ParentPath returns the cgroup of the job cgroups, relative to the mount
+func (c Cgroup) ParentPath() string {
+	if c.Parent == "" {
+		return DefaultCgroupParent
+	}
+	return strings.Trim(c.Parent, "/")
+}
+
+func (c Cgroup) Validate() error {
+	parent := c.ParentPath()
+	if path.Clean(parent) != parent || strings.HasPrefix(parent, "..") {
+		return fmt.Errorf("cgroup parent %q is not a clean path under %s", c.Parent, CgroupMount)
+	}
+	return nil
+}
+
+// CgroupLimits are resource limits of a cgroup. Nil limits are left alone, zero
+// lifts one.
+type CgroupLimits struct {
+	// bytes
+	MemoryMax *int64
+	// cpus worth of time
+	CPUs    *float64
+	PidsMax *int64
+}
+
+func (l CgroupLimits) Validate() error {
+	if l.MemoryMax != nil && *l.MemoryMax < 0 {
+		return fmt.Errorf("invalid memory limit %d", *l.MemoryMax)
+	}
+	if l.CPUs != nil && (*l.CPUs < 0 || *l.CPUs > 0 && *l.CPUs*cgroupCPUPeriod < cgroupMinCPUQuota) {
+		return fmt.Errorf("invalid cpu limit %g, expected at least %g cpus", *l.CPUs, float64(cgroupMinCPUQuota)/cgroupCPUPeriod)
+	}
+	if l.PidsMax != nil && *l.PidsMax < 0 {
+		return fmt.Errorf("invalid pids limit %d", *l.PidsMax)
+	}
+	return nil
+}
+
+// files maps the cgroup files of the limits to what gets written to them
+func (l CgroupLimits) files() map[string]string {
+	files := map[string]string{}
+	if l.MemoryMax != nil {
+		files["memory.max"] = "max"
+		if *l.MemoryMax > 0 {
+			files["memory.max"] = strconv.FormatInt(*l.MemoryMax, 10)
+		}
+	}
+	if l.CPUs != nil {
+		quota := "max"
+		if *l.CPUs > 0 {
+			quota = strconv.FormatInt(int64(*l.CPUs*cgroupCPUPeriod), 10)
+		}
+		files["cpu.max"] = fmt.Sprintf("%s %d", quota, cgroupCPUPeriod)
+	}
+	if l.PidsMax != nil {
+		files["pids.max"] = "max"
+		if *l.PidsMax > 0 {
+			files["pids.max"] = strconv.FormatInt(*l.PidsMax, 10)
+		}
+	}
+	return files
+}
+
+// CgroupV2 tells whether the unified cgroup hierarchy is mounted
+func CgroupV2() bool {
+	_, err := os.Stat(filepath.Join(CgroupMount, "cgroup.controllers"))
+	return err == nil
+}
+
+// JobCgroup creates the cgroup of a job under parent, or reuses the one left from
+// before, and returns its path relative to the mount
+func JobCgroup(parent, name string) (string, error) {
+	if !CgroupV2() {
+		return "", fmt.Errorf("no cgroup v2 hierarchy mounted on %s", CgroupMount)
+	}
+
+	cgroup := path.Join("/", parent, strings.ReplaceAll(name, "/", "_"))
+	// the controllers have to be on in every ancestor for the job to get them
+	dir := CgroupMount
+	for _, elem := range strings.Split(strings.TrimPrefix(cgroup, "/"), "/") {
+		if err := enableControllers(dir); err != nil {
+			return "", err
+		}
+		dir = filepath.Join(dir, elem)
+		if err := os.Mkdir(dir, 0o755); err != nil && !os.IsExist(err) {
+			return "", err
+		}
+	}
+	return cgroup, nil
+}
+
+// enableControllers turns the job controllers on for the children of the cgroup
+// in dir, those of them it has
+func enableControllers(dir string) error {
+	data, err := os.ReadFile(filepath.Join(dir, "cgroup.controllers"))
+	if err != nil {
+		return err
+	}
+	available := strings.Fields(string(data))
+
+	var enable []string
+	for _, controller := range cgroupControllers {
+		for _, a := range available {
+			if a == controller {
+				enable = append(enable, "+"+controller)
+			}
+		}
+	}
+	if len(enable) == 0 {
+		return nil
+	}
+	if err := os.WriteFile(filepath.Join(dir, "cgroup.subtree_control"), []byte(strings.Join(enable, " ")), 0); err != nil {
+		return fmt.Errorf("could not enable the %s controllers of %s: %w", strings.Join(cgroupControllers, ", "), dir, err)
+	}
+	return nil
+}
+
+// SetCgroupLimits writes limits to the cgroup
+func SetCgroupLimits(cgroup string, limits CgroupLimits) error {
+	files := limits.files()
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(CgroupMount, cgroup, name), []byte(files[name]), 0); err != nil {
+			return fmt.Errorf("could not set %s of cgroup %s to %s: %w", name, cgroup, files[name], err)
+		}
+	}
+	return nil
+}
+
+// ReadCgroupLimits reads the limits a cgroup is under, leaving out the ones it
+// doesn't have
+func ReadCgroupLimits(cgroup string) (CgroupLimits, error) {
+	var limits CgroupLimits
+	read := func(name string) ([]string, error) {
+		data, err := os.ReadFile(filepath.Join(CgroupMount, cgroup, name))
+		if os.IsNotExist(err) {
+			// controller is off
+			return nil, nil
+		}
+		return strings.Fields(string(data)), err
+	}
+
+	memory, err := read("memory.max")
+	if err != nil {
+		return limits, err
+	}
+	if len(memory) == 1 && memory[0] != "max" {
+		v, err := strconv.ParseInt(memory[0], 10, 64)
+		if err != nil {
+			return limits, fmt.Errorf("malformed memory.max of cgroup %s: %v", cgroup, err)
+		}
+		limits.MemoryMax = &v
+	}
+
+	cpu, err := read("cpu.max")
+	if err != nil {
+		return limits, err
+	}
+	if len(cpu) == 2 && cpu[0] != "max" {
+		quota, err1 := strconv.ParseInt(cpu[0], 10, 64)
+		period, err2 := strconv.ParseInt(cpu[1], 10, 64)
+		if err1 != nil || err2 != nil || period == 0 {
+			return limits, fmt.Errorf("malformed cpu.max of cgroup %s", cgroup)
+		}
+		cpus := float64(quota) / float64(period)
+		limits.CPUs = &cpus
+	}
+
+	pids, err := read("pids.max")
+	if err != nil {
+		return limits, err
+	}
+	if len(pids) == 1 && pids[0] != "max" {
+		v, err := strconv.ParseInt(pids[0], 10, 64)
+		if err != nil {
+			return limits, fmt.Errorf("malformed pids.max of cgroup %s: %v", cgroup, err)
+		}
+		limits.PidsMax = &v
+	}
+
+	return limits, nil
+}
+
+// AddToCgroup moves a process into the cgroup
+func AddToCgroup(cgroup string, pid int32) error {
+	return os.WriteFile(filepath.Join(CgroupMount, cgroup, "cgroup.procs"), []byte(strconv.Itoa(int(pid))), 0)
+}
+
+// CgroupPopulated tells whether any process is left in the cgroup or below it
+func CgroupPopulated(cgroup string) (bool, error) {
+	data, err := os.ReadFile(filepath.Join(CgroupMount, cgroup, "cgroup.events"))
+	if err != nil {
+		return false, err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, "populated ") {
+			return strings.TrimPrefix(line, "populated ") != "0", nil
+		}
+	}
+	return false, fmt.Errorf("no populated field in the events of cgroup %s", cgroup)
+}
+
+// RemoveCgroup deletes an empty cgroup, if it's still there
+func RemoveCgroup(cgroup string) error {
+	if err := os.Remove(filepath.Join(CgroupMount, cgroup)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// ProcessCgroup reads the cgroup v2 of a process, relative to the mount
+func ProcessCgroup(pid int32) (string, error) {
+	data, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(int(pid)), "cgroup"))
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, "0::") {
+			return strings.TrimPrefix(line, "0::"), nil
+		}
+	}
+	return "", fmt.Errorf("process %d is in no cgroup v2", pid)
+}
+
+// ParseBytes reads a size in bytes, with an optional K, M, G or T suffix for
+// powers of 1024
+func ParseBytes(s string) (int64, error) {
+	multiplier := int64(1)
+	number := strings.TrimSuffix(strings.ToUpper(strings.TrimSpace(s)), "B")
+	for i, suffix := range []string{"K", "M", "G", "T"} {
+		if strings.HasSuffix(number, suffix) {
+			number = strings.TrimSuffix(number, suffix)
+			multiplier = 1 << (10 * (i + 1))
+			break
+		}
+	}
+	v, err := strconv.ParseInt(number, 10, 64)
+	if err != nil || v < 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return v * multiplier, nil
+}
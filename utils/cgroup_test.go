@@ -0,0 +1,55 @@
+package utils
+
+import "testing"
+
+func TestCgroupLimitFiles(t *testing.T) {
+	memory, cpus, pids := int64(512<<20), 1.5, int64(0)
+	files := CgroupLimits{MemoryMax: &memory, CPUs: &cpus, PidsMax: &pids}.files()
+
+	want := map[string]string{
+		"memory.max": "536870912",
+		"cpu.max":    "150000 100000",
+		"pids.max":   "max",
+	}
+	if len(files) != len(want) {
+		t.Fatalf("expected %v, got %v", want, files)
+	}
+	for name, value := range want {
+		if files[name] != value {
+			t.Errorf("expected %s to be %q, got %q", name, value, files[name])
+		}
+	}
+
+	if files := (CgroupLimits{}).files(); len(files) != 0 {
+		t.Errorf("expected unset limits left alone, got %v", files)
+	}
+}
+
+func TestCgroupLimitsValidate(t *testing.T) {
+	negative, tiny := int64(-1), 0.001
+	for _, limits := range []CgroupLimits{{MemoryMax: &negative}, {PidsMax: &negative}, {CPUs: &tiny}} {
+		if err := limits.Validate(); err == nil {
+			t.Errorf("expected %+v to be invalid", limits)
+		}
+	}
+
+	if err := (Cgroup{Parent: "../escape"}).Validate(); err == nil {
+		t.Error("expected a parent outside the hierarchy to be invalid")
+	}
+	if parent := (Cgroup{Parent: "/jobs/"}).ParentPath(); parent != "jobs" {
+		t.Errorf("expected the parent relative to the mount, got %q", parent)
+	}
+}
+
+func TestParseBytes(t *testing.T) {
+	for s, want := range map[string]int64{"1024": 1024, "4K": 4 << 10, "512m": 512 << 20, "2GB": 2 << 30} {
+		if got, err := ParseBytes(s); err != nil || got != want {
+			t.Errorf("expected %s to be %d, got %d, %v", s, want, got, err)
+		}
+	}
+	for _, s := range []string{"", "lots", "-1K"} {
+		if _, err := ParseBytes(s); err == nil {
+			t.Errorf("expected %q to be invalid", s)
+		}
+	}
+}
@@ -0,0 +1,461 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Checkpoint images are split into content-defined chunks, so that the pages
+// that didn't change between two checkpoints of a job end up in the same chunks,
+// and get stored (and uploaded) once. Cut points are picked with a gear hash,
+// between minChunkSize and maxChunkSize and averaging around 64KiB.
+const (
+	minChunkSize = 16 << 10
+	maxChunkSize = 256 << 10
+	chunkMask    = (1 << 16) - 1
+
+	// chunk indexes start with this line, followed by the index as json
+	chunkIndexMagic = "CDNCHUNKS1\n"
+	// chunk stores live under the shared storage dir
+	ChunkStoreDir = "cedana-chunks"
+)
+
+var gearTable [256]uint64
+
+func init() {
+	// splitmix64 with a fixed seed, the table must never change or cut points
+	// (and with them deduplication against older chunks) would shift
+	seed := uint64(0x6365646167656172)
+	for i := range gearTable {
+		seed += 0x9e3779b97f4a7c15
+		z := seed
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		gearTable[i] = z ^ (z >> 31)
+	}
+}
+
+// ChunkedFile is a file of a checkpoint, as the list of chunks it is made of
+type ChunkedFile struct {
+	Path   string      `json:"path"`
+	Mode   os.FileMode `json:"mode"`
+	Size   int64       `json:"size,omitempty"`
+	Link   string      `json:"link,omitempty"`
+	Chunks []string    `json:"chunks,omitempty"`
+}
+
+// ChunkIndex replaces the tar of a deduplicated checkpoint, referencing its
+// files by chunk in a ChunkStore.
+type ChunkIndex struct {
+	Files []ChunkedFile `json:"files"`
+}
+
+// ChunkSource fetches chunks missing from the local store, as they were stored
+type ChunkSource interface {
+	GetChunk(ctx context.Context, hash string) (io.ReadCloser, error)
+}
+
+// ChunkStore keeps chunks by their sha256, zstd compressed and encrypted if keys
+// is set, so a chunk can be shipped around as stored.
+type ChunkStore struct {
+	dir  string
+	keys *Keyring
+}
+
+func NewChunkStore(dir string, keys *Keyring) (*ChunkStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &ChunkStore{dir: dir, keys: keys}, nil
+}
+
+func (cs *ChunkStore) path(hash string) string {
+	return filepath.Join(cs.dir, hash[:2], hash)
+}
+
+func (cs *ChunkStore) Has(hash string) bool {
+	_, err := os.Stat(cs.path(hash))
+	return err == nil
+}
+
+// Put stores a chunk unless it is already there, and returns its hash
+func (cs *ChunkStore) Put(chunk []byte) (string, error) {
+	sum := sha256.Sum256(chunk)
+	hash := hex.EncodeToString(sum[:])
+	if cs.Has(hash) {
+		return hash, nil
+	}
+
+	err := cs.writeAtomic(hash, func(w io.Writer) error {
+		ew, err := cs.keys.NewEncryptWriter(w)
+		if err != nil {
+			return err
+		}
+		cw, err := NewCompressWriter(ew, Compression{Algorithm: CompressionZstd})
+		if err != nil {
+			return err
+		}
+		if _, err := cw.Write(chunk); err != nil {
+			return err
+		}
+		if err := cw.Close(); err != nil {
+			return err
+		}
+		return ew.Close()
+	})
+	return hash, err
+}
+
+// PutStored stores a chunk as read from another store, e.g. a remote one
+func (cs *ChunkStore) PutStored(hash string, r io.Reader) error {
+	if len(hash) != sha256.Size*2 {
+		return fmt.Errorf("invalid chunk hash %q", hash)
+	}
+	return cs.writeAtomic(hash, func(w io.Writer) error {
+		_, err := io.Copy(w, r)
+		return err
+	})
+}
+
+// OpenStored opens a chunk as stored, to ship it to another store
+func (cs *ChunkStore) OpenStored(hash string) (*os.File, error) {
+	return os.Open(cs.path(hash))
+}
+
+// Get reads a chunk back, checking it still hashes to its name
+func (cs *ChunkStore) Get(hash string) ([]byte, error) {
+	file, err := cs.OpenStored(hash)
+	if err != nil {
+		return nil, fmt.Errorf("chunk %s: %w", hash, err)
+	}
+	defer file.Close()
+
+	return cs.readChunk(file, hash)
+}
+
+// readChunk decodes a chunk as stored off r, checking it hashes to hash
+func (cs *ChunkStore) readChunk(r io.Reader, hash string) ([]byte, error) {
+	dr, err := cs.keys.NewDecryptReader(r)
+	if err != nil {
+		return nil, err
+	}
+	zr, _, err := NewDecompressReader(dr)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	chunk, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: chunk %s: %v", ErrChecksumMismatch, hash, err)
+	}
+
+	sum := sha256.Sum256(chunk)
+	if hex.EncodeToString(sum[:]) != hash {
+		return nil, fmt.Errorf("%w: chunk %s is corrupted", ErrChecksumMismatch, hash)
+	}
+
+	return chunk, nil
+}
+
+func (cs *ChunkStore) writeAtomic(hash string, write func(w io.Writer) error) error {
+	path := cs.path(hash)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+hash+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := write(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	// a chunk is stored under its hash once it is known to have it, as it is
+	// never written again
+	written, err := os.Open(tmp.Name())
+	if err != nil {
+		return err
+	}
+	_, err = cs.readChunk(written, hash)
+	written.Close()
+	if err != nil {
+		return err
+	}
+
+	// concurrent puts of the same chunk write the same content
+	return os.Rename(tmp.Name(), path)
+}
+
+// ChunkFolder splits every file under dir into the store, and returns the index
+// to put it back together.
+func (cs *ChunkStore) ChunkFolder(dir string) (*ChunkIndex, error) {
+	index := &ChunkIndex{}
+
+	err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+
+		f := ChunkedFile{Path: relPath, Mode: fi.Mode()}
+		switch {
+		case fi.Mode()&os.ModeSymlink != 0:
+			f.Link, err = os.Readlink(path)
+			if err != nil {
+				return err
+			}
+		case fi.Mode().IsRegular():
+			f.Size = fi.Size()
+			f.Chunks, err = cs.chunkFile(path)
+			if err != nil {
+				return err
+			}
+		case !fi.IsDir():
+			return nil
+		}
+
+		index.Files = append(index.Files, f)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+func (cs *ChunkStore) chunkFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var chunks []string
+	err = splitChunks(file, func(chunk []byte) error {
+		hash, err := cs.Put(chunk)
+		if err != nil {
+			return err
+		}
+		chunks = append(chunks, hash)
+		return nil
+	})
+	return chunks, err
+}
+
+// Fetch pulls the chunks of index missing from the store out of src
+func (cs *ChunkStore) Fetch(ctx context.Context, index *ChunkIndex, src ChunkSource) error {
+	for _, hash := range index.Hashes() {
+		if cs.Has(hash) {
+			continue
+		}
+
+		r, err := src.GetChunk(ctx, hash)
+		if err != nil {
+			return fmt.Errorf("could not fetch chunk %s: %w", hash, err)
+		}
+		err = cs.PutStored(hash, r)
+		r.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reassemble writes the files of index back out under dest
+func (cs *ChunkStore) Reassemble(index *ChunkIndex, dest string) error {
+	for _, f := range index.Files {
+		target := filepath.Join(dest, f.Path)
+		if target == filepath.Clean(dest) {
+			return fmt.Errorf("chunked file %s escapes %s", f.Path, dest)
+		}
+		if err := checkExtractPath(dest, target); err != nil {
+			return fmt.Errorf("chunked file %s: %w", f.Path, err)
+		}
+
+		switch {
+		case f.Mode.IsDir():
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case f.Mode&os.ModeSymlink != 0:
+			if err := checkLink(dest, target, f.Link); err != nil {
+				return fmt.Errorf("chunked file %s: %w", f.Path, err)
+			}
+			if err := os.Symlink(f.Link, target); err != nil {
+				return err
+			}
+		default:
+			if err := cs.reassembleFile(f, target); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (cs *ChunkStore) reassembleFile(f ChunkedFile, target string) error {
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, f.Mode.Perm())
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	for _, hash := range f.Chunks {
+		chunk, err := cs.Get(hash)
+		if err != nil {
+			return err
+		}
+		if _, err := out.Write(chunk); err != nil {
+			return err
+		}
+	}
+
+	return out.Close()
+}
+
+// Sweep deletes the chunks none of the referenced hashes point to, leaving the
+// ones newer than grace alone as they may belong to a checkpoint still being
+// written. Returns the count and bytes of the chunks deleted, or that would be
+// if dryRun is set.
+func (cs *ChunkStore) Sweep(referenced map[string]bool, grace time.Duration, dryRun bool) (int, int64, error) {
+	var count int
+	var size int64
+	cutoff := time.Now().Add(-grace)
+
+	err := filepath.Walk(cs.dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() || strings.HasPrefix(fi.Name(), ".") {
+			return nil
+		}
+		if referenced[fi.Name()] || fi.ModTime().After(cutoff) {
+			return nil
+		}
+
+		if !dryRun {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+		count++
+		size += fi.Size()
+		return nil
+	})
+
+	return count, size, err
+}
+
+// Hashes returns every chunk referenced by the index, once
+func (index *ChunkIndex) Hashes() []string {
+	seen := map[string]bool{}
+	var hashes []string
+	for _, f := range index.Files {
+		for _, hash := range f.Chunks {
+			if !seen[hash] {
+				seen[hash] = true
+				hashes = append(hashes, hash)
+			}
+		}
+	}
+	return hashes
+}
+
+func WriteChunkIndex(w io.Writer, index *ChunkIndex) error {
+	if _, err := io.WriteString(w, chunkIndexMagic); err != nil {
+		return err
+	}
+	return json.NewEncoder(w).Encode(index)
+}
+
+// ReadChunkIndex reads a chunk index off r, if that's what r starts with.
+// Otherwise returns a nil index, along with a reader of r from the start.
+func ReadChunkIndex(r io.Reader) (*ChunkIndex, io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(len(chunkIndexMagic))
+	if !bytes.Equal(magic, []byte(chunkIndexMagic)) {
+		return nil, br, nil
+	}
+
+	if _, err := br.Discard(len(chunkIndexMagic)); err != nil {
+		return nil, nil, err
+	}
+
+	var index ChunkIndex
+	if err := json.NewDecoder(br).Decode(&index); err != nil {
+		return nil, nil, fmt.Errorf("%w: could not parse chunk index: %v", ErrChecksumMismatch, err)
+	}
+
+	return &index, nil, nil
+}
+
+// splitChunks cuts r into content-defined chunks, handing each to fn. The chunk
+// is only valid for the duration of the call.
+func splitChunks(r io.Reader, fn func(chunk []byte) error) error {
+	buf := make([]byte, maxChunkSize)
+	n := 0
+	eof := false
+
+	for {
+		for n < maxChunkSize && !eof {
+			m, err := r.Read(buf[n:])
+			n += m
+			if err == io.EOF {
+				eof = true
+			} else if err != nil {
+				return err
+			}
+		}
+		if n == 0 {
+			return nil
+		}
+
+		cut := cutPoint(buf[:n])
+		if err := fn(buf[:cut]); err != nil {
+			return err
+		}
+		n = copy(buf, buf[cut:n])
+	}
+}
+
+func cutPoint(data []byte) int {
+	if len(data) <= minChunkSize {
+		return len(data)
+	}
+
+	var h uint64
+	for i := minChunkSize; i < len(data); i++ {
+		h = (h << 1) + gearTable[data[i]]
+		if h&chunkMask == 0 {
+			return i + 1
+		}
+	}
+	return len(data)
+}
@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"bytes"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func countChunks(t *testing.T, dir string) int {
+	n := 0
+	err := filepath.Walk(dir, func(_ string, fi os.FileInfo, err error) error {
+		if err == nil && fi.Mode().IsRegular() {
+			n++
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// Checkpoints a folder twice, with a small change in between, and checks only the
+// chunks around the change get stored again.
+func TestChunkStoreDedup(t *testing.T) {
+	storeDir := t.TempDir()
+	chunks, err := NewChunkStore(storeDir, testKeyring(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pages := make([]byte, 4<<20)
+	rand.New(rand.NewSource(1)).Read(pages)
+
+	src := t.TempDir()
+	if err := os.WriteFile(filepath.Join(src, "pages-1.img"), pages, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("pre-dump-1", filepath.Join(src, "parent")); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := chunks.ChunkFolder(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := countChunks(t, storeDir)
+
+	// a dirtied page, shifting everything after it
+	pages = append(pages[:1<<20], append([]byte("dirty"), pages[1<<20:]...)...)
+	if err := os.WriteFile(filepath.Join(src, "pages-1.img"), pages, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := chunks.ChunkFolder(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added := countChunks(t, storeDir) - stored; added > 2 {
+		t.Errorf("expected at most 2 new chunks for a small change, got %d of %d", added, len(second.Hashes()))
+	}
+
+	var buf bytes.Buffer
+	if err := WriteChunkIndex(&buf, second); err != nil {
+		t.Fatal(err)
+	}
+	index, _, err := ReadChunkIndex(&buf)
+	if err != nil || index == nil {
+		t.Fatalf("could not read chunk index back: %v", err)
+	}
+
+	dest := t.TempDir()
+	if err := chunks.Reassemble(index, dest); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filepath.Join(dest, "pages-1.img"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, pages) {
+		t.Error("reassembled file differs from the original")
+	}
+	if link, err := os.Readlink(filepath.Join(dest, "parent")); err != nil || link != "pre-dump-1" {
+		t.Errorf("parent link not restored: %q, %v", link, err)
+	}
+
+	if len(first.Hashes()) == 0 {
+		t.Error("first checkpoint has no chunks")
+	}
+}
+
+// Chunks are only stored under the hash they have, and indexes can't link out of
+// where they are reassembled.
+func TestChunkStoreRefusesBadInput(t *testing.T) {
+	keys := testKeyring(t)
+	chunks, err := NewChunkStore(t.TempDir(), keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	good, err := chunks.Put([]byte("good chunk"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bad, err := chunks.Put([]byte("bad chunk"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := NewChunkStore(t.TempDir(), keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored, err := chunks.OpenStored(bad)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stored.Close()
+	if err := other.PutStored(good, stored); err == nil {
+		t.Error("expected a chunk stored under another hash to be refused")
+	}
+	if other.Has(good) {
+		t.Error("refused chunk was stored")
+	}
+	stored.Seek(0, 0)
+	if err := other.PutStored(bad, stored); err != nil || !other.Has(bad) {
+		t.Errorf("expected a chunk stored under its hash to be taken, got %v", err)
+	}
+
+	for _, index := range []*ChunkIndex{
+		{Files: []ChunkedFile{{Path: "parent", Mode: os.ModeSymlink, Link: "/etc"}}},
+		{Files: []ChunkedFile{{Path: "parent", Mode: os.ModeSymlink, Link: "../.."}}},
+		{Files: []ChunkedFile{{Path: "parent", Mode: os.ModeSymlink, Link: "."}, {Path: "parent/pages-1.img", Mode: 0o644, Chunks: []string{good}}}},
+	} {
+		if err := chunks.Reassemble(index, t.TempDir()); err == nil {
+			t.Errorf("expected %+v to be refused", index.Files)
+		}
+	}
+}
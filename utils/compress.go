@@ -2,128 +2,155 @@ package utils
 
 import (
 	"archive/tar"
+	"bufio"
+	"bytes"
 	"compress/gzip"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4"
 )
 
-func TarFolder(srcFolder, destTar string) error {
-	file, err := os.Create(destTar)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	tw := tar.NewWriter(file)
-	defer tw.Close()
+// Compression algorithms for checkpoint archives
+const (
+	CompressionNone = "none"
+	CompressionGzip = "gzip"
+	CompressionLZ4  = "lz4"
+	CompressionZstd = "zstd"
+)
 
-	err = filepath.Walk(srcFolder, func(file string, fi os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
+// magic bytes at the start of each compressed stream, used to pick the decompressor
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	lz4Magic  = []byte{0x04, 0x22, 0x4d, 0x18}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
 
-		header, err := tar.FileInfoHeader(fi, file)
-		if err != nil {
-			return err
-		}
+// Compression selects the algorithm and level a checkpoint archive is written with.
+// A zero level picks the default of the algorithm.
+type Compression struct {
+	Algorithm string
+	Level     int
+}
 
-		// Adjust the file's path to exclude the base directory
-		relPath, err := filepath.Rel(srcFolder, file)
-		if err != nil {
-			return err
-		}
-		header.Name = relPath
+// ValidateCompression checks algorithm is one we can write, an empty one meaning none.
+func ValidateCompression(algorithm string) error {
+	switch algorithm {
+	case "", CompressionNone, CompressionGzip, CompressionLZ4, CompressionZstd:
+		return nil
+	default:
+		return fmt.Errorf("unknown compression %q, expected one of none, gzip, lz4, zstd", algorithm)
+	}
+}
 
-		if err := tw.WriteHeader(header); err != nil {
-			return err
-		}
+// Extension returns the file extension of a tar compressed with c.
+func (c Compression) Extension() string {
+	switch c.Algorithm {
+	case CompressionGzip:
+		return ".tar.gz"
+	case CompressionLZ4:
+		return ".tar.lz4"
+	case CompressionZstd:
+		return ".tar.zst"
+	default:
+		return ".tar"
+	}
+}
 
-		if !fi.Mode().IsRegular() {
-			return nil
+// NewCompressWriter wraps w in a writer compressing with c. Closing it flushes the
+// compressed stream, but doesn't close w.
+func NewCompressWriter(w io.Writer, c Compression) (io.WriteCloser, error) {
+	switch c.Algorithm {
+	case "", CompressionNone:
+		return nopWriteCloser{w}, nil
+	case CompressionGzip:
+		level := c.Level
+		if level == 0 {
+			level = gzip.DefaultCompression
 		}
-
-		srcFile, err := os.Open(file)
-		if err != nil {
-			return err
+		return gzip.NewWriterLevel(w, level)
+	case CompressionLZ4:
+		zw := lz4.NewWriter(w)
+		zw.Header.CompressionLevel = c.Level
+		return zw, nil
+	case CompressionZstd:
+		level := zstd.SpeedDefault
+		if c.Level != 0 {
+			level = zstd.EncoderLevelFromZstd(c.Level)
 		}
-		defer srcFile.Close()
-
-		_, err = io.Copy(tw, srcFile)
-		return err
-	})
-
-	return err
-}
-
-func UntarFolder(srcTar, destFolder string) error {
-	file, err := os.Open(srcTar)
-	if err != nil {
-		return err
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(level))
+	default:
+		return nil, ValidateCompression(c.Algorithm)
 	}
-	defer file.Close()
-
-	tr := tar.NewReader(file)
+}
 
-	// Iterate through the files in the tarball
-	for {
-		header, err := tr.Next()
-		if err == io.EOF {
-			break // End of tarball
-		}
+// NewDecompressReader detects the compression of r from its magic bytes, and
+// returns a reader of the decompressed stream. Anything not recognized is passed
+// through as is, i.e. a plain tar.
+func NewDecompressReader(r io.Reader) (io.ReadCloser, string, error) {
+	br := bufio.NewReader(r)
+	// a short read just means a stream too small to be compressed
+	magic, _ := br.Peek(4)
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		gr, err := gzip.NewReader(br)
 		if err != nil {
-			return err
+			return nil, "", err
 		}
-
-		// Construct the full path for the file
-		target := filepath.Join(destFolder, header.Name)
-
-		// Check the type of the file
-		switch header.Typeflag {
-		case tar.TypeDir:
-			// Create directory
-			if err := os.MkdirAll(target, 0755); err != nil {
-				return err
-			}
-		case tar.TypeReg:
-			// Create file and write data into it
-			outFile, err := os.Create(target)
-			if err != nil {
-				return err
-			}
-
-			if _, err := io.Copy(outFile, tr); err != nil {
-				outFile.Close()
-				return err
-			}
-			outFile.Close()
+		return gr, CompressionGzip, nil
+	case bytes.HasPrefix(magic, lz4Magic):
+		return io.NopCloser(lz4.NewReader(br)), CompressionLZ4, nil
+	case bytes.HasPrefix(magic, zstdMagic):
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, "", err
 		}
+		return zr.IOReadCloser(), CompressionZstd, nil
+	default:
+		return io.NopCloser(br), CompressionNone, nil
 	}
+}
 
-	return nil
+type nopWriteCloser struct {
+	io.Writer
 }
 
-func TarLZ4Folder(srcFolder, destTar string) error {
+func (nopWriteCloser) Close() error { return nil }
+
+func TarFolder(srcFolder, destTar string) error {
 	file, err := os.Create(destTar)
 	if err != nil {
 		return err
 	}
 	defer file.Close()
 
-	zw := lz4.NewWriter(file)
-	defer zw.Close()
+	return TarFolderToWriter(srcFolder, file)
+}
 
-	tw := tar.NewWriter(zw)
-	defer tw.Close()
+// TarFolderToWriter streams a tar of srcFolder to w, one file at a time, without
+// buffering the archive anywhere.
+func TarFolderToWriter(srcFolder string, w io.Writer) error {
+	tw := tar.NewWriter(w)
 
-	err = filepath.Walk(srcFolder, func(file string, fi os.FileInfo, err error) error {
+	err := filepath.Walk(srcFolder, func(file string, fi os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
-		header, err := tar.FileInfoHeader(fi, file)
+		// pre-dump chains are linked together through a relative "parent" symlink
+		var link string
+		if fi.Mode()&os.ModeSymlink != 0 {
+			link, err = os.Readlink(file)
+			if err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(fi, link)
 		if err != nil {
 			return err
 		}
@@ -152,78 +179,87 @@ func TarLZ4Folder(srcFolder, destTar string) error {
 		_, err = io.Copy(tw, srcFile)
 		return err
 	})
+	if err != nil {
+		return err
+	}
 
-	return err
+	return tw.Close()
 }
 
-func TarGzFolder(srcFolder, destTar string) error {
+// TarCompressFolder writes a tar of srcFolder compressed with c to destTar.
+func TarCompressFolder(srcFolder, destTar string, c Compression) error {
 	file, err := os.Create(destTar)
 	if err != nil {
 		return err
 	}
 	defer file.Close()
 
-	gw := gzip.NewWriter(file)
-	defer gw.Close()
-
-	tw := tar.NewWriter(gw)
-	defer tw.Close()
+	return TarCompressFolderToWriter(srcFolder, file, c)
+}
 
-	err = filepath.Walk(srcFolder, func(file string, fi os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
+// TarCompressFolderToWriter streams a tar of srcFolder compressed with c to w.
+func TarCompressFolderToWriter(srcFolder string, w io.Writer, c Compression) error {
+	cw, err := NewCompressWriter(w, c)
+	if err != nil {
+		return err
+	}
 
-		header, err := tar.FileInfoHeader(fi, file)
-		if err != nil {
-			return err
-		}
+	if err := TarFolderToWriter(srcFolder, cw); err != nil {
+		cw.Close()
+		return err
+	}
 
-		// Adjust the file's path to exclude the base directory
-		relPath, err := filepath.Rel(srcFolder, file)
-		if err != nil {
-			return err
-		}
-		header.Name = relPath
+	return cw.Close()
+}
 
-		if err := tw.WriteHeader(header); err != nil {
-			return err
-		}
+// ArchiveFolderToWriter streams srcFolder to w as a tar compressed with c, and
+// encrypted if keys is set.
+func ArchiveFolderToWriter(srcFolder string, w io.Writer, c Compression, keys *Keyring) error {
+	ew, err := keys.NewEncryptWriter(w)
+	if err != nil {
+		return err
+	}
 
-		if !fi.Mode().IsRegular() {
-			return nil
-		}
+	if err := TarCompressFolderToWriter(srcFolder, ew, c); err != nil {
+		ew.Close()
+		return err
+	}
 
-		srcFile, err := os.Open(file)
-		if err != nil {
-			return err
-		}
-		defer srcFile.Close()
+	return ew.Close()
+}
 
-		_, err = io.Copy(tw, srcFile)
+// UnarchiveFromReader extracts an archive written by ArchiveFolderToWriter into
+// destFolder, detecting its encryption and compression from the stream itself.
+func UnarchiveFromReader(r io.Reader, destFolder string, keys *Keyring) error {
+	dr, err := keys.NewDecryptReader(r)
+	if err != nil {
 		return err
-	})
+	}
 
-	return err
+	return UntarFromReader(dr, destFolder)
 }
 
-func UntarGzFolder(srcTarGz, destFolder string) error {
-	// Open the gzip file
-	file, err := os.Open(srcTarGz)
+func UntarFolder(srcTar, destFolder string) error {
+	file, err := os.Open(srcTar)
 	if err != nil {
 		return err
 	}
 	defer file.Close()
 
-	// Create a gzip reader on top of the file reader
-	gr, err := gzip.NewReader(file)
+	return UntarFromReader(file, destFolder)
+}
+
+// UntarFromReader extracts a tar stream into destFolder as it is read, so a
+// checkpoint can be extracted straight off the network. Compressed tars are
+// detected from their magic bytes and decompressed on the fly.
+func UntarFromReader(r io.Reader, destFolder string) error {
+	dr, _, err := NewDecompressReader(r)
 	if err != nil {
 		return err
 	}
-	defer gr.Close()
+	defer dr.Close()
 
-	// Create a tar reader on top of the gzip reader
-	tr := tar.NewReader(gr)
+	tr := tar.NewReader(dr)
 
 	// Iterate through the files in the tarball
 	for {
@@ -237,6 +273,9 @@ func UntarGzFolder(srcTarGz, destFolder string) error {
 
 		// Construct the full path for the file
 		target := filepath.Join(destFolder, header.Name)
+		if err := checkExtractPath(destFolder, target); err != nil {
+			return fmt.Errorf("tar entry %s: %w", header.Name, err)
+		}
 
 		// Check the type of the file
 		switch header.Typeflag {
@@ -257,8 +296,57 @@ func UntarGzFolder(srcTarGz, destFolder string) error {
 				return err
 			}
 			outFile.Close()
+		case tar.TypeSymlink:
+			if err := checkLink(destFolder, target, header.Linkname); err != nil {
+				return fmt.Errorf("tar entry %s: %w", header.Name, err)
+			}
+			if err := os.Symlink(header.Linkname, target); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
+
+func TarLZ4Folder(srcFolder, destTar string) error {
+	return TarCompressFolder(srcFolder, destTar, Compression{Algorithm: CompressionLZ4})
+}
+
+func TarGzFolder(srcFolder, destTar string) error {
+	return TarCompressFolder(srcFolder, destTar, Compression{Algorithm: CompressionGzip})
+}
+
+func UntarGzFolder(srcTarGz, destFolder string) error {
+	// Open the gzip file
+	file, err := os.Open(srcTarGz)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Create a gzip reader on top of the file reader
+	gr, err := gzip.NewReader(file)
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	return UntarFromReader(gr, destFolder)
+}
+
+// FolderSize sums up the size of the regular files under folder, as an estimate
+// for the size of its tar ahead of streaming it.
+func FolderSize(folder string) (int64, error) {
+	var size int64
+	err := filepath.Walk(folder, func(_ string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.Mode().IsRegular() {
+			size += fi.Size()
+		}
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Compresses a folder with each algorithm and checks it extracts back, with the
+// decompressor picked from the magic bytes alone.
+func TestCompressionRoundtrip(t *testing.T) {
+	src := t.TempDir()
+	data := bytes.Repeat([]byte("cedana"), 1<<12)
+	if err := os.WriteFile(filepath.Join(src, "pages-1.img"), data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, algorithm := range []string{CompressionNone, CompressionGzip, CompressionLZ4, CompressionZstd} {
+		t.Run(algorithm, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := TarCompressFolderToWriter(src, &buf, Compression{Algorithm: algorithm}); err != nil {
+				t.Fatal(err)
+			}
+
+			_, detected, err := NewDecompressReader(bytes.NewReader(buf.Bytes()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if detected != algorithm {
+				t.Errorf("detected %s, expected %s", detected, algorithm)
+			}
+
+			dest := t.TempDir()
+			if err := UntarFromReader(&buf, dest); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := os.ReadFile(filepath.Join(dest, "pages-1.img"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Errorf("extracted file differs from the original")
+			}
+		})
+	}
+}
+
+func TestValidateCompression(t *testing.T) {
+	if err := ValidateCompression("brotli"); err == nil {
+		t.Error("expected an error for an unknown compression")
+	}
+	if err := ValidateCompression(""); err != nil {
+		t.Errorf("empty compression should default to none: %v", err)
+	}
+}
+
+// Tars with links out of the destination are refused, along with entries written
+// through a link.
+func TestUntarRefusesEscapingLinks(t *testing.T) {
+	type entry struct {
+		name, link string
+	}
+	tarOf := func(entries ...entry) *bytes.Buffer {
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		for _, e := range entries {
+			header := &tar.Header{Name: e.name, Typeflag: tar.TypeReg, Mode: 0o644, Size: 1}
+			if e.link != "" {
+				header = &tar.Header{Name: e.name, Typeflag: tar.TypeSymlink, Linkname: e.link}
+			} else if strings.HasSuffix(e.name, "/") {
+				header = &tar.Header{Name: e.name, Typeflag: tar.TypeDir, Mode: 0o755}
+			}
+			if err := tw.WriteHeader(header); err != nil {
+				t.Fatal(err)
+			}
+			if header.Typeflag == tar.TypeReg {
+				tw.Write([]byte("x"))
+			}
+		}
+		tw.Close()
+		return &buf
+	}
+
+	for name, entries := range map[string][]entry{
+		"absolute":   {{name: "a", link: "/etc"}},
+		"relative":   {{name: "a", link: "../.."}},
+		"unclean":    {{name: "b", link: "."}, {name: "a", link: "b/../.."}},
+		"through":    {{name: "a", link: "."}, {name: "a/x"}},
+		"overwrites": {{name: "a", link: "b"}, {name: "a"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := UntarFromReader(tarOf(entries...), t.TempDir()); err == nil {
+				t.Error("expected the tar to be refused")
+			}
+		})
+	}
+
+	// pre-dump chains link their parents within the checkpoint
+	chain := tarOf(entry{name: "parent", link: "pre-dump-1"}, entry{name: "pre-dump-1/"}, entry{name: "pre-dump-1/parent", link: "../pre-dump-0"})
+	if err := UntarFromReader(chain, t.TempDir()); err != nil {
+		t.Errorf("expected a pre-dump chain to extract, got %v", err)
+	}
+}
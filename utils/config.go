@@ -13,6 +13,14 @@ type Config struct {
 	Client        Client        `json:"client" mapstructure:"client"`
 	Connection    Connection    `json:"connection" mapstructure:"connection"`
 	SharedStorage SharedStorage `json:"shared_storage" mapstructure:"shared_storage"`
+	Encryption    Encryption    `json:"encryption" mapstructure:"encryption"`
+	Signing       Signing       `json:"signing" mapstructure:"signing"`
+	Retention     Retention     `json:"retention" mapstructure:"retention"`
+	Network       Network       `json:"network" mapstructure:"network"`
+	Criu          CriuConfig    `json:"criu" mapstructure:"criu"`
+	Restore       Restore       `json:"restore" mapstructure:"restore"`
+	Cgroup        Cgroup        `json:"cgroup" mapstructure:"cgroup"`
+	Metrics       Metrics       `json:"metrics" mapstructure:"metrics"`
 }
 
 type Client struct {
@@ -20,6 +28,9 @@ type Client struct {
 	Task         string `json:"task" mapstructure:"task"`
 	LeaveRunning bool   `json:"leave_running" mapstructure:"leave_running"`
 	ForwardLogs  bool   `json:"forward_logs" mapstructure:"forward_logs"`
+	// checkpoint compression (none, gzip, lz4 or zstd), and its level (0 for default)
+	Compression      string `json:"compression" mapstructure:"compression"`
+	CompressionLevel int    `json:"compression_level" mapstructure:"compression_level"`
 }
 
 type Connection struct {
@@ -30,6 +41,71 @@ type Connection struct {
 
 type SharedStorage struct {
 	DumpStorageDir string `json:"dump_storage_dir" mapstructure:"dump_storage_dir"`
+	// store checkpoints as deduplicated chunks under DumpStorageDir, instead of tars
+	Dedup bool `json:"dedup" mapstructure:"dedup"`
+}
+
+type Encryption struct {
+	// checkpoints are encrypted when a key is set, read from a file or an env variable
+	KeyFile string `json:"key_file" mapstructure:"key_file"`
+	KeyEnv  string `json:"key_env" mapstructure:"key_env"`
+	// rotated out keys, still used to decrypt older checkpoints
+	PreviousKeyFiles []string `json:"previous_key_files" mapstructure:"previous_key_files"`
+}
+
+type Signing struct {
+	// node key checkpoint manifests are signed with, unsigned if empty
+	KeyFile string `json:"key_file" mapstructure:"key_file"`
+	// directory of public keys whose checkpoints we trust
+	TrustStore string `json:"trust_store" mapstructure:"trust_store"`
+	// what to do on restore with untrusted checkpoints: off, warn or enforce
+	Policy string `json:"policy" mapstructure:"policy"`
+}
+
+type Retention struct {
+	// checkpoints kept per job, 0 keeps them all
+	KeepLast int `json:"keep_last" mapstructure:"keep_last"`
+	// checkpoints older than this duration (e.g. "72h") get deleted, empty for no limit
+	MaxAge string `json:"max_age" mapstructure:"max_age"`
+	// bytes of checkpoints kept per job and on the whole node, 0 for no limit
+	MaxJobBytes  int64 `json:"max_job_bytes" mapstructure:"max_job_bytes"`
+	MaxNodeBytes int64 `json:"max_node_bytes" mapstructure:"max_node_bytes"`
+	// how often the daemon collects checkpoints past retention, defaults to an hour
+	GCInterval string `json:"gc_interval" mapstructure:"gc_interval"`
+}
+
+type Network struct {
+	// firewall to lock a job's network with from dump to restore: nftables or
+	// iptables, empty to leave it alone
+	LockBackend string `json:"lock_backend" mapstructure:"lock_backend"`
+}
+
+type CriuConfig struct {
+	// CRIU option profiles requests can name for process dumps and restores
+	Profiles map[string]CriuProfile `json:"profiles" mapstructure:"profiles"`
+}
+
+type Restore struct {
+	// where every restore gets a working directory of its own, named after the
+	// operation, defaults to /tmp/cedana_restore
+	Root string `json:"root" mapstructure:"root"`
+	// how long the working directory of a failed restore is kept along with its
+	// logs (e.g. "24h"), defaults to a day
+	FailedTTL string `json:"failed_ttl" mapstructure:"failed_ttl"`
+}
+
+type Cgroup struct {
+	// cgroup v2 the jobs get their cgroups under, relative to the mount,
+	// defaults to cedana
+	Parent string `json:"parent" mapstructure:"parent"`
+}
+
+type Metrics struct {
+	// how often the daemon samples the resource usage of running jobs (e.g.
+	// "1m"), defaults to a minute
+	Interval string `json:"interval" mapstructure:"interval"`
+	// how long samples are kept (e.g. "24h"), defaults to a day
+	Retention string `json:"retention" mapstructure:"retention"`
 }
 
 func InitConfig() (*Config, error) {
@@ -97,10 +173,46 @@ func GenSampleConfig() string {
 	return `{
 	"client": {
 		"process_name": "",
-		"leave_running": false 
+		"leave_running": false,
+		"compression": "none",
+		"compression_level": 0
 	},
 	"shared_storage": {
-		"dump_storage_dir": "/tmp"
+		"dump_storage_dir": "/tmp",
+		"dedup": false
+	},
+	"encryption": {
+		"key_file": "",
+		"key_env": ""
+	},
+	"signing": {
+		"key_file": "",
+		"trust_store": "",
+		"policy": "off"
+	},
+	"retention": {
+		"keep_last": 0,
+		"max_age": "",
+		"max_job_bytes": 0,
+		"max_node_bytes": 0,
+		"gc_interval": "1h"
+	},
+	"network": {
+		"lock_backend": ""
+	},
+	"criu": {
+		"profiles": {}
+	},
+	"restore": {
+		"root": "/tmp/cedana_restore",
+		"failed_ttl": "24h"
+	},
+	"cgroup": {
+		"parent": "cedana"
+	},
+	"metrics": {
+		"interval": "1m",
+		"retention": "24h"
 	},
 	"connection": {
 		"cedana_url": "0.0.0.0",
@@ -0,0 +1,152 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/checkpoint-restore/go-criu/v6/rpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// TCP modes of a CRIU profile
+const (
+	CriuTcpEstablished = "established"
+	CriuTcpClose       = "close"
+)
+
+// criuOptionVersions are the CRIU versions the RPC options of a profile first
+// appeared in, 3.0 for the ones older than that, the oldest CRIU cedana runs with
+var criuOptionVersions = map[string]int{
+	"ghost limit":         30000,
+	"external":            30000,
+	"tcp established":     30000,
+	"tcp close":           31100,
+	"file locks":          30000,
+	"link remap":          30000,
+	"skip in flight":      30400,
+	"manage cgroups mode": 30000,
+	"log level":           30000,
+	"leave running":       30000,
+}
+
+// CriuProfile is a set of CRIU options for process dumps and restores, named in
+// the config for requests to pick. Unset options keep cedana's defaults. Viper
+// lowercases the names.
+type CriuProfile struct {
+	// 0 keeps the default
+	GhostLimit uint32 `json:"ghost_limit" mapstructure:"ghost_limit"`
+	// external resources, e.g. mnt[/mnt]:data or unix[12345]
+	External []string `json:"external" mapstructure:"external"`
+	// established or close, empty to checkpoint established connections only
+	// when the process has some
+	Tcp          string `json:"tcp" mapstructure:"tcp"`
+	FileLocks    *bool  `json:"file_locks" mapstructure:"file_locks"`
+	LinkRemap    *bool  `json:"link_remap" mapstructure:"link_remap"`
+	SkipInFlight *bool  `json:"skip_in_flight" mapstructure:"skip_in_flight"`
+	// soft, full, strict, props or ignore
+	ManageCgroupsMode string `json:"manage_cgroups_mode" mapstructure:"manage_cgroups_mode"`
+	LogLevel          *int32 `json:"log_level" mapstructure:"log_level"`
+	// dumps only
+	LeaveRunning *bool `json:"leave_running" mapstructure:"leave_running"`
+}
+
+// Validate checks the options of p, and that the CRIU of the given version
+// (e.g. 31700 for 3.17) has them all. A version of 0 skips that check.
+func (p *CriuProfile) Validate(version int) error {
+	var needed []string
+	switch p.Tcp {
+	case "":
+	case CriuTcpEstablished:
+		needed = append(needed, "tcp established")
+	case CriuTcpClose:
+		needed = append(needed, "tcp close")
+	default:
+		return fmt.Errorf("unknown tcp mode %q, expected established or close", p.Tcp)
+	}
+	for option, set := range map[string]bool{
+		"ghost limit":         p.GhostLimit != 0,
+		"external":            len(p.External) > 0,
+		"file locks":          p.FileLocks != nil,
+		"link remap":          p.LinkRemap != nil,
+		"skip in flight":      p.SkipInFlight != nil,
+		"manage cgroups mode": p.ManageCgroupsMode != "",
+		"log level":           p.LogLevel != nil,
+		"leave running":       p.LeaveRunning != nil,
+	} {
+		if set {
+			needed = append(needed, option)
+		}
+	}
+	if _, err := p.cgroupsMode(); err != nil {
+		return err
+	}
+	if p.LogLevel != nil && (*p.LogLevel < 0 || *p.LogLevel > 4) {
+		return fmt.Errorf("criu log level %d is out of 0-4", *p.LogLevel)
+	}
+	for _, ext := range p.External {
+		if !strings.Contains(ext, "[") {
+			return fmt.Errorf("external resource %q is not of the form type[id]", ext)
+		}
+	}
+
+	if version == 0 {
+		return nil
+	}
+	sort.Strings(needed)
+	for _, option := range needed {
+		if min := criuOptionVersions[option]; version < min {
+			return fmt.Errorf("criu %d.%d doesn't support %s, %d.%d or newer is needed", version/10000, version/100%100, option, min/10000, min/100%100)
+		}
+	}
+	return nil
+}
+
+func (p *CriuProfile) cgroupsMode() (*rpc.CriuCgMode, error) {
+	if p.ManageCgroupsMode == "" {
+		return nil, nil
+	}
+	switch mode := strings.ToUpper(p.ManageCgroupsMode); mode {
+	case "SOFT", "FULL", "STRICT", "PROPS", "IGNORE":
+		return rpc.CriuCgMode(rpc.CriuCgMode_value[mode]).Enum(), nil
+	default:
+		return nil, fmt.Errorf("unknown cgroups mode %q, expected soft, full, strict, props or ignore", p.ManageCgroupsMode)
+	}
+}
+
+// Apply sets the options of a validated p on opts
+func (p *CriuProfile) Apply(opts *rpc.CriuOpts) {
+	if p.GhostLimit != 0 {
+		opts.GhostLimit = proto.Uint32(p.GhostLimit)
+	}
+	if len(p.External) > 0 {
+		opts.External = append(opts.External, p.External...)
+	}
+	switch p.Tcp {
+	case CriuTcpEstablished:
+		opts.TcpEstablished = proto.Bool(true)
+		opts.TcpClose = nil
+	case CriuTcpClose:
+		opts.TcpClose = proto.Bool(true)
+		opts.TcpEstablished = proto.Bool(false)
+	}
+	if p.FileLocks != nil {
+		opts.FileLocks = proto.Bool(*p.FileLocks)
+	}
+	if p.LinkRemap != nil {
+		opts.LinkRemap = proto.Bool(*p.LinkRemap)
+	}
+	if p.SkipInFlight != nil {
+		opts.TcpSkipInFlight = proto.Bool(*p.SkipInFlight)
+	}
+	if mode, _ := p.cgroupsMode(); mode != nil {
+		opts.ManageCgroups = proto.Bool(true)
+		opts.ManageCgroupsMode = mode
+	}
+	if p.LogLevel != nil {
+		opts.LogLevel = proto.Int32(*p.LogLevel)
+	}
+	if p.LeaveRunning != nil {
+		opts.LeaveRunning = proto.Bool(*p.LeaveRunning)
+	}
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/checkpoint-restore/go-criu/v6/rpc"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCriuProfile(t *testing.T) {
+	profile := &CriuProfile{
+		GhostLimit:        1 << 30,
+		External:          []string{"mnt[/data]:data"},
+		Tcp:               CriuTcpClose,
+		FileLocks:         proto.Bool(false),
+		ManageCgroupsMode: "soft",
+	}
+	if err := profile.Validate(31700); err != nil {
+		t.Fatal(err)
+	}
+	if err := profile.Validate(31000); err == nil {
+		t.Error("expected tcp close to need a newer criu")
+	}
+	if err := (&CriuProfile{SkipInFlight: proto.Bool(true)}).Validate(30300); err == nil {
+		t.Error("expected skipping in-flight connections to need a newer criu")
+	}
+	if err := (&CriuProfile{FileLocks: proto.Bool(true)}).Validate(20000); err == nil {
+		t.Error("expected file locks to need a newer criu")
+	}
+
+	opts := &rpc.CriuOpts{TcpEstablished: proto.Bool(true), FileLocks: proto.Bool(true), LogLevel: proto.Int32(4)}
+	profile.Apply(opts)
+	if !opts.GetTcpClose() || opts.GetTcpEstablished() {
+		t.Error("expected established connections to be closed")
+	}
+	if opts.GetFileLocks() || opts.GetGhostLimit() != 1<<30 || opts.GetManageCgroupsMode() != rpc.CriuCgMode_SOFT {
+		t.Errorf("options not applied: %v", opts)
+	}
+	if opts.GetLogLevel() != 4 || opts.LinkRemap != nil {
+		t.Errorf("unset options changed: %v", opts)
+	}
+
+	for _, bad := range []*CriuProfile{
+		{Tcp: "reset"},
+		{ManageCgroupsMode: "none"},
+		{LogLevel: proto.Int32(7)},
+		{External: []string{"/mnt"}},
+	} {
+		if err := bad.Validate(0); err == nil {
+			t.Errorf("expected %+v to be invalid", bad)
+		}
+	}
+}
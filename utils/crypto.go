@@ -0,0 +1,344 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Encrypted checkpoints are a header followed by AES-256-GCM sealed chunks:
+//
+//	header: magic | version | key id length | key id | nonce prefix
+//	chunk:  ciphertext length (uint32) | ciphertext
+//
+// Each chunk nonce is the random prefix, the chunk counter and a flag marking the
+// last chunk, so chunks can't be reordered, dropped or the stream truncated. The
+// header is authenticated along with every chunk.
+const (
+	encryptionMagic       = "CDNE"
+	encryptionVersion     = 1
+	encryptionChunkSize   = 64 << 10
+	encryptionNoncePrefix = 7
+	keySize               = 32
+)
+
+// ErrUnknownKey is returned when a checkpoint is encrypted with a key that isn't configured
+var ErrUnknownKey = errors.New("checkpoint is encrypted with an unknown key")
+
+// A Keyring holds the key checkpoints are encrypted with, along with previous keys
+// still accepted for decryption. A nil Keyring means encryption is off.
+type Keyring struct {
+	keyID string
+	keys  map[string][]byte
+}
+
+// Enabled tells whether checkpoints get encrypted
+func (cfg Encryption) Enabled() bool {
+	return cfg.KeyFile != "" || cfg.KeyEnv != ""
+}
+
+// LoadKeyring loads the keys named in cfg. Returns a nil Keyring if no key is
+// configured, in which case checkpoints are written in the clear.
+func LoadKeyring(cfg Encryption) (*Keyring, error) {
+	var key []byte
+	var err error
+
+	switch {
+	case cfg.KeyFile != "":
+		key, err = readKeyFile(cfg.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+	case cfg.KeyEnv != "":
+		value := os.Getenv(cfg.KeyEnv)
+		if value == "" {
+			return nil, fmt.Errorf("encryption key variable %s is not set", cfg.KeyEnv)
+		}
+		key, err = parseKey([]byte(value))
+		if err != nil {
+			return nil, fmt.Errorf("encryption key in %s: %w", cfg.KeyEnv, err)
+		}
+	default:
+		return nil, nil
+	}
+
+	k := &Keyring{keyID: KeyID(key), keys: map[string][]byte{}}
+	k.keys[k.keyID] = key
+
+	for _, path := range cfg.PreviousKeyFiles {
+		previous, err := readKeyFile(path)
+		if err != nil {
+			return nil, err
+		}
+		k.keys[KeyID(previous)] = previous
+	}
+
+	return k, nil
+}
+
+// KeyID identifies a key without giving it away, so the key a checkpoint was
+// encrypted with can be looked up after rotation.
+func KeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+// KeyID returns the id of the key checkpoints are encrypted with, empty if
+// encryption is off.
+func (k *Keyring) KeyID() string {
+	if k == nil {
+		return ""
+	}
+	return k.keyID
+}
+
+// NewEncryptWriter wraps w in a writer encrypting with the current key. With
+// encryption off, writes go straight through to w. Closing it writes out the
+// last chunk, but doesn't close w.
+func (k *Keyring) NewEncryptWriter(w io.Writer) (io.WriteCloser, error) {
+	if k == nil {
+		return nopWriteCloser{w}, nil
+	}
+
+	aead, err := newAEAD(k.keys[k.keyID])
+	if err != nil {
+		return nil, err
+	}
+
+	header := []byte(encryptionMagic)
+	header = append(header, encryptionVersion, byte(len(k.keyID)))
+	header = append(header, k.keyID...)
+	prefix := make([]byte, encryptionNoncePrefix)
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, err
+	}
+	header = append(header, prefix...)
+
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	return &encryptWriter{
+		w:      w,
+		aead:   aead,
+		header: header,
+		prefix: prefix,
+		buf:    make([]byte, 0, encryptionChunkSize),
+	}, nil
+}
+
+// NewDecryptReader detects an encrypted checkpoint from its magic bytes and returns
+// a reader of the decrypted stream, looking up its key by id. Anything else is
+// passed through as is.
+func (k *Keyring) NewDecryptReader(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(len(encryptionMagic))
+	if !bytes.Equal(magic, []byte(encryptionMagic)) {
+		return br, nil
+	}
+
+	fixed := make([]byte, len(encryptionMagic)+2)
+	if _, err := io.ReadFull(br, fixed); err != nil {
+		return nil, fmt.Errorf("%w: truncated encryption header", ErrChecksumMismatch)
+	}
+	if version := fixed[len(encryptionMagic)]; version != encryptionVersion {
+		return nil, fmt.Errorf("unsupported encryption version %d", version)
+	}
+
+	rest := make([]byte, int(fixed[len(fixed)-1])+encryptionNoncePrefix)
+	if _, err := io.ReadFull(br, rest); err != nil {
+		return nil, fmt.Errorf("%w: truncated encryption header", ErrChecksumMismatch)
+	}
+	keyID := string(rest[:len(rest)-encryptionNoncePrefix])
+
+	if k == nil {
+		return nil, fmt.Errorf("%w %s, encryption is not configured", ErrUnknownKey, keyID)
+	}
+	key, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownKey, keyID)
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &decryptReader{
+		r:      br,
+		aead:   aead,
+		header: append(fixed, rest...),
+		prefix: rest[len(rest)-encryptionNoncePrefix:],
+	}, nil
+}
+
+type encryptWriter struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	header  []byte
+	prefix  []byte
+	counter uint32
+	buf     []byte
+	out     []byte
+}
+
+func (e *encryptWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		// only seal a full chunk once there's more to come, the last one is sealed on Close
+		if len(e.buf) == encryptionChunkSize {
+			if err := e.seal(false); err != nil {
+				return n, err
+			}
+		}
+		c := copy(e.buf[len(e.buf):encryptionChunkSize], p)
+		e.buf = e.buf[:len(e.buf)+c]
+		p = p[c:]
+		n += c
+	}
+	return n, nil
+}
+
+func (e *encryptWriter) Close() error {
+	return e.seal(true)
+}
+
+func (e *encryptWriter) seal(last bool) error {
+	if e.counter == ^uint32(0) {
+		return fmt.Errorf("checkpoint too large to encrypt")
+	}
+
+	e.out = e.aead.Seal(e.out[:0], chunkNonce(e.prefix, e.counter, last), e.buf, e.header)
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(e.out)))
+	if _, err := e.w.Write(length[:]); err != nil {
+		return err
+	}
+	if _, err := e.w.Write(e.out); err != nil {
+		return err
+	}
+
+	e.counter++
+	e.buf = e.buf[:0]
+	return nil
+}
+
+type decryptReader struct {
+	r       io.Reader
+	aead    cipher.AEAD
+	header  []byte
+	prefix  []byte
+	counter uint32
+	buf     []byte
+	ct      []byte
+	pt      []byte
+	done    bool
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+func (d *decryptReader) open() error {
+	var length [4]byte
+	if _, err := io.ReadFull(d.r, length[:]); err != nil {
+		return fmt.Errorf("%w: encrypted checkpoint is truncated", ErrChecksumMismatch)
+	}
+	size := binary.BigEndian.Uint32(length[:])
+	if size > encryptionChunkSize+uint32(d.aead.Overhead()) {
+		return fmt.Errorf("%w: chunk %d is %d bytes", ErrChecksumMismatch, d.counter, size)
+	}
+
+	if cap(d.ct) < int(size) {
+		d.ct = make([]byte, size)
+	}
+	d.ct = d.ct[:size]
+	if _, err := io.ReadFull(d.r, d.ct); err != nil {
+		return fmt.Errorf("%w: encrypted checkpoint is truncated", ErrChecksumMismatch)
+	}
+
+	// the last chunk is sealed with its own nonce, so try that one second. Open
+	// clears its output when authentication fails, so it can't decrypt in place
+	plaintext, err := d.aead.Open(d.pt[:0], chunkNonce(d.prefix, d.counter, false), d.ct, d.header)
+	if err != nil {
+		plaintext, err = d.aead.Open(d.pt[:0], chunkNonce(d.prefix, d.counter, true), d.ct, d.header)
+		if err != nil {
+			return fmt.Errorf("%w: chunk %d failed authentication", ErrChecksumMismatch, d.counter)
+		}
+		d.done = true
+	}
+
+	d.counter++
+	d.pt = plaintext
+	d.buf = plaintext
+	return nil
+}
+
+func chunkNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, encryptionNoncePrefix+5)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[encryptionNoncePrefix:], counter)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func readKeyFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read encryption key: %w", err)
+	}
+	key, err := parseKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("encryption key in %s: %w", path, err)
+	}
+	return key, nil
+}
+
+// parseKey accepts a raw 32 byte key, or one encoded in hex or base64
+func parseKey(data []byte) ([]byte, error) {
+	if len(data) == keySize {
+		return data, nil
+	}
+
+	encoded := strings.TrimSpace(string(data))
+	if key, err := hex.DecodeString(encoded); err == nil && len(key) == keySize {
+		return key, nil
+	}
+	if key, err := base64.StdEncoding.DecodeString(encoded); err == nil && len(key) == keySize {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("expected a %d byte key, raw or hex/base64 encoded", keySize)
+}
@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func testKeyring(t *testing.T, previous ...string) *Keyring {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "key")
+	if err := os.WriteFile(path, []byte(hex.EncodeToString(key)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := LoadKeyring(Encryption{KeyFile: path, PreviousKeyFiles: previous})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keys
+}
+
+func encrypt(t *testing.T, keys *Keyring, data []byte) []byte {
+	var buf bytes.Buffer
+	ew, err := keys.NewEncryptWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ew.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := ew.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func decrypt(keys *Keyring, data []byte) ([]byte, error) {
+	dr, err := keys.NewDecryptReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(dr)
+}
+
+func TestEncryptionRoundtrip(t *testing.T) {
+	keys := testKeyring(t)
+
+	// spans a few chunks, the last one partial
+	data := make([]byte, 3*encryptionChunkSize+100)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatal(err)
+	}
+
+	ciphertext := encrypt(t, keys, data)
+	plaintext, err := decrypt(keys, ciphertext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(plaintext, data) {
+		t.Fatal("decrypted data differs from the original")
+	}
+
+	// a flipped bit in the last chunk
+	tampered := append([]byte(nil), ciphertext...)
+	tampered[len(tampered)-1] ^= 1
+	if _, err := decrypt(keys, tampered); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("expected tampering to be detected, got %v", err)
+	}
+
+	// dropping the last chunk
+	truncated := ciphertext[:len(ciphertext)-(100+4+16)]
+	if _, err := decrypt(keys, truncated); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("expected truncation to be detected, got %v", err)
+	}
+
+	if _, err := decrypt(testKeyring(t), ciphertext); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("expected an unknown key error, got %v", err)
+	}
+}
+
+func TestUnencryptedPassthrough(t *testing.T) {
+	plaintext, err := decrypt(testKeyring(t), []byte("not encrypted"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(plaintext) != "not encrypted" {
+		t.Errorf("unexpected passthrough %q", plaintext)
+	}
+}
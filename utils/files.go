@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 func CopyFile(src, dstFolder string) error {
@@ -32,6 +33,18 @@ func CopyFile(src, dstFolder string) error {
 	return copyFileContents(src, dst)
 }
 
+// CopyFileTo copies the regular file src to the path dst, replacing it
+func CopyFileTo(src, dst string) error {
+	sfi, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	if !sfi.Mode().IsRegular() {
+		return fmt.Errorf("%s is not a regular file", src)
+	}
+	return copyFileContents(src, dst)
+}
+
 // copyFileContents copies the contents of the file named src to the file named
 // by dst. The file will be created if it does not already exist. If the
 // destination file exists, all it's contents will be replaced by the contents
@@ -60,3 +73,49 @@ func copyFileContents(src, dst string) error {
 	err = out.Sync()
 	return err
 }
+
+// checkExtractPath checks a file of an archive about to be written to target
+// stays under dest: no symlink on the way there, nor at target itself, which the
+// write would follow.
+func checkExtractPath(dest, target string) error {
+	dest = filepath.Clean(dest)
+	rel, err := filepath.Rel(dest, target)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(os.PathSeparator)) {
+		return fmt.Errorf("%s escapes %s", target, dest)
+	}
+	if rel == "." {
+		return nil
+	}
+
+	path := dest
+	for _, elem := range strings.Split(rel, string(os.PathSeparator)) {
+		path = filepath.Join(path, elem)
+		fi, err := os.Lstat(path)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if fi.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("%s goes through symlink %s", target, path)
+		}
+	}
+	return nil
+}
+
+// checkLink checks a symlink of an archive at target links to somewhere under
+// dest. Only leading .. elements are allowed, climbing from the dir of the link,
+// which checkExtractPath makes sure is no symlink, so the link can't get out of
+// dest through another one.
+func checkLink(dest, target, link string) error {
+	if filepath.IsAbs(link) || filepath.Clean(link) != link {
+		return fmt.Errorf("symlink %s to %s is not relative and clean", target, link)
+	}
+	dest = filepath.Clean(dest)
+	resolved := filepath.Join(filepath.Dir(target), link)
+	if resolved != dest && !strings.HasPrefix(resolved, dest+string(os.PathSeparator)) {
+		return fmt.Errorf("symlink %s to %s escapes %s", target, link, dest)
+	}
+	return nil
+}
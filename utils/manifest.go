@@ -0,0 +1,242 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ManifestFile is written next to checkpoint_state.json in every checkpoint
+const ManifestFile = "checkpoint_manifest.json"
+
+const manifestVersion = 1
+
+// ErrChecksumMismatch is returned when a checkpoint doesn't match its manifest
+var ErrChecksumMismatch = errors.New("checkpoint does not match its manifest")
+
+// PageServerFiles are what a CRIU page server writes next to the images it receives
+// pages for, which the dump it receives them from never has itself
+var PageServerFiles = []string{"pagemap-*.img", "pages-*.img", "page-server.log"}
+
+// ManifestEntry is a regular file of a checkpoint, or a symlink if Link is set
+type ManifestEntry struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+	Link   string `json:"link,omitempty"`
+}
+
+// Manifest lists every file of a checkpoint with its size and checksum. Digest
+// covers the whole list, so a checkpoint verifies as one unit. KeyID is the
+// encryption key the checkpoint archive was written with, if any.
+type Manifest struct {
+	Version int             `json:"version"`
+	Files   []ManifestEntry `json:"files"`
+	Digest  string          `json:"digest"`
+	KeyID   string          `json:"key_id,omitempty"`
+}
+
+// WriteManifest checksums every regular file under dir and writes the manifest
+// into dir, along with the id of the key the checkpoint gets encrypted with.
+// Symlinks (e.g. the parent links of pre-dumps) are recorded with their target,
+// the files they point to are checksummed where they live.
+func WriteManifest(dir, keyID string) (*Manifest, error) {
+	manifest := &Manifest{Version: manifestVersion, KeyID: keyID}
+
+	err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() && fi.Mode()&os.ModeSymlink == 0 {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if relPath == ManifestFile {
+			return nil
+		}
+
+		if fi.Mode()&os.ModeSymlink != 0 {
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			manifest.Files = append(manifest.Files, ManifestEntry{Path: relPath, Link: link})
+			return nil
+		}
+
+		sum, err := sha256File(path)
+		if err != nil {
+			return err
+		}
+
+		manifest.Files = append(manifest.Files, ManifestEntry{
+			Path:   relPath,
+			Size:   fi.Size(),
+			SHA256: sum,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	manifest.Digest = manifest.digest()
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, ManifestFile), data, 0o644); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// ReadManifest reads the manifest of the checkpoint in dir. Returns an error
+// satisfying os.IsNotExist for checkpoints taken before manifests existed.
+func ReadManifest(dir string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("%w: could not parse manifest: %v", ErrChecksumMismatch, err)
+	}
+
+	return &manifest, nil
+}
+
+// VerifyManifest checks every file listed in the manifest of dir is present with
+// the recorded size and checksum, and every symlink with the recorded target.
+// Unless strict, files not in the manifest are ignored, CRIU and the page server
+// can add their own next to the images. Strict refuses them, for checkpoints that
+// have to be exactly what was signed, but for the ones matching a pattern of
+// unlisted, which are known to come from elsewhere.
+func VerifyManifest(dir string, strict bool, unlisted ...string) (*Manifest, error) {
+	manifest, err := ReadManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if digest := manifest.digest(); digest != manifest.Digest {
+		return nil, fmt.Errorf("%w: manifest digest is %s, expected %s", ErrChecksumMismatch, digest, manifest.Digest)
+	}
+
+	for _, f := range manifest.Files {
+		path := filepath.Join(dir, f.Path)
+
+		fi, err := os.Lstat(path)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s is missing", ErrChecksumMismatch, f.Path)
+		}
+		if f.Link != "" {
+			link, err := os.Readlink(path)
+			if err != nil || fi.Mode()&os.ModeSymlink == 0 {
+				return nil, fmt.Errorf("%w: %s is not a symlink", ErrChecksumMismatch, f.Path)
+			}
+			if link != f.Link {
+				return nil, fmt.Errorf("%w: %s links to %s, expected %s", ErrChecksumMismatch, f.Path, link, f.Link)
+			}
+			continue
+		}
+		if !fi.Mode().IsRegular() {
+			return nil, fmt.Errorf("%w: %s is not a regular file", ErrChecksumMismatch, f.Path)
+		}
+		if fi.Size() != f.Size {
+			return nil, fmt.Errorf("%w: %s is %d bytes, expected %d", ErrChecksumMismatch, f.Path, fi.Size(), f.Size)
+		}
+
+		sum, err := sha256File(path)
+		if err != nil {
+			return nil, err
+		}
+		if sum != f.SHA256 {
+			return nil, fmt.Errorf("%w: sha256 of %s is %s, expected %s", ErrChecksumMismatch, f.Path, sum, f.SHA256)
+		}
+	}
+
+	if strict {
+		if err := checkUnlisted(dir, manifest, unlisted); err != nil {
+			return nil, err
+		}
+	}
+
+	return manifest, nil
+}
+
+// checkUnlisted fails if dir has anything but directories that isn't in the
+// manifest, besides the manifest, its signature and files matching a pattern of
+// unlisted
+func checkUnlisted(dir string, manifest *Manifest, unlisted []string) error {
+	listed := map[string]bool{ManifestFile: true, SignatureFile: true}
+	for _, f := range manifest.Files {
+		listed[f.Path] = true
+	}
+
+	return filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if listed[relPath] {
+			return nil
+		}
+		for _, pattern := range unlisted {
+			if matched, _ := filepath.Match(pattern, relPath); matched {
+				return nil
+			}
+		}
+		return fmt.Errorf("%w: %s is not in the manifest", ErrChecksumMismatch, relPath)
+	})
+}
+
+// digest hashes the sorted file list, making it the checksum of the checkpoint as a whole
+func (m *Manifest) digest() string {
+	files := make([]ManifestEntry, len(m.Files))
+	copy(files, m.Files)
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+
+	h := sha256.New()
+	for _, f := range files {
+		if f.Link != "" {
+			fmt.Fprintf(h, "%s\x00->\x00%s\n", f.Path, f.Link)
+			continue
+		}
+		fmt.Fprintf(h, "%s\x00%d\x00%s\n", f.Path, f.Size, f.SHA256)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func sha256File(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
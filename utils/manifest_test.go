@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestManifestDetectsCorruption(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "pages-1.img"), []byte("some pages"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "checkpoint_state.json"), []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest, err := WriteManifest(dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(manifest.Files) != 2 {
+		t.Fatalf("expected 2 files in the manifest, got %d", len(manifest.Files))
+	}
+
+	if _, err := VerifyManifest(dir, false); err != nil {
+		t.Fatalf("fresh checkpoint failed verification: %v", err)
+	}
+
+	// same size, different content
+	if err := os.WriteFile(filepath.Join(dir, "pages-1.img"), []byte("some bytes"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := VerifyManifest(dir, false); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("expected a checksum mismatch, got %v", err)
+	}
+
+	if err := os.Remove(filepath.Join(dir, "pages-1.img")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := VerifyManifest(dir, false); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("expected a missing file to fail verification, got %v", err)
+	}
+}
+
+func TestManifestStrict(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "pages-1.img"), []byte("some pages"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("../pre-dump-1", filepath.Join(dir, "parent")); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest, err := WriteManifest(dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(manifest.Files) != 2 {
+		t.Fatalf("expected the file and the link in the manifest, got %+v", manifest.Files)
+	}
+	if _, err := VerifyManifest(dir, true); err != nil {
+		t.Fatalf("fresh checkpoint failed strict verification: %v", err)
+	}
+
+	extra := filepath.Join(dir, "extra.img")
+	if err := os.WriteFile(extra, []byte("smuggled"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := VerifyManifest(dir, false); err != nil {
+		t.Errorf("unlisted file failed lax verification: %v", err)
+	}
+	if _, err := VerifyManifest(dir, true); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("expected an unlisted file to fail strict verification, got %v", err)
+	}
+	os.Remove(extra)
+
+	// received by a page server
+	for _, name := range []string{"pages-2.img", "pagemap-2.img", "page-server.log"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("received"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := VerifyManifest(dir, true); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("expected page server files to fail strict verification, got %v", err)
+	}
+	if _, err := VerifyManifest(dir, true, PageServerFiles...); err != nil {
+		t.Errorf("page server files failed strict verification allowing them: %v", err)
+	}
+	if err := os.WriteFile(extra, []byte("smuggled"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := VerifyManifest(dir, true, PageServerFiles...); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("expected an unlisted file to fail strict verification allowing page server files, got %v", err)
+	}
+	for _, name := range []string{"pages-2.img", "pagemap-2.img", "page-server.log", "extra.img"} {
+		os.Remove(filepath.Join(dir, name))
+	}
+
+	// pointing the parent elsewhere
+	os.Remove(filepath.Join(dir, "parent"))
+	if err := os.Symlink("../other", filepath.Join(dir, "parent")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := VerifyManifest(dir, false); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("expected a retargeted link to fail verification, got %v", err)
+	}
+}
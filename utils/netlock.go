@@ -0,0 +1,232 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"os/exec"
+	"strings"
+	"syscall"
+)
+
+// Backends to lock the network of a job with while it's checkpointed, so peers
+// can't send it data that would get lost. Off when empty.
+const (
+	NetworkLockNftables = "nftables"
+	NetworkLockIptables = "iptables"
+)
+
+func ValidateNetworkLock(backend string) error {
+	switch backend {
+	case "", NetworkLockNftables, NetworkLockIptables:
+		return nil
+	default:
+		return fmt.Errorf("unknown network lock backend %q, expected nftables or iptables", backend)
+	}
+}
+
+// Endpoint is a local address and port of a locked job. A zero IP is any
+// address, a zero port any port.
+type Endpoint struct {
+	IP   string
+	Port uint32
+	// syscall.SOCK_STREAM or syscall.SOCK_DGRAM
+	Type uint32
+}
+
+// NetworkLock drops the traffic of a job's endpoints, or all the traffic of its
+// network namespace besides loopback if it has its own. The rules live in an
+// nftables table or iptables chain of their own, so unlocking just deletes it.
+type NetworkLock struct {
+	Backend string
+	// name of the table or chain
+	Name      string
+	Endpoints []Endpoint
+	// network namespace to lock whole, e.g. /proc/<pid>/ns/net, or empty for the
+	// host's
+	Netns string
+}
+
+// Lock installs the rules, replacing the ones of an earlier lock of the same name
+func (l *NetworkLock) Lock() error {
+	l.Unlock()
+
+	switch l.Backend {
+	case NetworkLockNftables:
+		_, err := l.run(strings.NewReader(l.nftRuleset()), "nft", "-f", "-")
+		return err
+	case NetworkLockIptables:
+		for _, cmd := range l.iptablesLock() {
+			if _, err := l.run(nil, cmd...); err != nil {
+				l.Unlock()
+				return err
+			}
+		}
+		return nil
+	default:
+		return ValidateNetworkLock(l.Backend)
+	}
+}
+
+// Unlock deletes the rules of the lock, if any are still there
+func (l *NetworkLock) Unlock() error {
+	var errs []string
+	switch l.Backend {
+	case NetworkLockNftables:
+		if out, err := l.run(nil, "nft", "delete", "table", "inet", l.Name); err != nil && !strings.Contains(out, "No such file or directory") {
+			errs = append(errs, err.Error())
+		}
+	case NetworkLockIptables:
+		for _, cmd := range l.iptablesUnlock() {
+			// the jumps and chains of a partial lock may not all be there
+			if out, err := l.run(nil, cmd...); err != nil && !missingRule(out) {
+				errs = append(errs, err.Error())
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("could not unlock network: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// missingRule tells whether iptables failed because there was nothing to delete
+func missingRule(out string) bool {
+	for _, msg := range []string{"No chain", "does not exist", "Bad rule", "Couldn't load target", "No such file or directory"} {
+		if strings.Contains(out, msg) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *NetworkLock) run(stdin *strings.Reader, args ...string) (string, error) {
+	if l.Netns != "" {
+		args = append([]string{"nsenter", "--net=" + l.Netns}, args...)
+	}
+	cmd := exec.Command(args[0], args[1:]...)
+	if stdin != nil {
+		cmd.Stdin = stdin
+	}
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Run(); err != nil {
+		return out.String(), fmt.Errorf("%s: %v: %s", strings.Join(args, " "), err, strings.TrimSpace(out.String()))
+	}
+	return out.String(), nil
+}
+
+// protocol of an endpoint, for both backends
+func (e Endpoint) protocol() string {
+	if e.Type == syscall.SOCK_DGRAM {
+		return "udp"
+	}
+	return "tcp"
+}
+
+func (e Endpoint) ip() net.IP {
+	ip := net.ParseIP(e.IP)
+	if ip == nil || ip.IsUnspecified() {
+		return nil
+	}
+	return ip
+}
+
+// nftRuleset is the table of the lock, for nft -f
+func (l *NetworkLock) nftRuleset() string {
+	var input, output []string
+	if l.Netns != "" {
+		input = append(input, `iifname != "lo" drop`)
+		output = append(output, `oifname != "lo" drop`)
+	} else {
+		for _, e := range l.Endpoints {
+			var in, out []string
+			if ip := e.ip(); ip != nil {
+				family := "ip"
+				if ip.To4() == nil {
+					family = "ip6"
+				}
+				in = append(in, family, "daddr", e.IP)
+				out = append(out, family, "saddr", e.IP)
+			}
+			if e.Port != 0 {
+				in = append(in, e.protocol(), "dport", fmt.Sprint(e.Port))
+				out = append(out, e.protocol(), "sport", fmt.Sprint(e.Port))
+			} else {
+				in = append(in, "meta", "l4proto", e.protocol())
+				out = append(out, "meta", "l4proto", e.protocol())
+			}
+			input = append(input, strings.Join(append(in, "drop"), " "))
+			output = append(output, strings.Join(append(out, "drop"), " "))
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "table inet %s {\n", l.Name)
+	for _, chain := range []struct {
+		name  string
+		rules []string
+	}{{"input", input}, {"output", output}} {
+		fmt.Fprintf(&b, "\tchain %s {\n\t\ttype filter hook %s priority -150; policy accept;\n", chain.name, chain.name)
+		for _, rule := range chain.rules {
+			fmt.Fprintf(&b, "\t\t%s\n", rule)
+		}
+		b.WriteString("\t}\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// iptablesLock are the commands creating the chain of the lock in both the
+// iptables and ip6tables, and jumping to it from INPUT and OUTPUT
+func (l *NetworkLock) iptablesLock() [][]string {
+	var cmds [][]string
+	for _, bin := range []string{"iptables", "ip6tables"} {
+		cmds = append(cmds, []string{bin, "-w", "-N", l.Name})
+		if l.Netns != "" {
+			cmds = append(cmds,
+				[]string{bin, "-w", "-A", l.Name, "!", "-i", "lo", "-j", "DROP"},
+				[]string{bin, "-w", "-A", l.Name, "!", "-o", "lo", "-j", "DROP"},
+			)
+		}
+		for _, e := range l.Endpoints {
+			if l.Netns != "" {
+				break
+			}
+			ip := e.ip()
+			if ip != nil && (ip.To4() == nil) != (bin == "ip6tables") {
+				continue
+			}
+			in := []string{bin, "-w", "-A", l.Name, "-p", e.protocol()}
+			out := []string{bin, "-w", "-A", l.Name, "-p", e.protocol()}
+			if ip != nil {
+				in = append(in, "-d", e.IP)
+				out = append(out, "-s", e.IP)
+			}
+			if e.Port != 0 {
+				in = append(in, "--dport", fmt.Sprint(e.Port))
+				out = append(out, "--sport", fmt.Sprint(e.Port))
+			}
+			cmds = append(cmds, append(in, "-j", "DROP"), append(out, "-j", "DROP"))
+		}
+		cmds = append(cmds,
+			[]string{bin, "-w", "-I", "INPUT", "-j", l.Name},
+			[]string{bin, "-w", "-I", "OUTPUT", "-j", l.Name},
+		)
+	}
+	return cmds
+}
+
+func (l *NetworkLock) iptablesUnlock() [][]string {
+	var cmds [][]string
+	for _, bin := range []string{"iptables", "ip6tables"} {
+		cmds = append(cmds,
+			[]string{bin, "-w", "-D", "INPUT", "-j", l.Name},
+			[]string{bin, "-w", "-D", "OUTPUT", "-j", l.Name},
+			[]string{bin, "-w", "-F", l.Name},
+			[]string{bin, "-w", "-X", l.Name},
+		)
+	}
+	return cmds
+}
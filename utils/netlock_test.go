@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+func TestNetworkLockRules(t *testing.T) {
+	lock := &NetworkLock{
+		Name: "cedana-42",
+		Endpoints: []Endpoint{
+			{IP: "10.0.0.1", Port: 8080, Type: syscall.SOCK_STREAM},
+			{IP: "::", Port: 53, Type: syscall.SOCK_DGRAM},
+		},
+	}
+
+	ruleset := lock.nftRuleset()
+	for _, rule := range []string{
+		"table inet cedana-42 {",
+		"ip daddr 10.0.0.1 tcp dport 8080 drop",
+		"ip saddr 10.0.0.1 tcp sport 8080 drop",
+		"udp dport 53 drop",
+		"type filter hook output priority -150; policy accept;",
+	} {
+		if !strings.Contains(ruleset, rule) {
+			t.Errorf("expected %q in the nftables ruleset:\n%s", rule, ruleset)
+		}
+	}
+
+	cmds := lock.iptablesLock()
+	expected := []string{"iptables", "-w", "-A", "cedana-42", "-p", "tcp", "-d", "10.0.0.1", "--dport", "8080", "-j", "DROP"}
+	var found, v6 bool
+	for _, cmd := range cmds {
+		if reflect.DeepEqual(cmd, expected) {
+			found = true
+		}
+		// v4 addresses stay out of ip6tables, wildcards go in both
+		if cmd[0] == "ip6tables" && strings.Contains(strings.Join(cmd, " "), "10.0.0.1") {
+			t.Errorf("v4 rule in ip6tables: %v", cmd)
+		}
+		if cmd[0] == "ip6tables" && strings.Contains(strings.Join(cmd, " "), "--dport 53") {
+			v6 = true
+		}
+	}
+	if !found || !v6 {
+		t.Errorf("missing rules in %v", cmds)
+	}
+
+	lock.Netns = "/proc/42/ns/net"
+	if ruleset := lock.nftRuleset(); !strings.Contains(ruleset, `iifname != "lo" drop`) || strings.Contains(ruleset, "dport") {
+		t.Errorf("expected the whole namespace locked:\n%s", ruleset)
+	}
+
+	if err := ValidateNetworkLock("pf"); err == nil {
+		t.Error("expected an unknown backend to fail validation")
+	}
+}
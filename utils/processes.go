@@ -2,6 +2,10 @@ package utils
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/adrg/strutil"
 	"github.com/adrg/strutil/metrics"
@@ -75,3 +79,215 @@ func GetProcessName(pid int32) (*string, error) {
 
 	return &name, nil
 }
+
+// ProcessTree returns pid followed by all of its descendants, parents before
+// their children
+func ProcessTree(pid int32) ([]int32, error) {
+	if _, err := os.Stat(filepath.Join("/proc", strconv.Itoa(int(pid)))); err != nil {
+		return nil, fmt.Errorf("process %d not found: %w", pid, err)
+	}
+
+	children, err := childrenByParent()
+	if err != nil {
+		return nil, err
+	}
+
+	tree := []int32{pid}
+	for i := 0; i < len(tree); i++ {
+		tree = append(tree, children[tree[i]]...)
+	}
+	return tree, nil
+}
+
+// SessionProcesses returns the processes in session sid, the session leader first
+// if it is still around
+func SessionProcesses(sid int32) ([]int32, error) {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, err
+	}
+
+	var pids []int32
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		stat, err := ReadProcStat(int32(pid))
+		if err != nil || stat.SID != sid {
+			continue
+		}
+		if stat.PID == sid {
+			pids = append([]int32{stat.PID}, pids...)
+		} else {
+			pids = append(pids, stat.PID)
+		}
+	}
+
+	if len(pids) == 0 {
+		return nil, fmt.Errorf("no processes in session %d", sid)
+	}
+	return pids, nil
+}
+
+// childrenByParent maps every running process to its children, from /proc
+func childrenByParent() (map[int32][]int32, error) {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, err
+	}
+
+	children := map[int32][]int32{}
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		stat, err := ReadProcStat(int32(pid))
+		if err != nil {
+			// exited since we listed /proc
+			continue
+		}
+		children[stat.PPID] = append(children[stat.PPID], stat.PID)
+	}
+	return children, nil
+}
+
+type ProcStat struct {
+	PID int32
+	// R, S, D, Z, T...
+	State byte
+	PPID  int32
+	// process group and session
+	PGID int32
+	SID  int32
+}
+
+// ReadProcStat reads the state of a process and the ids of its parent, group and
+// session
+func ReadProcStat(pid int32) (*ProcStat, error) {
+	stat, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(int(pid)), "stat"))
+	if err != nil {
+		return nil, err
+	}
+
+	// the command in parentheses can hold anything, fields start after the last one
+	end := strings.LastIndexByte(string(stat), ')')
+	if end < 0 {
+		return nil, fmt.Errorf("malformed stat of process %d", pid)
+	}
+	// state ppid pgrp session ...
+	fields := strings.Fields(string(stat[end+1:]))
+	if len(fields) < 4 {
+		return nil, fmt.Errorf("malformed stat of process %d", pid)
+	}
+
+	ids := make([]int32, 3)
+	for i := range ids {
+		id, err := strconv.Atoi(fields[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("malformed stat of process %d: %v", pid, err)
+		}
+		ids[i] = int32(id)
+	}
+
+	return &ProcStat{PID: pid, State: fields[0][0], PPID: ids[0], PGID: ids[1], SID: ids[2]}, nil
+}
+
+// NsPids reads the pids of a process in each pid namespace it is in, from the
+// outermost one /proc shows to its own
+func NsPids(pid int32) ([]int32, error) {
+	status, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(int(pid)), "status"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range strings.Split(string(status), "\n") {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok || name != "NSpid" {
+			continue
+		}
+		var pids []int32
+		for _, field := range strings.Fields(value) {
+			p, err := strconv.Atoi(field)
+			if err != nil {
+				return nil, fmt.Errorf("malformed NSpid of process %d: %v", pid, err)
+			}
+			pids = append(pids, int32(p))
+		}
+		return pids, nil
+	}
+
+	// kernels before 4.1 don't list them, all processes are in the one namespace
+	return []int32{pid}, nil
+}
+
+// OpenFile is a regular file a process has open
+type OpenFile struct {
+	PID   int32
+	Fd    int
+	Path  string
+	Flags int
+	// offset of the fd in the file
+	Pos int64
+}
+
+// Writable tells whether the file was opened for writing
+func (f OpenFile) Writable() bool {
+	return f.Flags&(os.O_WRONLY|os.O_RDWR) != 0
+}
+
+// ProcessOpenFiles lists the regular files pid has open, leaving out deleted
+// files and the ones under /proc, /sys and /dev
+func ProcessOpenFiles(pid int32) ([]OpenFile, error) {
+	fdDir := filepath.Join("/proc", strconv.Itoa(int(pid)), "fd")
+	entries, err := os.ReadDir(fdDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []OpenFile
+	for _, entry := range entries {
+		fd, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		// fds can close while we go through them
+		path, err := os.Readlink(filepath.Join(fdDir, entry.Name()))
+		if err != nil || !filepath.IsAbs(path) || strings.HasSuffix(path, " (deleted)") {
+			continue
+		}
+		if strings.HasPrefix(path, "/proc/") || strings.HasPrefix(path, "/sys/") || strings.HasPrefix(path, "/dev/") {
+			continue
+		}
+		fi, err := os.Stat(filepath.Join(fdDir, entry.Name()))
+		if err != nil || !fi.Mode().IsRegular() {
+			continue
+		}
+
+		file := OpenFile{PID: pid, Fd: fd, Path: path}
+		info, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(int(pid)), "fdinfo", entry.Name()))
+		if err != nil {
+			continue
+		}
+		for _, line := range strings.Split(string(info), "\n") {
+			key, value, ok := strings.Cut(line, ":")
+			if !ok {
+				continue
+			}
+			value = strings.TrimSpace(value)
+			switch key {
+			case "pos":
+				file.Pos, _ = strconv.ParseInt(value, 10, 64)
+			case "flags":
+				// octal, e.g. 0100002
+				flags, _ := strconv.ParseInt(value, 8, 0)
+				file.Flags = int(flags)
+			}
+		}
+		files = append(files, file)
+	}
+
+	return files, nil
+}
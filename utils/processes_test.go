@@ -0,0 +1,146 @@
+package utils
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestProcessTree(t *testing.T) {
+	cmd := exec.Command("sh", "-c", "sleep 10 & wait")
+	// in a process group of its own, for the sleep to go with the shell
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		cmd.Wait()
+	}()
+
+	// give the shell a moment to fork its child
+	var tree []int32
+	for i := 0; i < 50; i++ {
+		var err error
+		tree, err = ProcessTree(int32(cmd.Process.Pid))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tree) > 1 {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if len(tree) != 2 || tree[0] != int32(cmd.Process.Pid) {
+		t.Errorf("expected the shell and its sleep, got %v", tree)
+	}
+	for _, pid := range tree[1:] {
+		if stat, err := ReadProcStat(pid); err != nil || stat.PPID != tree[0] {
+			t.Errorf("process %d is not a child of the shell: %+v, %v", pid, stat, err)
+		}
+	}
+}
+
+func TestSessionProcesses(t *testing.T) {
+	// the subshell exits right away, orphaning its sleep out of the shell's tree
+	cmd := exec.Command("sh", "-c", "(sleep 10 &); sleep 10")
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	sid := int32(cmd.Process.Pid)
+	// the orphaned sleep stays in the shell's process group, it goes too
+	defer func() {
+		syscall.Kill(-int(sid), syscall.SIGKILL)
+		cmd.Wait()
+	}()
+
+	var session, tree []int32
+	for i := 0; i < 50; i++ {
+		var err error
+		if session, err = SessionProcesses(sid); err != nil {
+			t.Fatal(err)
+		}
+		if tree, err = ProcessTree(sid); err != nil {
+			t.Fatal(err)
+		}
+		if len(session) == 3 && len(tree) == 2 {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if len(session) != 3 || session[0] != sid {
+		t.Fatalf("expected the shell and both sleeps in the session, got %v", session)
+	}
+	if len(tree) != 2 {
+		t.Errorf("expected only the shell and its own sleep in its tree, got %v", tree)
+	}
+	for _, pid := range session {
+		if stat, err := ReadProcStat(pid); err != nil || stat.SID != sid {
+			t.Errorf("process %d is not in session %d: %+v, %v", pid, sid, stat, err)
+		}
+	}
+}
+
+func TestProcessOpenFiles(t *testing.T) {
+	out, err := os.CreateTemp(t.TempDir(), "out")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+	if _, err := out.WriteString("hello"); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := ProcessOpenFiles(int32(os.Getpid()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, f := range files {
+		if f.Path != out.Name() {
+			continue
+		}
+		if !f.Writable() || f.Pos != 5 || f.Fd != int(out.Fd()) {
+			t.Errorf("expected a writable fd %d at offset 5, got %+v", out.Fd(), f)
+		}
+		return
+	}
+	t.Errorf("%s not found in the open files %+v", out.Name(), files)
+}
+
+func TestNsPids(t *testing.T) {
+	pids, err := NsPids(int32(os.Getpid()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pids) == 0 || pids[0] != int32(os.Getpid()) {
+		t.Errorf("expected our own pid first, got %v", pids)
+	}
+}
+
+func TestReadProcStatOfZombie(t *testing.T) {
+	cmd := exec.Command("true")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer cmd.Wait()
+
+	// exits right away, and stays a zombie until waited on
+	var stat *ProcStat
+	for i := 0; i < 50; i++ {
+		var err error
+		if stat, err = ReadProcStat(int32(cmd.Process.Pid)); err != nil {
+			t.Fatal(err)
+		}
+		if stat.State == 'Z' {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Errorf("expected a zombie, got state %c", stat.State)
+}
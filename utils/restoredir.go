@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultRestoreRoot is where restores get their working directories when the
+// restore config doesn't say
+const DefaultRestoreRoot = "/tmp/cedana_restore"
+
+// DefaultRestoreTTL is how long the working directory of a failed restore is
+// kept when the restore config doesn't say
+const DefaultRestoreTTL = 24 * time.Hour
+
+// Dir returns the root of the restore working directories
+func (r Restore) Dir() string {
+	if r.Root == "" {
+		return DefaultRestoreRoot
+	}
+	return r.Root
+}
+
+// TTL returns how long the working directory of a failed restore is kept
+func (r Restore) TTL() (time.Duration, error) {
+	if r.FailedTTL == "" {
+		return DefaultRestoreTTL, nil
+	}
+	ttl, err := time.ParseDuration(r.FailedTTL)
+	if err != nil || ttl <= 0 {
+		return 0, fmt.Errorf("invalid failed restore ttl %q", r.FailedTTL)
+	}
+	return ttl, nil
+}
+
+func (r Restore) Validate() error {
+	if r.Root != "" && !filepath.IsAbs(r.Root) {
+		return fmt.Errorf("restore root %q is not an absolute path", r.Root)
+	}
+	_, err := r.TTL()
+	return err
+}
+
+// SweepRestoreDirs deletes whatever is in root last modified more than ttl ago,
+// besides the working directories active says are still in use. It returns the
+// paths deleted.
+func SweepRestoreDirs(root string, ttl time.Duration, active func(name string) bool) ([]string, error) {
+	entries, err := os.ReadDir(root)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var swept []string
+	for _, entry := range entries {
+		if active(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || time.Since(info.ModTime()) < ttl {
+			continue
+		}
+		path := filepath.Join(root, entry.Name())
+		if err := os.RemoveAll(path); err != nil {
+			return swept, err
+		}
+		swept = append(swept, path)
+	}
+	return swept, nil
+}
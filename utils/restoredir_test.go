@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSweepRestoreDirs(t *testing.T) {
+	root := t.TempDir()
+	old := time.Now().Add(-2 * time.Hour)
+	for _, name := range []string{"failed", "running", "recent"} {
+		dir := filepath.Join(root, name)
+		if err := os.Mkdir(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if name != "recent" {
+			if err := os.Chtimes(dir, old, old); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	swept, err := SweepRestoreDirs(root, time.Hour, func(name string) bool { return name == "running" })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(swept) != 1 || filepath.Base(swept[0]) != "failed" {
+		t.Errorf("expected only the failed restore swept, got %v", swept)
+	}
+	for _, name := range []string{"running", "recent"} {
+		if _, err := os.Stat(filepath.Join(root, name)); err != nil {
+			t.Errorf("%s should have been kept: %v", name, err)
+		}
+	}
+
+	if swept, err := SweepRestoreDirs(filepath.Join(root, "missing"), time.Hour, func(string) bool { return false }); err != nil || swept != nil {
+		t.Errorf("expected a missing root to be left alone, got %v, %v", swept, err)
+	}
+
+	if err := (Restore{FailedTTL: "-1h"}).Validate(); err == nil {
+		t.Error("expected a negative ttl to be invalid")
+	}
+	if err := (Restore{Root: "restores"}).Validate(); err == nil {
+		t.Error("expected a relative root to be invalid")
+	}
+}
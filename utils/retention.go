@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DefaultGCInterval is how often the daemon collects checkpoints when the
+// retention config doesn't say
+const DefaultGCInterval = time.Hour
+
+// RetainedCheckpoint is a checkpoint as seen by a retention policy
+type RetainedCheckpoint struct {
+	JobID string
+	Time  time.Time
+	// bytes on disk, 0 for checkpoints only kept remotely
+	Size int64
+}
+
+// Enabled reports whether r deletes anything at all
+func (r Retention) Enabled() bool {
+	return r.KeepLast > 0 || r.MaxAge != "" || r.MaxJobBytes > 0 || r.MaxNodeBytes > 0
+}
+
+func (r Retention) Validate() error {
+	if r.KeepLast < 0 || r.MaxJobBytes < 0 || r.MaxNodeBytes < 0 {
+		return fmt.Errorf("retention limits cannot be negative")
+	}
+	if _, err := r.maxAge(); err != nil {
+		return err
+	}
+	if _, err := r.Interval(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r Retention) maxAge() (time.Duration, error) {
+	if r.MaxAge == "" {
+		return 0, nil
+	}
+	age, err := time.ParseDuration(r.MaxAge)
+	if err != nil || age <= 0 {
+		return 0, fmt.Errorf("invalid retention max age %q", r.MaxAge)
+	}
+	return age, nil
+}
+
+// Interval returns how often the daemon collects checkpoints
+func (r Retention) Interval() (time.Duration, error) {
+	if r.GCInterval == "" {
+		return DefaultGCInterval, nil
+	}
+	interval, err := time.ParseDuration(r.GCInterval)
+	if err != nil || interval <= 0 {
+		return 0, fmt.Errorf("invalid gc interval %q", r.GCInterval)
+	}
+	return interval, nil
+}
+
+// Expired returns the indexes of the checkpoints that r deletes, as of now. A
+// checkpoint goes once any of the limits is past. The latest checkpoint of a job
+// is always kept, so every job can still be restored.
+func (r Retention) Expired(checkpoints []RetainedCheckpoint, now time.Time) ([]int, error) {
+	maxAge, err := r.maxAge()
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := map[string][]int{}
+	for i, checkpoint := range checkpoints {
+		jobs[checkpoint.JobID] = append(jobs[checkpoint.JobID], i)
+	}
+
+	newestFirst := func(indexes []int) {
+		sort.SliceStable(indexes, func(a, b int) bool {
+			return checkpoints[indexes[a]].Time.After(checkpoints[indexes[b]].Time)
+		})
+	}
+
+	expired := map[int]bool{}
+	var nodeBytes int64
+	// kept checkpoints that the node limit can still delete
+	var deletable []int
+
+	for _, indexes := range jobs {
+		newestFirst(indexes)
+
+		var jobBytes int64
+		for n, i := range indexes {
+			checkpoint := checkpoints[i]
+			if n > 0 {
+				switch {
+				case r.KeepLast > 0 && n >= r.KeepLast,
+					maxAge > 0 && now.Sub(checkpoint.Time) > maxAge,
+					r.MaxJobBytes > 0 && jobBytes+checkpoint.Size > r.MaxJobBytes:
+					expired[i] = true
+					continue
+				}
+				deletable = append(deletable, i)
+			}
+			jobBytes += checkpoint.Size
+		}
+		nodeBytes += jobBytes
+	}
+
+	if r.MaxNodeBytes > 0 {
+		// oldest go first, whatever job they're from
+		newestFirst(deletable)
+		for n := len(deletable) - 1; n >= 0 && nodeBytes > r.MaxNodeBytes; n-- {
+			i := deletable[n]
+			expired[i] = true
+			nodeBytes -= checkpoints[i].Size
+		}
+	}
+
+	var indexes []int
+	for i := range checkpoints {
+		if expired[i] {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes, nil
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRetentionExpired(t *testing.T) {
+	now := time.Now()
+	hoursAgo := func(h int) time.Time { return now.Add(-time.Duration(h) * time.Hour) }
+
+	checkpoints := []RetainedCheckpoint{
+		{JobID: "a", Time: hoursAgo(1), Size: 100},
+		{JobID: "a", Time: hoursAgo(2), Size: 100},
+		{JobID: "a", Time: hoursAgo(3), Size: 100},
+		{JobID: "a", Time: hoursAgo(48), Size: 100},
+		// only checkpoint of b, kept however old
+		{JobID: "b", Time: hoursAgo(100), Size: 500},
+	}
+
+	tests := []struct {
+		name      string
+		retention Retention
+		expired   []int
+	}{
+		{"none", Retention{}, nil},
+		{"keep last", Retention{KeepLast: 2}, []int{2, 3}},
+		{"max age", Retention{MaxAge: "24h"}, []int{3}},
+		{"max job bytes", Retention{MaxJobBytes: 250}, []int{2, 3}},
+		{"max node bytes", Retention{MaxNodeBytes: 750}, []int{2, 3}},
+		{"combined", Retention{KeepLast: 3, MaxAge: "150m"}, []int{2, 3}},
+	}
+
+	for _, tt := range tests {
+		expired, err := tt.retention.Expired(checkpoints, now)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if !reflect.DeepEqual(expired, tt.expired) {
+			t.Errorf("%s: expired %v, expected %v", tt.name, expired, tt.expired)
+		}
+	}
+
+	if err := (Retention{MaxAge: "a week"}).Validate(); err == nil {
+		t.Error("expected an invalid max age to fail validation")
+	}
+}
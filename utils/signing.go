@@ -0,0 +1,189 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SignatureFile holds the signature of the manifest, next to it in the checkpoint
+const SignatureFile = "checkpoint_manifest.sig"
+
+// Signature policies applied on restore
+const (
+	SignaturePolicyOff     = "off"
+	SignaturePolicyWarn    = "warn"
+	SignaturePolicyEnforce = "enforce"
+)
+
+// ErrUntrustedCheckpoint is returned for checkpoints that are unsigned, wrongly
+// signed or signed by a key outside of the trust store.
+var ErrUntrustedCheckpoint = errors.New("checkpoint is not signed by a trusted key")
+
+type ManifestSignature struct {
+	KeyID     string `json:"key_id"`
+	Signature string `json:"signature"`
+}
+
+// A Signer signs checkpoint manifests with the node key of this daemon
+type Signer struct {
+	keyID string
+	key   ed25519.PrivateKey
+}
+
+// LoadSigner loads the node key named in cfg. Returns a nil Signer if no key is
+// configured, in which case checkpoints go unsigned.
+func LoadSigner(cfg Signing) (*Signer, error) {
+	if cfg.KeyFile == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read signing key: %w", err)
+	}
+	seed, err := parseKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("signing key in %s: %w", cfg.KeyFile, err)
+	}
+
+	key := ed25519.NewKeyFromSeed(seed)
+	return &Signer{
+		keyID: KeyID(key.Public().(ed25519.PublicKey)),
+		key:   key,
+	}, nil
+}
+
+// GenerateSigningKey writes a new node key to path, and its public key to path.pub
+// for the trust stores of other daemons. Returns the id of the key.
+func GenerateSigningKey(path string) (string, error) {
+	public, private, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		return "", err
+	}
+
+	seed := base64.StdEncoding.EncodeToString(private.Seed())
+	if err := os.WriteFile(path, []byte(seed+"\n"), 0o600); err != nil {
+		return "", err
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(public)
+	if err := os.WriteFile(path+".pub", []byte(encoded+"\n"), 0o644); err != nil {
+		return "", err
+	}
+
+	return KeyID(public), nil
+}
+
+// KeyID returns the id of the node key, empty if signing is off
+func (s *Signer) KeyID() string {
+	if s == nil {
+		return ""
+	}
+	return s.keyID
+}
+
+// SignManifest signs the manifest in dir, written by WriteManifest. The manifest
+// lists the checksum of every file, so its signature covers the whole checkpoint.
+func (s *Signer) SignManifest(dir string) error {
+	manifest, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(ManifestSignature{
+		KeyID:     s.keyID,
+		Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(s.key, manifest)),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, SignatureFile), data, 0o644)
+}
+
+// A TrustStore holds the public keys of the daemons whose checkpoints we restore,
+// one base64 or hex encoded key per file in its directory.
+type TrustStore struct {
+	keys map[string]ed25519.PublicKey
+}
+
+func LoadTrustStore(dir string) (*TrustStore, error) {
+	store := &TrustStore{keys: map[string]ed25519.PublicKey{}}
+	if dir == "" {
+		return store, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read trust store: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		key, err := parseKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("trusted key %s: %w", entry.Name(), err)
+		}
+		store.keys[KeyID(key)] = ed25519.PublicKey(key)
+	}
+
+	return store, nil
+}
+
+// VerifySignature checks the manifest in dir is signed by a key in the trust
+// store. Returns the id of the signing key.
+func (t *TrustStore) VerifySignature(dir string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, SignatureFile))
+	if err != nil {
+		return "", fmt.Errorf("%w: no signature found", ErrUntrustedCheckpoint)
+	}
+
+	var sig ManifestSignature
+	if err := json.Unmarshal(data, &sig); err != nil {
+		return "", fmt.Errorf("%w: could not parse signature: %v", ErrUntrustedCheckpoint, err)
+	}
+
+	key, ok := t.keys[sig.KeyID]
+	if !ok {
+		return sig.KeyID, fmt.Errorf("%w: signed by unknown key %s", ErrUntrustedCheckpoint, sig.KeyID)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(sig.Signature)
+	if err != nil {
+		return sig.KeyID, fmt.Errorf("%w: could not decode signature: %v", ErrUntrustedCheckpoint, err)
+	}
+
+	manifest, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return sig.KeyID, fmt.Errorf("%w: signed manifest is missing", ErrUntrustedCheckpoint)
+	}
+
+	if !ed25519.Verify(key, manifest, signature) {
+		return sig.KeyID, fmt.Errorf("%w: bad signature from key %s", ErrUntrustedCheckpoint, sig.KeyID)
+	}
+
+	return sig.KeyID, nil
+}
+
+// ValidateSignaturePolicy checks policy is one of off, warn or enforce, an empty
+// one meaning off.
+func ValidateSignaturePolicy(policy string) error {
+	switch policy {
+	case "", SignaturePolicyOff, SignaturePolicyWarn, SignaturePolicyEnforce:
+		return nil
+	default:
+		return fmt.Errorf("unknown signature policy %q, expected one of off, warn, enforce", policy)
+	}
+}
@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestManifestSignature(t *testing.T) {
+	keys := t.TempDir()
+	trusted := t.TempDir()
+
+	keyPath := filepath.Join(keys, "node.key")
+	if _, err := GenerateSigningKey(keyPath); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(keyPath+".pub", filepath.Join(trusted, "node.pub")); err != nil {
+		t.Fatal(err)
+	}
+
+	signer, err := LoadSigner(Signing{KeyFile: keyPath})
+	if err != nil {
+		t.Fatal(err)
+	}
+	trust, err := LoadTrustStore(trusted)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "pages-1.img"), []byte("some pages"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := trust.VerifySignature(dir); !errors.Is(err, ErrUntrustedCheckpoint) {
+		t.Errorf("expected an unsigned checkpoint to be untrusted, got %v", err)
+	}
+
+	if _, err := WriteManifest(dir, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := signer.SignManifest(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	keyID, err := trust.VerifySignature(dir)
+	if err != nil {
+		t.Fatalf("signed checkpoint failed verification: %v", err)
+	}
+	if keyID != signer.KeyID() {
+		t.Errorf("signed by %s, expected %s", keyID, signer.KeyID())
+	}
+
+	// the manifest is rewritten after signing
+	if err := os.WriteFile(filepath.Join(dir, "pages-2.img"), []byte("more pages"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := WriteManifest(dir, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := trust.VerifySignature(dir); !errors.Is(err, ErrUntrustedCheckpoint) {
+		t.Errorf("expected a modified manifest to fail verification, got %v", err)
+	}
+
+	empty, err := LoadTrustStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := signer.SignManifest(dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := empty.VerifySignature(dir); !errors.Is(err, ErrUntrustedCheckpoint) {
+		t.Errorf("expected a key outside the trust store to be untrusted, got %v", err)
+	}
+}
@@ -3,11 +3,15 @@ package utils
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"hash"
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -46,6 +50,13 @@ func (s *S3Store) PushCheckpoint(filepath string) error {
 	return nil
 }
 
+const (
+	// parts in flight at once when uploading a checkpoint
+	maxConcurrentUploads = 10
+	// part size to use if the upload endpoint doesn't pick one
+	defaultPartSize = 64 << 20
+)
+
 type UploadResponse struct {
 	UploadID  string `json:"upload_id"`
 	PartSize  int64  `json:"part_size"`
@@ -121,22 +132,39 @@ func (cs *CedanaStore) FullMultipartUpload(ctx context.Context, checkpointPath s
 }
 
 func (cs *CedanaStore) GetCheckpoint(ctx context.Context, cid string) (*string, error) {
-	_, getSpan := cs.tracer.Start(ctx, "GetCheckpoint")
-	defer getSpan.End()
-	url := cs.url + "/checkpoint/" + cid
 	downloadPath := "checkpoint.tar"
 	file, err := os.Create(downloadPath)
-
 	if err != nil {
-		getSpan.RecordError(err)
 		return nil, err
 	}
 
 	defer file.Close()
 
+	checkpoint, err := cs.GetCheckpointStream(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	defer checkpoint.Close()
+
+	_, err = io.Copy(file, checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &downloadPath, nil
+}
+
+// GetCheckpointStream returns the body of the checkpoint download, so it can be
+// extracted as it comes in instead of landing on disk first. The caller closes it.
+func (cs *CedanaStore) GetCheckpointStream(ctx context.Context, cid string) (io.ReadCloser, error) {
+	_, getSpan := cs.tracer.Start(ctx, "GetCheckpoint")
+	defer getSpan.End()
+	url := cs.url + "/checkpoint/" + cid
+
 	httpClient := &http.Client{}
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 
 	if err != nil {
 		getSpan.RecordError(err)
@@ -153,18 +181,44 @@ func (cs *CedanaStore) GetCheckpoint(ctx context.Context, cid string) (*string,
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resp.Body.Close()
 		return nil, fmt.Errorf("unexpected status code: %v", resp.Status)
 	}
 
-	defer resp.Body.Close()
+	return resp.Body, nil
+}
 
-	_, err = io.Copy(file, resp.Body)
+// DeleteCheckpoint deletes a checkpoint from the store, one that's already gone
+// counting as deleted
+func (cs *CedanaStore) DeleteCheckpoint(ctx context.Context, cid string) error {
+	_, deleteSpan := cs.tracer.Start(ctx, "DeleteCheckpoint")
+	defer deleteSpan.End()
+	httpClient := &http.Client{}
+	url := cs.url + "/checkpoint/" + cid
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
-		getSpan.RecordError(err)
-		return nil, err
+		deleteSpan.RecordError(err)
+		return err
 	}
 
-	return &downloadPath, nil
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", cs.cfg.Connection.CedanaAuthToken))
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		deleteSpan.RecordError(err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code: %v", resp.Status)
+	}
+
+	return nil
 }
 
 func (cs *CedanaStore) PushCheckpoint(ctx context.Context, filepath string) error {
@@ -234,88 +288,375 @@ func (cs *CedanaStore) CreateMultiPartUpload(ctx context.Context, fullSize int64
 }
 
 func (cs *CedanaStore) StartMultiPartUpload(ctx context.Context, cid string, uploadResp *UploadResponse, checkpointPath string) error {
-	_, smpSpan := cs.tracer.Start(ctx, "StartMultiPartUpload")
-	defer smpSpan.End()
-	binaryOfFile, err := os.ReadFile(checkpointPath)
+	file, err := os.Open(checkpointPath)
 	if err != nil {
 		fmt.Println("Error reading zip file:", err)
 		return err
 	}
+	defer file.Close()
+
+	return cs.StreamMultiPartUpload(ctx, cid, uploadResp, file)
+}
 
-	maxConcurrentUploads := 10
-	semaphore := make(chan struct{}, maxConcurrentUploads)
+// StreamMultiPartUpload uploads r in parts of uploadResp.PartSize as it is read. At most
+// maxConcurrentUploads parts are in flight (and in memory) at any time, no matter the
+// size of the checkpoint.
+func (cs *CedanaStore) StreamMultiPartUpload(ctx context.Context, cid string, uploadResp *UploadResponse, r io.Reader) error {
+	_, smpSpan := cs.tracer.Start(ctx, "StartMultiPartUpload")
+	defer smpSpan.End()
 
 	chunkSize := uploadResp.PartSize
-	numOfParts := uploadResp.PartCount
+	if chunkSize <= 0 {
+		chunkSize = defaultPartSize
+	}
+
+	// part buffers are recycled, bounding memory to maxConcurrentUploads parts
+	buffers := make(chan []byte, maxConcurrentUploads)
+	allocated := 0
 
-	errChan := make(chan error, numOfParts)
 	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var uploadErr error
+	failed := make(chan struct{})
+	fail := func(err error) {
+		errOnce.Do(func() {
+			uploadErr = err
+			close(failed)
+		})
+	}
 
-	for i := 0; i < int(numOfParts); i++ {
-		wg.Add(1)
+	for partNumber := 1; ; partNumber++ {
+		var buf []byte
+		select {
+		case buf = <-buffers:
+		default:
+			if allocated < maxConcurrentUploads {
+				buf = make([]byte, chunkSize)
+				allocated++
+				break
+			}
+			select {
+			case buf = <-buffers:
+			case <-failed:
+			}
+		}
+		if buf == nil {
+			break
+		}
 
-		semaphore <- struct{}{}
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			wg.Add(1)
+			go func(partNumber int, part []byte) {
+				defer wg.Done()
+				defer func() { buffers <- part[:cap(part)] }()
+
+				if err := cs.uploadPart(ctx, cid, uploadResp.UploadID, partNumber, part); err != nil {
+					fail(err)
+				}
+			}(partNumber, buf[:n])
+		} else {
+			buffers <- buf
+		}
 
-		go func(partNumber int) {
-			defer wg.Done()
-			defer func() { <-semaphore }()
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			fail(err)
+			break
+		}
+	}
 
-			start := partNumber * int(chunkSize)
-			end := (partNumber + 1) * int(chunkSize)
-			if end > len(binaryOfFile) {
-				end = len(binaryOfFile)
-			}
+	wg.Wait()
 
-			partData := binaryOfFile[start:end]
-			buffer := bytes.NewBuffer(partData)
+	if uploadErr != nil {
+		smpSpan.RecordError(uploadErr)
+	}
 
-			httpClient := &http.Client{}
-			url := cs.url + "/checkpoint/" + cid + "/upload/" + uploadResp.UploadID + "/part/" + fmt.Sprintf("%d", partNumber+1)
+	return uploadErr
+}
 
-			req, err := http.NewRequest("PUT", url, buffer)
-			if err != nil {
-				errChan <- err
-				return
-			}
+func (cs *CedanaStore) uploadPart(ctx context.Context, cid, uploadID string, partNumber int, part []byte) error {
+	httpClient := &http.Client{}
+	url := cs.url + "/checkpoint/" + cid + "/upload/" + uploadID + "/part/" + fmt.Sprintf("%d", partNumber)
 
-			req.Header.Set("Content-Type", "application/octet-stream")
-			req.Header.Set("Transfer-Encoding", "chunked")
-			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", cs.cfg.Connection.CedanaAuthToken))
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewReader(part))
+	if err != nil {
+		return err
+	}
 
-			resp, err := httpClient.Do(req)
-			if err != nil {
-				errChan <- err
-				return
-			}
-			if resp.StatusCode < 200 || resp.StatusCode > 299 {
-				errChan <- fmt.Errorf("unexpected status code: %v", resp.Status)
-				return
-			}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Transfer-Encoding", "chunked")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", cs.cfg.Connection.CedanaAuthToken))
 
-			defer resp.Body.Close()
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
 
-			respBody, err := io.ReadAll(resp.Body)
-			if err != nil {
-				errChan <- err
-				return
-			}
-			cs.logger.Info().Msgf("Part %d uploaded: %s", partNumber+1, string(respBody))
-		}(i)
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code: %v", resp.Status)
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
 	}
+	cs.logger.Info().Msgf("Part %d uploaded: %s", partNumber, string(respBody))
+
+	return nil
+}
+
+// ErrCorruptDownload is returned when a downloaded checkpoint doesn't have the
+// checksum it was uploaded with
+var ErrCorruptDownload = fmt.Errorf("%w: download is corrupted", ErrChecksumMismatch)
+
+// ChecksumReader hashes a downloaded checkpoint as it's read, to check once it's
+// been extracted that it's the one that was uploaded
+type ChecksumReader struct {
+	r   io.Reader
+	sum hash.Hash
+}
+
+func NewChecksumReader(r io.Reader) *ChecksumReader {
+	sum := sha256.New()
+	return &ChecksumReader{r: io.TeeReader(r, sum), sum: sum}
+}
 
+func (c *ChecksumReader) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
+
+// Verify reads what extraction left of the download, like the padding at the end
+// of a tar, and checks the whole of it has checksum
+func (c *ChecksumReader) Verify(checksum string) error {
+	if _, err := io.Copy(io.Discard, c.r); err != nil {
+		return err
+	}
+	if got := hex.EncodeToString(c.sum.Sum(nil)); got != checksum {
+		return fmt.Errorf("%w, it has sha256 %s instead of %s", ErrCorruptDownload, got, checksum)
+	}
+	return nil
+}
+
+// UploadFolder tars dir, compressed with compression and encrypted if keys is set,
+// into a multipart upload. The upload is created with the size of the archive, so
+// it's written once to a file next to dir first, which goes once uploaded. Returns
+// the upload and the new checkpoint, with the sha256 of the archive.
+func (cs *CedanaStore) UploadFolder(ctx context.Context, dir string, compression Compression, keys *Keyring) (*UploadResponse, *CheckpointMeta, error) {
+	ctx, uploadSpan := cs.tracer.Start(ctx, "UploadFolder")
+	defer uploadSpan.End()
+
+	archive, err := os.CreateTemp(filepath.Dir(dir), filepath.Base(dir)+"-upload-")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+
+	sum := sha256.New()
+	if err := ArchiveFolderToWriter(dir, io.MultiWriter(archive, sum), compression, keys); err != nil {
+		return nil, nil, err
+	}
+	size, err := archive.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return nil, nil, err
+	}
+
+	multipartCheckpointResp, cid, err := cs.CreateMultiPartUpload(ctx, size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("CreateMultiPartUpload failed with error: %w", err)
+	}
+
+	err = cs.StreamMultiPartUpload(ctx, cid, multipartCheckpointResp, archive)
+	if err != nil {
+		uploadSpan.RecordError(err)
+		return nil, nil, fmt.Errorf("StartMultiPartUpload failed with error: %w", err)
+	}
+
+	err = cs.CompleteMultiPartUpload(ctx, *multipartCheckpointResp, cid)
+	if err != nil {
+		return nil, nil, fmt.Errorf("CompleteMultiPartUpload failed with error: %w", err)
+	}
+
+	return multipartCheckpointResp, &CheckpointMeta{
+		ID:       cid,
+		Size:     uint64(size),
+		Checksum: hex.EncodeToString(sum.Sum(nil)),
+	}, nil
+}
+
+// UploadChunked splits dir into the local chunk store, uploads the chunks the
+// remote hasn't seen yet, and then the chunk index as the checkpoint itself.
+// Returns the upload and the new checkpoint, with the sha256 of the index.
+func (cs *CedanaStore) UploadChunked(ctx context.Context, dir string, chunks *ChunkStore) (*UploadResponse, *CheckpointMeta, error) {
+	ctx, uploadSpan := cs.tracer.Start(ctx, "UploadChunked")
+	defer uploadSpan.End()
+
+	index, err := chunks.ChunkFolder(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hashes := index.Hashes()
+	missing, err := cs.MissingChunks(ctx, hashes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("MissingChunks failed with error: %w", err)
+	}
+	cs.logger.Info().Msgf("uploading %d of %d chunks", len(missing), len(hashes))
+
+	sem := make(chan struct{}, maxConcurrentUploads)
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var uploadErr error
+	for _, hash := range missing {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(hash string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := cs.PutChunk(ctx, hash, chunks); err != nil {
+				errOnce.Do(func() { uploadErr = err })
+			}
+		}(hash)
+	}
 	wg.Wait()
-	close(errChan)
+	if uploadErr != nil {
+		uploadSpan.RecordError(uploadErr)
+		return nil, nil, fmt.Errorf("PutChunk failed with error: %w", uploadErr)
+	}
 
-	// check for errs
-	// check for errors
-	for err := range errChan {
-		if err != nil {
-			return err
-		}
+	var buf bytes.Buffer
+	if err := WriteChunkIndex(&buf, index); err != nil {
+		return nil, nil, err
+	}
+
+	meta := &CheckpointMeta{Size: uint64(buf.Len())}
+	sum := sha256.Sum256(buf.Bytes())
+	meta.Checksum = hex.EncodeToString(sum[:])
+
+	multipartCheckpointResp, cid, err := cs.CreateMultiPartUpload(ctx, int64(buf.Len()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("CreateMultiPartUpload failed with error: %w", err)
+	}
+
+	err = cs.StreamMultiPartUpload(ctx, cid, multipartCheckpointResp, &buf)
+	if err != nil {
+		return nil, nil, fmt.Errorf("StartMultiPartUpload failed with error: %w", err)
+	}
+
+	err = cs.CompleteMultiPartUpload(ctx, *multipartCheckpointResp, cid)
+	if err != nil {
+		return nil, nil, fmt.Errorf("CompleteMultiPartUpload failed with error: %w", err)
+	}
+
+	meta.ID = cid
+	return multipartCheckpointResp, meta, nil
+}
+
+// MissingChunks asks the remote which of hashes it doesn't have yet
+func (cs *CedanaStore) MissingChunks(ctx context.Context, hashes []string) ([]string, error) {
+	payload, err := json.Marshal(struct {
+		Chunks []string `json:"chunks"`
+	}{Chunks: hashes})
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := &http.Client{}
+	url := cs.url + "/chunks/missing"
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", cs.cfg.Connection.CedanaAuthToken))
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("unexpected status code: %v", resp.Status)
+	}
+
+	var missing struct {
+		Missing []string `json:"missing"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&missing); err != nil {
+		return nil, err
+	}
+
+	return missing.Missing, nil
+}
+
+// PutChunk uploads a chunk as it is stored locally
+func (cs *CedanaStore) PutChunk(ctx context.Context, hash string, chunks *ChunkStore) error {
+	file, err := chunks.OpenStored(hash)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	httpClient := &http.Client{}
+	url := cs.url + "/chunks/" + hash
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, file)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", cs.cfg.Connection.CedanaAuthToken))
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code: %v", resp.Status)
 	}
 
 	return nil
 }
+
+// GetChunk downloads a chunk, as it was stored by the daemon that uploaded it
+func (cs *CedanaStore) GetChunk(ctx context.Context, hash string) (io.ReadCloser, error) {
+	httpClient := &http.Client{}
+	url := cs.url + "/chunks/" + hash
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", cs.cfg.Connection.CedanaAuthToken))
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code: %v", resp.Status)
+	}
+
+	return resp.Body, nil
+}
+
 func (cs *CedanaStore) CompleteMultiPartUpload(ctx context.Context, uploadResp UploadResponse, cid string) error {
 	_, cmpuSpan := cs.tracer.Start(ctx, "CompleteMultiPartUpload")
 	defer cmpuSpan.End()
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// Extracts an archive through a checksum reader, which should only pass it with
+// the checksum of the whole archive.
+func TestChecksumReader(t *testing.T) {
+	src := t.TempDir()
+	if err := os.WriteFile(filepath.Join(src, "pages-1.img"), bytes.Repeat([]byte("checkpoint"), 1<<10), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var archive bytes.Buffer
+	if err := ArchiveFolderToWriter(src, &archive, Compression{Algorithm: CompressionNone}, nil); err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(archive.Bytes())
+	checksum := hex.EncodeToString(sum[:])
+
+	download := NewChecksumReader(bytes.NewReader(archive.Bytes()))
+	if err := UntarFromReader(download, t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	if err := download.Verify(checksum); err != nil {
+		t.Errorf("expected the download to match, got %v", err)
+	}
+
+	// still a good tar, only with a page flipped
+	corrupt := append([]byte{}, archive.Bytes()...)
+	corrupt[bytes.Index(corrupt, []byte("checkpoint"))] = 'C'
+	download = NewChecksumReader(bytes.NewReader(corrupt))
+	if err := UntarFromReader(download, t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	if err := download.Verify(checksum); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("expected a checksum mismatch, got %v", err)
+	}
+}
+
+// Uploads a folder to a fake store, which should get the archive whole in parts
+// after being told its exact size, with no archive left next to the folder.
+func TestUploadFolder(t *testing.T) {
+	var mu sync.Mutex
+	var fullSize int64
+	parts := map[int][]byte{}
+	completed := false
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		switch {
+		case r.Method == "POST" && len(path) == 3:
+			var create struct {
+				FullSize int64 `json:"full_size"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&create); err != nil {
+				t.Error(err)
+			}
+			fullSize = create.FullSize
+			json.NewEncoder(w).Encode(UploadResponse{UploadID: "upload", PartSize: 4096})
+		case r.Method == "PUT" && len(path) == 6 && path[4] == "part":
+			n, _ := strconv.Atoi(path[5])
+			parts[n], _ = io.ReadAll(r.Body)
+		case r.Method == "PUT" && len(path) == 5 && path[4] == "complete":
+			completed = true
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	parent := t.TempDir()
+	dir := filepath.Join(parent, "dump")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "pages-1.img"), bytes.Repeat([]byte("cedana"), 1<<12), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	logger := GetLogger()
+	cs := &CedanaStore{logger: &logger, cfg: &Config{}, url: srv.URL, tracer: noop.NewTracerProvider().Tracer("")}
+	_, meta, err := cs.UploadFolder(context.Background(), dir, Compression{Algorithm: CompressionGzip}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var uploaded []byte
+	for i := 1; i <= len(parts); i++ {
+		uploaded = append(uploaded, parts[i]...)
+	}
+	sum := sha256.Sum256(uploaded)
+	if !completed || int64(len(uploaded)) != fullSize || meta.Size != uint64(fullSize) {
+		t.Errorf("upload created with %d bytes, got %d in %d parts, checkpoint of %d", fullSize, len(uploaded), len(parts), meta.Size)
+	}
+	if meta.Checksum != hex.EncodeToString(sum[:]) {
+		t.Errorf("checksum %s is not the one of what was uploaded", meta.Checksum)
+	}
+	if err := UntarFromReader(bytes.NewReader(uploaded), t.TempDir()); err != nil {
+		t.Errorf("uploaded archive doesn't extract: %v", err)
+	}
+
+	entries, err := os.ReadDir(parent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected only the dump dir left, got %v", entries)
+	}
+}
@@ -0,0 +1,152 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultMetricsInterval is how often the daemon samples the usage of the jobs
+// when the metrics config doesn't say
+const DefaultMetricsInterval = time.Minute
+
+// DefaultMetricsRetention is how long usage samples are kept when the metrics
+// config doesn't say
+const DefaultMetricsRetention = 24 * time.Hour
+
+// clock ticks of the cpu times in /proc, USER_HZ is 100 everywhere
+const clockTicks = 100
+
+// SampleInterval returns how often the usage of the jobs gets sampled
+func (m Metrics) SampleInterval() (time.Duration, error) {
+	return parseMetricsDuration("metrics interval", m.Interval, DefaultMetricsInterval)
+}
+
+// RetentionPeriod returns how long usage samples are kept
+func (m Metrics) RetentionPeriod() (time.Duration, error) {
+	return parseMetricsDuration("metrics retention", m.Retention, DefaultMetricsRetention)
+}
+
+func (m Metrics) Validate() error {
+	if _, err := m.SampleInterval(); err != nil {
+		return err
+	}
+	_, err := m.RetentionPeriod()
+	return err
+}
+
+func parseMetricsDuration(name, value string, def time.Duration) (time.Duration, error) {
+	if value == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid %s %q", name, value)
+	}
+	return d, nil
+}
+
+// ProcessUsage is what a process uses of the machine
+type ProcessUsage struct {
+	// resident and proportional set size, in bytes
+	RSS uint64
+	PSS uint64
+	// resident memory that is anonymous, what a checkpoint has to hold, and the
+	// rest, backed by files or shared memory
+	AnonBytes uint64
+	FileBytes uint64
+	Threads   int32
+	// cpu time in user and kernel mode, in seconds
+	CPUUser   float64
+	CPUSystem float64
+	// bytes read from and written to storage
+	IOReadBytes  uint64
+	IOWriteBytes uint64
+	// memory mappings, one per vma
+	Mappings uint32
+}
+
+// Add sums o into u
+func (u *ProcessUsage) Add(o *ProcessUsage) {
+	u.RSS += o.RSS
+	u.PSS += o.PSS
+	u.AnonBytes += o.AnonBytes
+	u.FileBytes += o.FileBytes
+	u.Threads += o.Threads
+	u.CPUUser += o.CPUUser
+	u.CPUSystem += o.CPUSystem
+	u.IOReadBytes += o.IOReadBytes
+	u.IOWriteBytes += o.IOWriteBytes
+	u.Mappings += o.Mappings
+}
+
+// ReadProcessUsage reads the usage of a process from /proc. Only the process
+// being gone is an error, the figures the kernel doesn't give are left at 0.
+func ReadProcessUsage(pid int32) (*ProcessUsage, error) {
+	dir := filepath.Join("/proc", strconv.Itoa(int(pid)))
+	status, err := os.ReadFile(filepath.Join(dir, "status"))
+	if err != nil {
+		return nil, err
+	}
+
+	var u ProcessUsage
+	fields := procFields(status)
+	u.RSS = fields["VmRSS"] << 10
+	u.AnonBytes = fields["RssAnon"] << 10
+	u.FileBytes = (fields["RssFile"] + fields["RssShmem"]) << 10
+	u.Threads = int32(fields["Threads"])
+
+	// kernels before 4.14 don't roll the smaps up
+	if rollup, err := os.ReadFile(filepath.Join(dir, "smaps_rollup")); err == nil {
+		u.PSS = procFields(rollup)["Pss"] << 10
+	}
+
+	if stat, err := os.ReadFile(filepath.Join(dir, "stat")); err == nil {
+		// utime and stime, past the command in parentheses
+		if end := bytes.LastIndexByte(stat, ')'); end >= 0 {
+			if f := strings.Fields(string(stat[end+1:])); len(f) > 12 {
+				utime, _ := strconv.ParseUint(f[11], 10, 64)
+				stime, _ := strconv.ParseUint(f[12], 10, 64)
+				u.CPUUser = float64(utime) / clockTicks
+				u.CPUSystem = float64(stime) / clockTicks
+			}
+		}
+	}
+
+	if io, err := os.ReadFile(filepath.Join(dir, "io")); err == nil {
+		fields := procFields(io)
+		u.IOReadBytes = fields["read_bytes"]
+		u.IOWriteBytes = fields["write_bytes"]
+	}
+
+	if maps, err := os.ReadFile(filepath.Join(dir, "maps")); err == nil {
+		u.Mappings = uint32(bytes.Count(maps, []byte("\n")))
+	}
+
+	return &u, nil
+}
+
+// procFields reads the "name: value [kB]" lines of a /proc file
+func procFields(data []byte) map[string]uint64 {
+	fields := map[string]uint64{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		name, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		f := strings.Fields(value)
+		if len(f) == 0 {
+			continue
+		}
+		if v, err := strconv.ParseUint(f[0], 10, 64); err == nil {
+			fields[name] = v
+		}
+	}
+	return fields
+}
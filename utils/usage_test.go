@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"os"
+	"testing"
+)
+
+func TestReadProcessUsage(t *testing.T) {
+	u, err := ReadProcessUsage(int32(os.Getpid()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.RSS == 0 || u.Threads == 0 || u.Mappings == 0 {
+		t.Errorf("expected our own memory, threads and mappings, got %+v", u)
+	}
+	if u.AnonBytes+u.FileBytes > u.RSS {
+		t.Errorf("expected anonymous and file backed memory to fit in the rss, got %+v", u)
+	}
+
+	total := &ProcessUsage{}
+	total.Add(u)
+	total.Add(u)
+	if total.RSS != 2*u.RSS || total.Threads != 2*u.Threads {
+		t.Errorf("expected the usage summed twice, got %+v", total)
+	}
+}
+
+func TestMetricsValidate(t *testing.T) {
+	if err := (Metrics{}).Validate(); err != nil {
+		t.Errorf("expected the defaults to be valid: %v", err)
+	}
+	if err := (Metrics{Interval: "never"}).Validate(); err == nil {
+		t.Error("expected an invalid interval")
+	}
+	if err := (Metrics{Retention: "-1h"}).Validate(); err == nil {
+		t.Error("expected an invalid retention")
+	}
+}